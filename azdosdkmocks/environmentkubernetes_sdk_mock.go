@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/sdk/environmentkubernetes (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	environmentkubernetes "github.com/microsoft/terraform-provider-azuredevops/sdk/environmentkubernetes"
+)
+
+// MockEnvironmentkubernetesClient is a mock of Client interface.
+type MockEnvironmentkubernetesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockEnvironmentkubernetesClientMockRecorder
+}
+
+// MockEnvironmentkubernetesClientMockRecorder is the mock recorder for MockEnvironmentkubernetesClient.
+type MockEnvironmentkubernetesClientMockRecorder struct {
+	mock *MockEnvironmentkubernetesClient
+}
+
+// NewMockEnvironmentkubernetesClient creates a new mock instance.
+func NewMockEnvironmentkubernetesClient(ctrl *gomock.Controller) *MockEnvironmentkubernetesClient {
+	mock := &MockEnvironmentkubernetesClient{ctrl: ctrl}
+	mock.recorder = &MockEnvironmentkubernetesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnvironmentkubernetesClient) EXPECT() *MockEnvironmentkubernetesClientMockRecorder {
+	return m.recorder
+}
+
+// AddKubernetesResource mocks base method.
+func (m *MockEnvironmentkubernetesClient) AddKubernetesResource(arg0 context.Context, arg1 environmentkubernetes.AddKubernetesResourceArgs) (*taskagent.KubernetesResource, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddKubernetesResource", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.KubernetesResource)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddKubernetesResource indicates an expected call of AddKubernetesResource.
+func (mr *MockEnvironmentkubernetesClientMockRecorder) AddKubernetesResource(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddKubernetesResource", reflect.TypeOf((*MockEnvironmentkubernetesClient)(nil).AddKubernetesResource), arg0, arg1)
+}
@@ -0,0 +1,95 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/sdk/extensiondata (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	extensiondata "github.com/microsoft/terraform-provider-azuredevops/sdk/extensiondata"
+)
+
+// MockExtensiondataClient is a mock of Client interface.
+type MockExtensiondataClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockExtensiondataClientMockRecorder
+}
+
+// MockExtensiondataClientMockRecorder is the mock recorder for MockExtensiondataClient.
+type MockExtensiondataClientMockRecorder struct {
+	mock *MockExtensiondataClient
+}
+
+// NewMockExtensiondataClient creates a new mock instance.
+func NewMockExtensiondataClient(ctrl *gomock.Controller) *MockExtensiondataClient {
+	mock := &MockExtensiondataClient{ctrl: ctrl}
+	mock.recorder = &MockExtensiondataClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExtensiondataClient) EXPECT() *MockExtensiondataClientMockRecorder {
+	return m.recorder
+}
+
+// CreateDocument mocks base method.
+func (m *MockExtensiondataClient) CreateDocument(arg0 context.Context, arg1 extensiondata.CreateDocumentArgs) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDocument", arg0, arg1)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDocument indicates an expected call of CreateDocument.
+func (mr *MockExtensiondataClientMockRecorder) CreateDocument(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDocument", reflect.TypeOf((*MockExtensiondataClient)(nil).CreateDocument), arg0, arg1)
+}
+
+// DeleteDocument mocks base method.
+func (m *MockExtensiondataClient) DeleteDocument(arg0 context.Context, arg1 extensiondata.DeleteDocumentArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDocument", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDocument indicates an expected call of DeleteDocument.
+func (mr *MockExtensiondataClientMockRecorder) DeleteDocument(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDocument", reflect.TypeOf((*MockExtensiondataClient)(nil).DeleteDocument), arg0, arg1)
+}
+
+// GetDocument mocks base method.
+func (m *MockExtensiondataClient) GetDocument(arg0 context.Context, arg1 extensiondata.GetDocumentArgs) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDocument", arg0, arg1)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDocument indicates an expected call of GetDocument.
+func (mr *MockExtensiondataClientMockRecorder) GetDocument(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDocument", reflect.TypeOf((*MockExtensiondataClient)(nil).GetDocument), arg0, arg1)
+}
+
+// SetDocument mocks base method.
+func (m *MockExtensiondataClient) SetDocument(arg0 context.Context, arg1 extensiondata.SetDocumentArgs) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDocument", arg0, arg1)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDocument indicates an expected call of SetDocument.
+func (mr *MockExtensiondataClientMockRecorder) SetDocument(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDocument", reflect.TypeOf((*MockExtensiondataClient)(nil).SetDocument), arg0, arg1)
+}
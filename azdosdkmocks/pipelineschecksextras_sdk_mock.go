@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/sdk/pipelineschecksextras (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	pipelineschecks "github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	pipelineschecksextras "github.com/microsoft/terraform-provider-azuredevops/sdk/pipelineschecksextras"
+)
+
+// MockPipelineschecksextrasClient is a mock of Client interface.
+type MockPipelineschecksextrasClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPipelineschecksextrasClientMockRecorder
+}
+
+// MockPipelineschecksextrasClientMockRecorder is the mock recorder for MockPipelineschecksextrasClient.
+type MockPipelineschecksextrasClientMockRecorder struct {
+	mock *MockPipelineschecksextrasClient
+}
+
+// NewMockPipelineschecksextrasClient creates a new mock instance.
+func NewMockPipelineschecksextrasClient(ctrl *gomock.Controller) *MockPipelineschecksextrasClient {
+	mock := &MockPipelineschecksextrasClient{ctrl: ctrl}
+	mock.recorder = &MockPipelineschecksextrasClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPipelineschecksextrasClient) EXPECT() *MockPipelineschecksextrasClientMockRecorder {
+	return m.recorder
+}
+
+// AddCheckConfiguration mocks base method.
+func (m *MockPipelineschecksextrasClient) AddCheckConfiguration(arg0 context.Context, arg1 pipelineschecksextras.AddCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelineschecks.GenericCheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCheckConfiguration indicates an expected call of AddCheckConfiguration.
+func (mr *MockPipelineschecksextrasClientMockRecorder) AddCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCheckConfiguration", reflect.TypeOf((*MockPipelineschecksextrasClient)(nil).AddCheckConfiguration), arg0, arg1)
+}
+
+// DeleteCheckConfiguration mocks base method.
+func (m *MockPipelineschecksextrasClient) DeleteCheckConfiguration(arg0 context.Context, arg1 pipelineschecksextras.DeleteCheckConfigurationArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCheckConfiguration indicates an expected call of DeleteCheckConfiguration.
+func (mr *MockPipelineschecksextrasClientMockRecorder) DeleteCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCheckConfiguration", reflect.TypeOf((*MockPipelineschecksextrasClient)(nil).DeleteCheckConfiguration), arg0, arg1)
+}
+
+// GetCheckConfiguration mocks base method.
+func (m *MockPipelineschecksextrasClient) GetCheckConfiguration(arg0 context.Context, arg1 pipelineschecksextras.GetCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelineschecks.GenericCheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCheckConfiguration indicates an expected call of GetCheckConfiguration.
+func (mr *MockPipelineschecksextrasClientMockRecorder) GetCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCheckConfiguration", reflect.TypeOf((*MockPipelineschecksextrasClient)(nil).GetCheckConfiguration), arg0, arg1)
+}
+
+// UpdateCheckConfiguration mocks base method.
+func (m *MockPipelineschecksextrasClient) UpdateCheckConfiguration(arg0 context.Context, arg1 pipelineschecksextras.UpdateCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCheckConfiguration", arg0, arg1)
+	ret0, _ := ret[0].(*pipelineschecks.GenericCheckConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCheckConfiguration indicates an expected call of UpdateCheckConfiguration.
+func (mr *MockPipelineschecksextrasClientMockRecorder) UpdateCheckConfiguration(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCheckConfiguration", reflect.TypeOf((*MockPipelineschecksextrasClient)(nil).UpdateCheckConfiguration), arg0, arg1)
+}
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/sdk/securefiles (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	securefiles "github.com/microsoft/terraform-provider-azuredevops/sdk/securefiles"
+)
+
+// MockSecurefilesClient is a mock of Client interface.
+type MockSecurefilesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecurefilesClientMockRecorder
+}
+
+// MockSecurefilesClientMockRecorder is the mock recorder for MockSecurefilesClient.
+type MockSecurefilesClientMockRecorder struct {
+	mock *MockSecurefilesClient
+}
+
+// NewMockSecurefilesClient creates a new mock instance.
+func NewMockSecurefilesClient(ctrl *gomock.Controller) *MockSecurefilesClient {
+	mock := &MockSecurefilesClient{ctrl: ctrl}
+	mock.recorder = &MockSecurefilesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecurefilesClient) EXPECT() *MockSecurefilesClientMockRecorder {
+	return m.recorder
+}
+
+// DeleteSecureFile mocks base method.
+func (m *MockSecurefilesClient) DeleteSecureFile(arg0 context.Context, arg1 securefiles.DeleteSecureFileArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecureFile", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecureFile indicates an expected call of DeleteSecureFile.
+func (mr *MockSecurefilesClientMockRecorder) DeleteSecureFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecureFile", reflect.TypeOf((*MockSecurefilesClient)(nil).DeleteSecureFile), arg0, arg1)
+}
+
+// GetSecureFile mocks base method.
+func (m *MockSecurefilesClient) GetSecureFile(arg0 context.Context, arg1 securefiles.GetSecureFileArgs) (*taskagent.SecureFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecureFile", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.SecureFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecureFile indicates an expected call of GetSecureFile.
+func (mr *MockSecurefilesClientMockRecorder) GetSecureFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecureFile", reflect.TypeOf((*MockSecurefilesClient)(nil).GetSecureFile), arg0, arg1)
+}
+
+// UpdateSecureFile mocks base method.
+func (m *MockSecurefilesClient) UpdateSecureFile(arg0 context.Context, arg1 securefiles.UpdateSecureFileArgs) (*taskagent.SecureFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecureFile", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.SecureFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecureFile indicates an expected call of UpdateSecureFile.
+func (mr *MockSecurefilesClientMockRecorder) UpdateSecureFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecureFile", reflect.TypeOf((*MockSecurefilesClient)(nil).UpdateSecureFile), arg0, arg1)
+}
+
+// UploadSecureFile mocks base method.
+func (m *MockSecurefilesClient) UploadSecureFile(arg0 context.Context, arg1 securefiles.UploadSecureFileArgs) (*taskagent.SecureFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSecureFile", arg0, arg1)
+	ret0, _ := ret[0].(*taskagent.SecureFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSecureFile indicates an expected call of UploadSecureFile.
+func (mr *MockSecurefilesClientMockRecorder) UploadSecureFile(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSecureFile", reflect.TypeOf((*MockSecurefilesClient)(nil).UploadSecureFile), arg0, arg1)
+}
@@ -0,0 +1,80 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	securityroles "github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles"
+)
+
+// MockSecurityrolesClient is a mock of Client interface.
+type MockSecurityrolesClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecurityrolesClientMockRecorder
+}
+
+// MockSecurityrolesClientMockRecorder is the mock recorder for MockSecurityrolesClient.
+type MockSecurityrolesClientMockRecorder struct {
+	mock *MockSecurityrolesClient
+}
+
+// NewMockSecurityrolesClient creates a new mock instance.
+func NewMockSecurityrolesClient(ctrl *gomock.Controller) *MockSecurityrolesClient {
+	mock := &MockSecurityrolesClient{ctrl: ctrl}
+	mock.recorder = &MockSecurityrolesClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecurityrolesClient) EXPECT() *MockSecurityrolesClientMockRecorder {
+	return m.recorder
+}
+
+// GetRoleAssignments mocks base method.
+func (m *MockSecurityrolesClient) GetRoleAssignments(arg0 context.Context, arg1 securityroles.GetRoleAssignmentsArgs) (*[]securityroles.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleAssignments", arg0, arg1)
+	ret0, _ := ret[0].(*[]securityroles.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRoleAssignments indicates an expected call of GetRoleAssignments.
+func (mr *MockSecurityrolesClientMockRecorder) GetRoleAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleAssignments", reflect.TypeOf((*MockSecurityrolesClient)(nil).GetRoleAssignments), arg0, arg1)
+}
+
+// RemoveRoleAssignments mocks base method.
+func (m *MockSecurityrolesClient) RemoveRoleAssignments(arg0 context.Context, arg1 securityroles.RemoveRoleAssignmentsArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRoleAssignments", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveRoleAssignments indicates an expected call of RemoveRoleAssignments.
+func (mr *MockSecurityrolesClientMockRecorder) RemoveRoleAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRoleAssignments", reflect.TypeOf((*MockSecurityrolesClient)(nil).RemoveRoleAssignments), arg0, arg1)
+}
+
+// SetRoleAssignments mocks base method.
+func (m *MockSecurityrolesClient) SetRoleAssignments(arg0 context.Context, arg1 securityroles.SetRoleAssignmentsArgs) (*[]securityroles.RoleAssignment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRoleAssignments", arg0, arg1)
+	ret0, _ := ret[0].(*[]securityroles.RoleAssignment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRoleAssignments indicates an expected call of SetRoleAssignments.
+func (mr *MockSecurityrolesClientMockRecorder) SetRoleAssignments(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRoleAssignments", reflect.TypeOf((*MockSecurityrolesClient)(nil).SetRoleAssignments), arg0, arg1)
+}
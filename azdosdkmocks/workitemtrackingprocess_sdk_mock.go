@@ -0,0 +1,880 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess (interfaces: Client)
+
+// Package azdosdkmocks is a generated GoMock package.
+package azdosdkmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	workitemtrackingprocess "github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+)
+
+// MockWorkitemtrackingprocessClient is a mock of Client interface.
+type MockWorkitemtrackingprocessClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockWorkitemtrackingprocessClientMockRecorder
+}
+
+// MockWorkitemtrackingprocessClientMockRecorder is the mock recorder for MockWorkitemtrackingprocessClient.
+type MockWorkitemtrackingprocessClientMockRecorder struct {
+	mock *MockWorkitemtrackingprocessClient
+}
+
+// NewMockWorkitemtrackingprocessClient creates a new mock instance.
+func NewMockWorkitemtrackingprocessClient(ctrl *gomock.Controller) *MockWorkitemtrackingprocessClient {
+	mock := &MockWorkitemtrackingprocessClient{ctrl: ctrl}
+	mock.recorder = &MockWorkitemtrackingprocessClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWorkitemtrackingprocessClient) EXPECT() *MockWorkitemtrackingprocessClientMockRecorder {
+	return m.recorder
+}
+
+// AddBehaviorToWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) AddBehaviorToWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.AddBehaviorToWorkItemTypeArgs) (*workitemtrackingprocess.WorkItemTypeBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBehaviorToWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.WorkItemTypeBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBehaviorToWorkItemType indicates an expected call of AddBehaviorToWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) AddBehaviorToWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBehaviorToWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).AddBehaviorToWorkItemType), arg0, arg1)
+}
+
+// AddFieldToWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) AddFieldToWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.AddFieldToWorkItemTypeArgs) (*workitemtrackingprocess.ProcessWorkItemTypeField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFieldToWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessWorkItemTypeField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddFieldToWorkItemType indicates an expected call of AddFieldToWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) AddFieldToWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFieldToWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).AddFieldToWorkItemType), arg0, arg1)
+}
+
+// AddGroup mocks base method.
+func (m *MockWorkitemtrackingprocessClient) AddGroup(arg0 context.Context, arg1 workitemtrackingprocess.AddGroupArgs) (*workitemtrackingprocess.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGroup", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGroup indicates an expected call of AddGroup.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) AddGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGroup", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).AddGroup), arg0, arg1)
+}
+
+// AddPage mocks base method.
+func (m *MockWorkitemtrackingprocessClient) AddPage(arg0 context.Context, arg1 workitemtrackingprocess.AddPageArgs) (*workitemtrackingprocess.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPage", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPage indicates an expected call of AddPage.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) AddPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPage", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).AddPage), arg0, arg1)
+}
+
+// AddProcessWorkItemTypeRule mocks base method.
+func (m *MockWorkitemtrackingprocessClient) AddProcessWorkItemTypeRule(arg0 context.Context, arg1 workitemtrackingprocess.AddProcessWorkItemTypeRuleArgs) (*workitemtrackingprocess.ProcessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProcessWorkItemTypeRule", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProcessWorkItemTypeRule indicates an expected call of AddProcessWorkItemTypeRule.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) AddProcessWorkItemTypeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProcessWorkItemTypeRule", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).AddProcessWorkItemTypeRule), arg0, arg1)
+}
+
+// CreateControlInGroup mocks base method.
+func (m *MockWorkitemtrackingprocessClient) CreateControlInGroup(arg0 context.Context, arg1 workitemtrackingprocess.CreateControlInGroupArgs) (*workitemtrackingprocess.Control, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateControlInGroup", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Control)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateControlInGroup indicates an expected call of CreateControlInGroup.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) CreateControlInGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateControlInGroup", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).CreateControlInGroup), arg0, arg1)
+}
+
+// CreateList mocks base method.
+func (m *MockWorkitemtrackingprocessClient) CreateList(arg0 context.Context, arg1 workitemtrackingprocess.CreateListArgs) (*workitemtrackingprocess.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) CreateList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).CreateList), arg0, arg1)
+}
+
+// CreateNewProcess mocks base method.
+func (m *MockWorkitemtrackingprocessClient) CreateNewProcess(arg0 context.Context, arg1 workitemtrackingprocess.CreateNewProcessArgs) (*workitemtrackingprocess.ProcessInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNewProcess", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNewProcess indicates an expected call of CreateNewProcess.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) CreateNewProcess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNewProcess", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).CreateNewProcess), arg0, arg1)
+}
+
+// CreateProcessBehavior mocks base method.
+func (m *MockWorkitemtrackingprocessClient) CreateProcessBehavior(arg0 context.Context, arg1 workitemtrackingprocess.CreateProcessBehaviorArgs) (*workitemtrackingprocess.ProcessBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProcessBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProcessBehavior indicates an expected call of CreateProcessBehavior.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) CreateProcessBehavior(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProcessBehavior", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).CreateProcessBehavior), arg0, arg1)
+}
+
+// CreateProcessWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) CreateProcessWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.CreateProcessWorkItemTypeArgs) (*workitemtrackingprocess.ProcessWorkItemType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProcessWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessWorkItemType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProcessWorkItemType indicates an expected call of CreateProcessWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) CreateProcessWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProcessWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).CreateProcessWorkItemType), arg0, arg1)
+}
+
+// CreateStateDefinition mocks base method.
+func (m *MockWorkitemtrackingprocessClient) CreateStateDefinition(arg0 context.Context, arg1 workitemtrackingprocess.CreateStateDefinitionArgs) (*workitemtrackingprocess.WorkItemStateResultModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStateDefinition", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.WorkItemStateResultModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStateDefinition indicates an expected call of CreateStateDefinition.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) CreateStateDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStateDefinition", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).CreateStateDefinition), arg0, arg1)
+}
+
+// DeleteList mocks base method.
+func (m *MockWorkitemtrackingprocessClient) DeleteList(arg0 context.Context, arg1 workitemtrackingprocess.DeleteListArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) DeleteList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).DeleteList), arg0, arg1)
+}
+
+// DeleteProcessBehavior mocks base method.
+func (m *MockWorkitemtrackingprocessClient) DeleteProcessBehavior(arg0 context.Context, arg1 workitemtrackingprocess.DeleteProcessBehaviorArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProcessBehavior", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProcessBehavior indicates an expected call of DeleteProcessBehavior.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) DeleteProcessBehavior(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProcessBehavior", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).DeleteProcessBehavior), arg0, arg1)
+}
+
+// DeleteProcessById mocks base method.
+func (m *MockWorkitemtrackingprocessClient) DeleteProcessById(arg0 context.Context, arg1 workitemtrackingprocess.DeleteProcessByIdArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProcessById", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProcessById indicates an expected call of DeleteProcessById.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) DeleteProcessById(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProcessById", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).DeleteProcessById), arg0, arg1)
+}
+
+// DeleteProcessWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) DeleteProcessWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.DeleteProcessWorkItemTypeArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProcessWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProcessWorkItemType indicates an expected call of DeleteProcessWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) DeleteProcessWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProcessWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).DeleteProcessWorkItemType), arg0, arg1)
+}
+
+// DeleteProcessWorkItemTypeRule mocks base method.
+func (m *MockWorkitemtrackingprocessClient) DeleteProcessWorkItemTypeRule(arg0 context.Context, arg1 workitemtrackingprocess.DeleteProcessWorkItemTypeRuleArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProcessWorkItemTypeRule", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProcessWorkItemTypeRule indicates an expected call of DeleteProcessWorkItemTypeRule.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) DeleteProcessWorkItemTypeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProcessWorkItemTypeRule", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).DeleteProcessWorkItemTypeRule), arg0, arg1)
+}
+
+// DeleteStateDefinition mocks base method.
+func (m *MockWorkitemtrackingprocessClient) DeleteStateDefinition(arg0 context.Context, arg1 workitemtrackingprocess.DeleteStateDefinitionArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStateDefinition", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteStateDefinition indicates an expected call of DeleteStateDefinition.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) DeleteStateDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStateDefinition", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).DeleteStateDefinition), arg0, arg1)
+}
+
+// DeleteSystemControl mocks base method.
+func (m *MockWorkitemtrackingprocessClient) DeleteSystemControl(arg0 context.Context, arg1 workitemtrackingprocess.DeleteSystemControlArgs) (*[]workitemtrackingprocess.Control, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSystemControl", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.Control)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSystemControl indicates an expected call of DeleteSystemControl.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) DeleteSystemControl(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSystemControl", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).DeleteSystemControl), arg0, arg1)
+}
+
+// EditProcess mocks base method.
+func (m *MockWorkitemtrackingprocessClient) EditProcess(arg0 context.Context, arg1 workitemtrackingprocess.EditProcessArgs) (*workitemtrackingprocess.ProcessInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditProcess", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditProcess indicates an expected call of EditProcess.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) EditProcess(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditProcess", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).EditProcess), arg0, arg1)
+}
+
+// GetAllWorkItemTypeFields mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetAllWorkItemTypeFields(arg0 context.Context, arg1 workitemtrackingprocess.GetAllWorkItemTypeFieldsArgs) (*[]workitemtrackingprocess.ProcessWorkItemTypeField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllWorkItemTypeFields", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.ProcessWorkItemTypeField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllWorkItemTypeFields indicates an expected call of GetAllWorkItemTypeFields.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetAllWorkItemTypeFields(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWorkItemTypeFields", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetAllWorkItemTypeFields), arg0, arg1)
+}
+
+// GetBehaviorForWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetBehaviorForWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.GetBehaviorForWorkItemTypeArgs) (*workitemtrackingprocess.WorkItemTypeBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBehaviorForWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.WorkItemTypeBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBehaviorForWorkItemType indicates an expected call of GetBehaviorForWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetBehaviorForWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBehaviorForWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetBehaviorForWorkItemType), arg0, arg1)
+}
+
+// GetBehaviorsForWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetBehaviorsForWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.GetBehaviorsForWorkItemTypeArgs) (*[]workitemtrackingprocess.WorkItemTypeBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBehaviorsForWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.WorkItemTypeBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBehaviorsForWorkItemType indicates an expected call of GetBehaviorsForWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetBehaviorsForWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBehaviorsForWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetBehaviorsForWorkItemType), arg0, arg1)
+}
+
+// GetFormLayout mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetFormLayout(arg0 context.Context, arg1 workitemtrackingprocess.GetFormLayoutArgs) (*workitemtrackingprocess.FormLayout, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFormLayout", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.FormLayout)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFormLayout indicates an expected call of GetFormLayout.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetFormLayout(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFormLayout", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetFormLayout), arg0, arg1)
+}
+
+// GetList mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetList(arg0 context.Context, arg1 workitemtrackingprocess.GetListArgs) (*workitemtrackingprocess.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetList), arg0, arg1)
+}
+
+// GetListOfProcesses mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetListOfProcesses(arg0 context.Context, arg1 workitemtrackingprocess.GetListOfProcessesArgs) (*[]workitemtrackingprocess.ProcessInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListOfProcesses", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.ProcessInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListOfProcesses indicates an expected call of GetListOfProcesses.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetListOfProcesses(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListOfProcesses", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetListOfProcesses), arg0, arg1)
+}
+
+// GetListsMetadata mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetListsMetadata(arg0 context.Context, arg1 workitemtrackingprocess.GetListsMetadataArgs) (*[]workitemtrackingprocess.PickListMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListsMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.PickListMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListsMetadata indicates an expected call of GetListsMetadata.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetListsMetadata(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListsMetadata", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetListsMetadata), arg0, arg1)
+}
+
+// GetProcessBehavior mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetProcessBehavior(arg0 context.Context, arg1 workitemtrackingprocess.GetProcessBehaviorArgs) (*workitemtrackingprocess.ProcessBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessBehavior indicates an expected call of GetProcessBehavior.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetProcessBehavior(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessBehavior", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetProcessBehavior), arg0, arg1)
+}
+
+// GetProcessBehaviors mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetProcessBehaviors(arg0 context.Context, arg1 workitemtrackingprocess.GetProcessBehaviorsArgs) (*[]workitemtrackingprocess.ProcessBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessBehaviors", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.ProcessBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessBehaviors indicates an expected call of GetProcessBehaviors.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetProcessBehaviors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessBehaviors", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetProcessBehaviors), arg0, arg1)
+}
+
+// GetProcessByItsId mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetProcessByItsId(arg0 context.Context, arg1 workitemtrackingprocess.GetProcessByItsIdArgs) (*workitemtrackingprocess.ProcessInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessByItsId", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessByItsId indicates an expected call of GetProcessByItsId.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetProcessByItsId(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessByItsId", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetProcessByItsId), arg0, arg1)
+}
+
+// GetProcessWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetProcessWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.GetProcessWorkItemTypeArgs) (*workitemtrackingprocess.ProcessWorkItemType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessWorkItemType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessWorkItemType indicates an expected call of GetProcessWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetProcessWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetProcessWorkItemType), arg0, arg1)
+}
+
+// GetProcessWorkItemTypeRule mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetProcessWorkItemTypeRule(arg0 context.Context, arg1 workitemtrackingprocess.GetProcessWorkItemTypeRuleArgs) (*workitemtrackingprocess.ProcessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessWorkItemTypeRule", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessWorkItemTypeRule indicates an expected call of GetProcessWorkItemTypeRule.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetProcessWorkItemTypeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessWorkItemTypeRule", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetProcessWorkItemTypeRule), arg0, arg1)
+}
+
+// GetProcessWorkItemTypeRules mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetProcessWorkItemTypeRules(arg0 context.Context, arg1 workitemtrackingprocess.GetProcessWorkItemTypeRulesArgs) (*[]workitemtrackingprocess.ProcessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessWorkItemTypeRules", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.ProcessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessWorkItemTypeRules indicates an expected call of GetProcessWorkItemTypeRules.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetProcessWorkItemTypeRules(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessWorkItemTypeRules", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetProcessWorkItemTypeRules), arg0, arg1)
+}
+
+// GetProcessWorkItemTypes mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetProcessWorkItemTypes(arg0 context.Context, arg1 workitemtrackingprocess.GetProcessWorkItemTypesArgs) (*[]workitemtrackingprocess.ProcessWorkItemType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProcessWorkItemTypes", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.ProcessWorkItemType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProcessWorkItemTypes indicates an expected call of GetProcessWorkItemTypes.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetProcessWorkItemTypes(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProcessWorkItemTypes", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetProcessWorkItemTypes), arg0, arg1)
+}
+
+// GetStateDefinition mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetStateDefinition(arg0 context.Context, arg1 workitemtrackingprocess.GetStateDefinitionArgs) (*workitemtrackingprocess.WorkItemStateResultModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStateDefinition", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.WorkItemStateResultModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateDefinition indicates an expected call of GetStateDefinition.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetStateDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateDefinition", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetStateDefinition), arg0, arg1)
+}
+
+// GetStateDefinitions mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetStateDefinitions(arg0 context.Context, arg1 workitemtrackingprocess.GetStateDefinitionsArgs) (*[]workitemtrackingprocess.WorkItemStateResultModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStateDefinitions", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.WorkItemStateResultModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStateDefinitions indicates an expected call of GetStateDefinitions.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetStateDefinitions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStateDefinitions", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetStateDefinitions), arg0, arg1)
+}
+
+// GetSystemControls mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetSystemControls(arg0 context.Context, arg1 workitemtrackingprocess.GetSystemControlsArgs) (*[]workitemtrackingprocess.Control, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSystemControls", arg0, arg1)
+	ret0, _ := ret[0].(*[]workitemtrackingprocess.Control)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSystemControls indicates an expected call of GetSystemControls.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetSystemControls(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemControls", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetSystemControls), arg0, arg1)
+}
+
+// GetWorkItemTypeField mocks base method.
+func (m *MockWorkitemtrackingprocessClient) GetWorkItemTypeField(arg0 context.Context, arg1 workitemtrackingprocess.GetWorkItemTypeFieldArgs) (*workitemtrackingprocess.ProcessWorkItemTypeField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWorkItemTypeField", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessWorkItemTypeField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWorkItemTypeField indicates an expected call of GetWorkItemTypeField.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) GetWorkItemTypeField(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWorkItemTypeField", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).GetWorkItemTypeField), arg0, arg1)
+}
+
+// HideStateDefinition mocks base method.
+func (m *MockWorkitemtrackingprocessClient) HideStateDefinition(arg0 context.Context, arg1 workitemtrackingprocess.HideStateDefinitionArgs) (*workitemtrackingprocess.WorkItemStateResultModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HideStateDefinition", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.WorkItemStateResultModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HideStateDefinition indicates an expected call of HideStateDefinition.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) HideStateDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HideStateDefinition", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).HideStateDefinition), arg0, arg1)
+}
+
+// MoveControlToGroup mocks base method.
+func (m *MockWorkitemtrackingprocessClient) MoveControlToGroup(arg0 context.Context, arg1 workitemtrackingprocess.MoveControlToGroupArgs) (*workitemtrackingprocess.Control, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveControlToGroup", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Control)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveControlToGroup indicates an expected call of MoveControlToGroup.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) MoveControlToGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveControlToGroup", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).MoveControlToGroup), arg0, arg1)
+}
+
+// MoveGroupToPage mocks base method.
+func (m *MockWorkitemtrackingprocessClient) MoveGroupToPage(arg0 context.Context, arg1 workitemtrackingprocess.MoveGroupToPageArgs) (*workitemtrackingprocess.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveGroupToPage", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveGroupToPage indicates an expected call of MoveGroupToPage.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) MoveGroupToPage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveGroupToPage", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).MoveGroupToPage), arg0, arg1)
+}
+
+// MoveGroupToSection mocks base method.
+func (m *MockWorkitemtrackingprocessClient) MoveGroupToSection(arg0 context.Context, arg1 workitemtrackingprocess.MoveGroupToSectionArgs) (*workitemtrackingprocess.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveGroupToSection", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveGroupToSection indicates an expected call of MoveGroupToSection.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) MoveGroupToSection(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveGroupToSection", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).MoveGroupToSection), arg0, arg1)
+}
+
+// RemoveBehaviorFromWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) RemoveBehaviorFromWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.RemoveBehaviorFromWorkItemTypeArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveBehaviorFromWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveBehaviorFromWorkItemType indicates an expected call of RemoveBehaviorFromWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) RemoveBehaviorFromWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveBehaviorFromWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).RemoveBehaviorFromWorkItemType), arg0, arg1)
+}
+
+// RemoveControlFromGroup mocks base method.
+func (m *MockWorkitemtrackingprocessClient) RemoveControlFromGroup(arg0 context.Context, arg1 workitemtrackingprocess.RemoveControlFromGroupArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveControlFromGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveControlFromGroup indicates an expected call of RemoveControlFromGroup.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) RemoveControlFromGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveControlFromGroup", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).RemoveControlFromGroup), arg0, arg1)
+}
+
+// RemoveGroup mocks base method.
+func (m *MockWorkitemtrackingprocessClient) RemoveGroup(arg0 context.Context, arg1 workitemtrackingprocess.RemoveGroupArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGroup", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveGroup indicates an expected call of RemoveGroup.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) RemoveGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGroup", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).RemoveGroup), arg0, arg1)
+}
+
+// RemovePage mocks base method.
+func (m *MockWorkitemtrackingprocessClient) RemovePage(arg0 context.Context, arg1 workitemtrackingprocess.RemovePageArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePage", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePage indicates an expected call of RemovePage.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) RemovePage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePage", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).RemovePage), arg0, arg1)
+}
+
+// RemoveWorkItemTypeField mocks base method.
+func (m *MockWorkitemtrackingprocessClient) RemoveWorkItemTypeField(arg0 context.Context, arg1 workitemtrackingprocess.RemoveWorkItemTypeFieldArgs) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveWorkItemTypeField", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveWorkItemTypeField indicates an expected call of RemoveWorkItemTypeField.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) RemoveWorkItemTypeField(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveWorkItemTypeField", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).RemoveWorkItemTypeField), arg0, arg1)
+}
+
+// UpdateBehaviorToWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateBehaviorToWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.UpdateBehaviorToWorkItemTypeArgs) (*workitemtrackingprocess.WorkItemTypeBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBehaviorToWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.WorkItemTypeBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBehaviorToWorkItemType indicates an expected call of UpdateBehaviorToWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateBehaviorToWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBehaviorToWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateBehaviorToWorkItemType), arg0, arg1)
+}
+
+// UpdateControl mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateControl(arg0 context.Context, arg1 workitemtrackingprocess.UpdateControlArgs) (*workitemtrackingprocess.Control, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateControl", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Control)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateControl indicates an expected call of UpdateControl.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateControl(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateControl", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateControl), arg0, arg1)
+}
+
+// UpdateGroup mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateGroup(arg0 context.Context, arg1 workitemtrackingprocess.UpdateGroupArgs) (*workitemtrackingprocess.Group, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroup", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Group)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGroup indicates an expected call of UpdateGroup.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateGroup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateGroup), arg0, arg1)
+}
+
+// UpdateList mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateList(arg0 context.Context, arg1 workitemtrackingprocess.UpdateListArgs) (*workitemtrackingprocess.PickList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.PickList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateList(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateList), arg0, arg1)
+}
+
+// UpdatePage mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdatePage(arg0 context.Context, arg1 workitemtrackingprocess.UpdatePageArgs) (*workitemtrackingprocess.Page, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePage", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Page)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePage indicates an expected call of UpdatePage.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdatePage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePage", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdatePage), arg0, arg1)
+}
+
+// UpdateProcessBehavior mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateProcessBehavior(arg0 context.Context, arg1 workitemtrackingprocess.UpdateProcessBehaviorArgs) (*workitemtrackingprocess.ProcessBehavior, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProcessBehavior", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessBehavior)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProcessBehavior indicates an expected call of UpdateProcessBehavior.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateProcessBehavior(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessBehavior", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateProcessBehavior), arg0, arg1)
+}
+
+// UpdateProcessWorkItemType mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateProcessWorkItemType(arg0 context.Context, arg1 workitemtrackingprocess.UpdateProcessWorkItemTypeArgs) (*workitemtrackingprocess.ProcessWorkItemType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProcessWorkItemType", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessWorkItemType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProcessWorkItemType indicates an expected call of UpdateProcessWorkItemType.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateProcessWorkItemType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessWorkItemType", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateProcessWorkItemType), arg0, arg1)
+}
+
+// UpdateProcessWorkItemTypeRule mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateProcessWorkItemTypeRule(arg0 context.Context, arg1 workitemtrackingprocess.UpdateProcessWorkItemTypeRuleArgs) (*workitemtrackingprocess.ProcessRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProcessWorkItemTypeRule", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProcessWorkItemTypeRule indicates an expected call of UpdateProcessWorkItemTypeRule.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateProcessWorkItemTypeRule(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProcessWorkItemTypeRule", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateProcessWorkItemTypeRule), arg0, arg1)
+}
+
+// UpdateStateDefinition mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateStateDefinition(arg0 context.Context, arg1 workitemtrackingprocess.UpdateStateDefinitionArgs) (*workitemtrackingprocess.WorkItemStateResultModel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStateDefinition", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.WorkItemStateResultModel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStateDefinition indicates an expected call of UpdateStateDefinition.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateStateDefinition(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStateDefinition", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateStateDefinition), arg0, arg1)
+}
+
+// UpdateSystemControl mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateSystemControl(arg0 context.Context, arg1 workitemtrackingprocess.UpdateSystemControlArgs) (*workitemtrackingprocess.Control, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSystemControl", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.Control)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSystemControl indicates an expected call of UpdateSystemControl.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateSystemControl(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSystemControl", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateSystemControl), arg0, arg1)
+}
+
+// UpdateWorkItemTypeField mocks base method.
+func (m *MockWorkitemtrackingprocessClient) UpdateWorkItemTypeField(arg0 context.Context, arg1 workitemtrackingprocess.UpdateWorkItemTypeFieldArgs) (*workitemtrackingprocess.ProcessWorkItemTypeField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorkItemTypeField", arg0, arg1)
+	ret0, _ := ret[0].(*workitemtrackingprocess.ProcessWorkItemTypeField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWorkItemTypeField indicates an expected call of UpdateWorkItemTypeField.
+func (mr *MockWorkitemtrackingprocessClientMockRecorder) UpdateWorkItemTypeField(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorkItemTypeField", reflect.TypeOf((*MockWorkitemtrackingprocessClient)(nil).UpdateWorkItemTypeField), arg0, arg1)
+}
@@ -0,0 +1,34 @@
+//go:build (all || data_sources || data_build_queue_position) && (!exclude_data_sources || !exclude_data_build_queue_position)
+// +build all data_sources data_build_queue_position
+// +build !exclude_data_sources !exclude_data_build_queue_position
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccBuildQueuePosition_DataSource(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	queuePositionData := testutils.HclBuildQueuePositionDataSource(projectName, "Azure Pipelines")
+
+	tfNode := "data.azuredevops_build_queue_position.position"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: queuePositionData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "pending_count", "0"),
+					resource.TestCheckResourceAttr(tfNode, "running_count", "0"),
+					resource.TestCheckResourceAttr(tfNode, "oldest_pending_queue_time", ""),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,65 @@
+//go:build (all || data_sources || data_serviceendpoint) && (!exclude_data_sources || !exclude_data_serviceendpoint)
+// +build all data_sources data_serviceendpoint
+// +build !exclude_data_sources !exclude_data_serviceendpoint
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccServiceEndpoint_with_serviceEndpointID_DataSource(t *testing.T) {
+	serviceEndpointName := testutils.GenerateResourceName()
+	projectName := testutils.GenerateResourceName()
+	createServiceEndpointWithServiceEndpointIDData := fmt.Sprintf("%s\n%s",
+		testutils.HclServiceEndpointGitHubResource(projectName, serviceEndpointName),
+		testutils.HclServiceEndpointDataSourceWithServiceEndpointID(),
+	)
+
+	tfNode := "data.azuredevops_serviceendpoint.serviceendpoint"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: createServiceEndpointWithServiceEndpointIDData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "service_endpoint_name", serviceEndpointName),
+					resource.TestCheckResourceAttr(tfNode, "type", "github"),
+					resource.TestCheckResourceAttr(tfNode, "authorization_scheme", "PersonalAccessToken"),
+					resource.TestCheckResourceAttrSet(tfNode, "service_endpoint_id"),
+					resource.TestCheckResourceAttrSet(tfNode, "url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceEndpoint_with_serviceEndpointName_DataSource(t *testing.T) {
+	serviceEndpointName := testutils.GenerateResourceName()
+	projectName := testutils.GenerateResourceName()
+	createServiceEndpointWithServiceEndpointNameData := fmt.Sprintf("%s\n%s",
+		testutils.HclServiceEndpointGitHubResource(projectName, serviceEndpointName),
+		testutils.HclServiceEndpointDataSourceWithServiceEndpointName(serviceEndpointName),
+	)
+
+	tfNode := "data.azuredevops_serviceendpoint.serviceendpoint"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: createServiceEndpointWithServiceEndpointNameData,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "service_endpoint_name", serviceEndpointName),
+					resource.TestCheckResourceAttr(tfNode, "type", "github"),
+					resource.TestCheckResourceAttrSet(tfNode, "service_endpoint_id"),
+				),
+			},
+		},
+	})
+}
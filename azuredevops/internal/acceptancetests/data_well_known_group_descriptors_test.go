@@ -0,0 +1,38 @@
+//go:build (all || core || data_sources || data_well_known_group_descriptors) && (!exclude_data_sources || !exclude_data_well_known_group_descriptors)
+// +build all core data_sources data_well_known_group_descriptors
+// +build !exclude_data_sources !exclude_data_well_known_group_descriptors
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// Validates that the descriptors of all well-known groups of a new project can be read
+func TestAccWellKnownGroupDescriptorsDataSource_Read_HappyPath(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	tfNode := "data.azuredevops_well_known_group_descriptors.groups"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclWellKnownGroupDescriptorsDataSource(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_collection_administrators"),
+					resource.TestCheckResourceAttrSet(tfNode, "project_collection_valid_users"),
+					resource.TestCheckResourceAttrSet(tfNode, "project_collection_build_service_accounts"),
+					resource.TestCheckResourceAttrSet(tfNode, "project_administrators"),
+					resource.TestCheckResourceAttrSet(tfNode, "project_valid_users"),
+					resource.TestCheckResourceAttrSet(tfNode, "contributors"),
+					resource.TestCheckResourceAttrSet(tfNode, "readers"),
+					resource.TestCheckResourceAttrSet(tfNode, "build_administrators"),
+				),
+			},
+		},
+	})
+}
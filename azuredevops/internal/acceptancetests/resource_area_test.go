@@ -0,0 +1,72 @@
+//go:build (all || core || resource_area) && !exclude_resource_area
+// +build all core resource_area
+// +build !exclude_resource_area
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclAreaResource(projectName string, areaName string, parentPath string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_area" "parent" {
+  project_id = azuredevops_project.project.id
+  name       = "parent"
+}
+
+resource "azuredevops_area" "area" {
+  project_id  = azuredevops_project.project.id
+  name        = "%s"
+  parent_path = "%s"
+
+  depends_on = [azuredevops_area.parent]
+}
+`, testutils.HclProjectResource(projectName), areaName, parentPath)
+}
+
+func TestAccArea_CreateRenameAndMove(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	areaName := testutils.GenerateResourceName()
+	areaName2 := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_area.area"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclAreaResource(projectName, areaName, "/"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "name", areaName),
+					resource.TestCheckResourceAttr(tfNode, "parent_path", "/"),
+					resource.TestCheckResourceAttr(tfNode, "path", "/"+areaName),
+					resource.TestCheckResourceAttr(tfNode, "has_children", "false"),
+				),
+			},
+			{
+				Config: hclAreaResource(projectName, areaName2, "/parent"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", areaName2),
+					resource.TestCheckResourceAttr(tfNode, "parent_path", "/parent"),
+					resource.TestCheckResourceAttr(tfNode, "path", "/parent/"+areaName2),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
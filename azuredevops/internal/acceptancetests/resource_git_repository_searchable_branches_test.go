@@ -0,0 +1,48 @@
+//go:build (all || core || resource_git_repository_searchable_branches) && !exclude_resource_git_repository_searchable_branches
+// +build all core resource_git_repository_searchable_branches
+// +build !exclude_resource_git_repository_searchable_branches
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// TestAccGitRepositorySearchableBranches_CreateAndUpdate verifies that the searchable branches
+// of a repository can be set and changed
+func TestAccGitRepositorySearchableBranches_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	gitRepoName := testutils.GenerateResourceName()
+	tfNode := "azuredevops_git_repository_searchable_branches.branches"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclGitRepositorySearchableBranches(projectName, gitRepoName, []string{"master"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "repository_id"),
+					resource.TestCheckResourceAttr(tfNode, "branches.#", "1"),
+					resource.TestCheckResourceAttr(tfNode, "branches.0", "master"),
+				),
+			},
+			{
+				Config: testutils.HclGitRepositorySearchableBranches(projectName, gitRepoName, []string{"master", "releases/v1"}),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "branches.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "branches.1", "releases/v1"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
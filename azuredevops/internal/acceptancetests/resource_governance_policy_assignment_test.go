@@ -0,0 +1,46 @@
+//go:build (all || resource_governance_policy_assignment) && !exclude_resource_governance_policy_assignment
+// +build all resource_governance_policy_assignment
+// +build !exclude_resource_governance_policy_assignment
+
+package acceptancetests
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+// The test requires an extension installed in the organization, which is identified by
+// AZDO_TEST_EXTENSION_PUBLISHER and AZDO_TEST_EXTENSION_NAME
+func TestAccGovernancePolicyAssignment_CreateAndUpdate(t *testing.T) {
+	publisherName := os.Getenv("AZDO_TEST_EXTENSION_PUBLISHER")
+	extensionName := os.Getenv("AZDO_TEST_EXTENSION_NAME")
+	documentID := testutils.GenerateResourceName()
+	tfNode := "azuredevops_governance_policy_assignment.assignment"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, &[]string{"AZDO_TEST_EXTENSION_PUBLISHER", "AZDO_TEST_EXTENSION_NAME"}) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclGovernancePolicyAssignment(publisherName, extensionName, documentID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "document_id", documentID),
+					resource.TestCheckResourceAttr(tfNode, "scope_type", "Default"),
+					resource.TestCheckResourceAttr(tfNode, "document", `{"enabled":true}`),
+				),
+			}, {
+				Config: testutils.HclGovernancePolicyAssignment(publisherName, extensionName, documentID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "document", `{"enabled":false}`),
+				),
+			}, {
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
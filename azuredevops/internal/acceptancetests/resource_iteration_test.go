@@ -0,0 +1,71 @@
+//go:build (all || core || resource_iteration) && !exclude_resource_iteration
+// +build all core resource_iteration
+// +build !exclude_resource_iteration
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclIterationResource(projectName string, iterationName string, dates string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_iteration" "release" {
+  project_id = azuredevops_project.project.id
+  name       = "release"
+}
+
+resource "azuredevops_iteration" "iteration" {
+  project_id  = azuredevops_project.project.id
+  name        = "%s"
+  parent_path = azuredevops_iteration.release.path
+  %s
+}
+`, testutils.HclProjectResource(projectName), iterationName, dates)
+}
+
+func TestAccIteration_CreateAndUpdateDates(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	iterationName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_iteration.iteration"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclIterationResource(projectName, iterationName, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", iterationName),
+					resource.TestCheckResourceAttr(tfNode, "parent_path", "/release"),
+					resource.TestCheckResourceAttr(tfNode, "path", "/release/"+iterationName),
+					resource.TestCheckResourceAttr(tfNode, "start_date", ""),
+					resource.TestCheckResourceAttr(tfNode, "finish_date", ""),
+				),
+			},
+			{
+				Config: hclIterationResource(projectName, iterationName, `
+  start_date  = "2023-01-02"
+  finish_date = "2023-01-13"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "start_date", "2023-01-02"),
+					resource.TestCheckResourceAttr(tfNode, "finish_date", "2023-01-13"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportStateIdFunc: testutils.ComputeProjectQualifiedResourceImportID(tfNode),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,43 @@
+//go:build (all || permissions || resource_permissions_baseline) && (!exclude_permissions || !exclude_resource_permissions_baseline)
+// +build all permissions resource_permissions_baseline
+// +build !exclude_permissions !exclude_resource_permissions_baseline
+
+package acceptancetests
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccPermissionsBaseline_ApplyAndRelax(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_permissions_baseline.baseline"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testutils.PreCheck(t, nil) },
+		ProviderFactories: testutils.GetProviderFactories(),
+		CheckDestroy:      testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: testutils.HclPermissionsBaseline(projectName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testutils.CheckProjectExists(projectName),
+					resource.TestCheckResourceAttrSet(tfNode, "principal"),
+					resource.TestCheckResourceAttr(tfNode, "deny_force_push", "true"),
+					resource.TestCheckResourceAttr(tfNode, "deny_build_definition_deletion", "true"),
+					resource.TestCheckResourceAttrSet(tfNode, "changes.#"),
+				),
+			},
+			{
+				Config: testutils.HclPermissionsBaseline(projectName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "deny_force_push", "true"),
+					resource.TestCheckResourceAttr(tfNode, "deny_build_definition_deletion", "false"),
+					resource.TestCheckResourceAttr(tfNode, "changes.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,57 @@
+//go:build (all || resource_process_picklist) && !exclude_resource_process_picklist
+// +build all resource_process_picklist
+// +build !exclude_resource_process_picklist
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclProcessPicklistResource(picklistName string, items string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_process_picklist" "picklist" {
+  name         = "%s"
+  items        = [%s]
+  is_suggested = true
+}
+`, picklistName, items)
+}
+
+func TestAccProcessPicklist_CreateAndUpdate(t *testing.T) {
+	picklistName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_process_picklist.picklist"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclProcessPicklistResource(picklistName, `"Gold", "Silver"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttr(tfNode, "name", picklistName),
+					resource.TestCheckResourceAttr(tfNode, "type", "String"),
+					resource.TestCheckResourceAttr(tfNode, "is_suggested", "true"),
+					resource.TestCheckResourceAttr(tfNode, "items.#", "2"),
+				),
+			},
+			{
+				Config: hclProcessPicklistResource(picklistName, `"Gold", "Silver", "Bronze"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "items.#", "3"),
+					resource.TestCheckResourceAttr(tfNode, "items.2", "Bronze"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,60 @@
+//go:build (all || resource_process) && !exclude_resource_process
+// +build all resource_process
+// +build !exclude_resource_process
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclProcessResource(processName string, description string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_process" "process" {
+  name                   = "%s"
+  description            = "%s"
+  parent_process_type_id = "adcc42ab-9882-485e-a3ed-7678f01f66bc"
+}
+`, processName, description)
+}
+
+func TestAccProcess_CreateAndUpdate(t *testing.T) {
+	processName := testutils.GenerateResourceName()
+	processName2 := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_process.process"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclProcessResource(processName, "description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "reference_name"),
+					resource.TestCheckResourceAttr(tfNode, "name", processName),
+					resource.TestCheckResourceAttr(tfNode, "description", "description"),
+					resource.TestCheckResourceAttr(tfNode, "is_enabled", "true"),
+					resource.TestCheckResourceAttr(tfNode, "is_default", "false"),
+					resource.TestCheckResourceAttr(tfNode, "customization_type", "inherited"),
+				),
+			},
+			{
+				Config: hclProcessResource(processName2, "updated description"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "name", processName2),
+					resource.TestCheckResourceAttr(tfNode, "description", "updated description"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,60 @@
+//go:build (all || core || resource_project_properties) && !exclude_resource_project_properties
+// +build all core resource_project_properties
+// +build !exclude_resource_project_properties
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclProjectProperties(projectName string, properties string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azuredevops_project_properties" "properties" {
+  project_id = azuredevops_project.project.id
+  properties = {
+%s
+  }
+}
+`, testutils.HclProjectResource(projectName), properties)
+}
+
+func TestAccProjectProperties_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_project_properties.properties"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: hclProjectProperties(projectName, `
+    CostCenter = "1234"
+    Owner      = "platform"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "project_id"),
+					resource.TestCheckResourceAttr(tfNode, "properties.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "properties.CostCenter", "1234"),
+					resource.TestCheckResourceAttr(tfNode, "properties.Owner", "platform"),
+				),
+			},
+			{
+				Config: hclProjectProperties(projectName, `
+    Owner = "security"
+    Tier  = "1"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "properties.%", "2"),
+					resource.TestCheckResourceAttr(tfNode, "properties.Owner", "security"),
+					resource.TestCheckResourceAttr(tfNode, "properties.Tier", "1"),
+				),
+			},
+		},
+	})
+}
@@ -1,6 +1,6 @@
-//go:build (all || resource_policy_check_credentials) && !resource_policy_check_credentials
+//go:build (all || resource_policy_check_credentials) && !exclude_resource_policy_check_credentials
 // +build all resource_policy_check_credentials
-// +build !resource_policy_check_credentials
+// +build !exclude_resource_policy_check_credentials
 
 package acceptancetests
 
@@ -0,0 +1,81 @@
+//go:build (all || core || resource_team_iterations) && !exclude_resource_team_iterations
+// +build all core resource_team_iterations
+// +build !exclude_resource_team_iterations
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func TestAccTeamIterations_CreateAndUpdate(t *testing.T) {
+	projectName := testutils.GenerateResourceName()
+	teamName := testutils.GenerateResourceName()
+	teamConfig := fmt.Sprintf(`
+%s
+
+resource "azuredevops_iteration" "sprint1" {
+  project_id  = azuredevops_project.project.id
+  name        = "sprint1"
+  start_date  = "2023-01-02"
+  finish_date = "2023-01-13"
+}
+
+resource "azuredevops_iteration" "sprint2" {
+  project_id  = azuredevops_project.project.id
+  name        = "sprint2"
+  start_date  = "2023-01-16"
+  finish_date = "2023-01-27"
+}
+`, testutils.HclTeamConfiguration(projectName, teamName, "", nil, nil))
+
+	config1 := fmt.Sprintf(`
+%s
+
+resource "azuredevops_team_iterations" "iterations" {
+  project_id           = azuredevops_project.project.id
+  team_id              = azuredevops_team.team.id
+  iteration_ids        = [azuredevops_iteration.sprint1.identifier]
+  default_iteration_id = azuredevops_iteration.sprint1.identifier
+}
+`, teamConfig)
+
+	config2 := fmt.Sprintf(`
+%s
+
+resource "azuredevops_team_iterations" "iterations" {
+  project_id              = azuredevops_project.project.id
+  team_id                 = azuredevops_team.team.id
+  iteration_ids           = [azuredevops_iteration.sprint1.identifier, azuredevops_iteration.sprint2.identifier]
+  default_iteration_macro = "@currentIteration"
+}
+`, teamConfig)
+
+	tfNode := "azuredevops_team_iterations.iterations"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { testutils.PreCheck(t, nil) },
+		Providers:    testutils.GetProviders(),
+		CheckDestroy: testutils.CheckProjectDestroyed,
+		Steps: []resource.TestStep{
+			{
+				Config: config1,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "backlog_iteration_id"),
+					resource.TestCheckResourceAttr(tfNode, "iteration_ids.#", "1"),
+					resource.TestCheckResourceAttrPair(tfNode, "default_iteration_id", "azuredevops_iteration.sprint1", "identifier"),
+				),
+			},
+			{
+				Config: config2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(tfNode, "iteration_ids.#", "2"),
+					resource.TestCheckResourceAttr(tfNode, "default_iteration_macro", "@currentIteration"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+//go:build (all || resource_workitem_field) && !exclude_resource_workitem_field
+// +build all resource_workitem_field
+// +build !exclude_resource_workitem_field
+
+package acceptancetests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+)
+
+func hclWorkItemFieldResource(fieldName string) string {
+	return fmt.Sprintf(`
+resource "azuredevops_workitem_field" "field" {
+  name        = "%s"
+  type        = "string"
+  description = "Managed by Terraform"
+}
+`, fieldName)
+}
+
+func TestAccWorkItemField_CreateAndImport(t *testing.T) {
+	fieldName := testutils.GenerateResourceName()
+
+	tfNode := "azuredevops_workitem_field.field"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:  func() { testutils.PreCheck(t, nil) },
+		Providers: testutils.GetProviders(),
+		Steps: []resource.TestStep{
+			{
+				Config: hclWorkItemFieldResource(fieldName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(tfNode, "id"),
+					resource.TestCheckResourceAttrSet(tfNode, "reference_name"),
+					resource.TestCheckResourceAttr(tfNode, "name", fieldName),
+					resource.TestCheckResourceAttr(tfNode, "type", "string"),
+					resource.TestCheckResourceAttr(tfNode, "description", "Managed by Terraform"),
+				),
+			},
+			{
+				ResourceName:      tfNode,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,70 @@
+//go:build sweep
+// +build sweep
+
+package acceptancetests
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/acceptancetests/testutils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// TestMain runs the sweepers if the tests are invoked with -sweep, e.g.
+//
+//	AZDO_TEST_RUN_ID=run42 go test ./azuredevops/internal/acceptancetests -tags sweep -sweep=all -v
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("azuredevops_project", &resource.Sweeper{
+		Name: "azuredevops_project",
+		F:    sweepProjects,
+	})
+}
+
+// sweepProjects deletes all projects created by acceptance tests. Deleting a project removes every object
+// which the tests created inside of it. If AZDO_TEST_RUN_ID is set, only the projects of that run are deleted.
+func sweepProjects(_ string) error {
+	clients, err := client.GetAzdoClient(os.Getenv("AZDO_PERSONAL_ACCESS_TOKEN"), os.Getenv("AZDO_ORG_SERVICE_URL"), "sweeper")
+	if err != nil {
+		return fmt.Errorf(" creating Azure DevOps client: %+v", err)
+	}
+
+	prefix := testutils.GetResourceNamePrefix()
+	var continuationToken *string
+	for {
+		projects, err := clients.CoreClient.GetProjects(clients.Ctx, core.GetProjectsArgs{
+			StateFilter:       &core.ProjectStateValues.All,
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return fmt.Errorf(" listing projects: %+v", err)
+		}
+
+		for _, project := range projects.Value {
+			if project.Name == nil || !strings.HasPrefix(*project.Name, prefix) {
+				continue
+			}
+			log.Printf("[INFO] Deleting project %s (%s)", *project.Name, project.Id.String())
+			if _, err := clients.CoreClient.QueueDeleteProject(clients.Ctx, core.QueueDeleteProjectArgs{
+				ProjectId: project.Id,
+			}); err != nil {
+				return fmt.Errorf(" deleting project %s: %+v", *project.Name, err)
+			}
+		}
+
+		if projects.ContinuationToken == "" {
+			return nil
+		}
+		continuationToken = converter.String(projects.ContinuationToken)
+	}
+}
@@ -69,9 +69,19 @@ func PreCheck(t *testing.T, additionalEnvVars *[]string) {
 	}
 }
 
+// GetResourceNamePrefix returns the prefix of all names generated by GenerateResourceName. If AZDO_TEST_RUN_ID
+// is set, the run ID is part of the prefix, so parallel test runs against a shared organization can be told apart
+// and cleaned up independently
+func GetResourceNamePrefix() string {
+	if runID := strings.TrimSpace(os.Getenv("AZDO_TEST_RUN_ID")); runID != "" {
+		return "test-acc-" + runID + "-"
+	}
+	return "test-acc-"
+}
+
 // GenerateResourceName generates a random name with a constant prefix, useful for acceptance tests
 func GenerateResourceName() string {
-	return "test-acc-" + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	return GetResourceNamePrefix() + acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
 }
 
 // CheckNestedKeyExistsWithValue checks if a property exists with a certain value in an instance state
@@ -275,6 +275,27 @@ data "azuredevops_serviceendpoint_github" "serviceendpoint" {
 	return fmt.Sprintf("%s", serviceEndpointDataSource)
 }
 
+// HclServiceEndpointDataSourceWithServiceEndpointID HCL describing a data source for an AzDO service endpoint of any type
+func HclServiceEndpointDataSourceWithServiceEndpointID() string {
+	return `
+data "azuredevops_serviceendpoint" "serviceendpoint" {
+  project_id          = azuredevops_project.project.id
+  service_endpoint_id = azuredevops_serviceendpoint_github.serviceendpoint.id
+}
+`
+}
+
+// HclServiceEndpointDataSourceWithServiceEndpointName HCL describing a data source for an AzDO service endpoint of any type
+func HclServiceEndpointDataSourceWithServiceEndpointName(serviceEndpointName string) string {
+	return fmt.Sprintf(`
+data "azuredevops_serviceendpoint" "serviceendpoint" {
+  project_id            = azuredevops_project.project.id
+  service_endpoint_name = "%s"
+  depends_on            = [azuredevops_serviceendpoint_github.serviceendpoint]
+}
+`, serviceEndpointName)
+}
+
 func HclServiceEndpointGitHubEnterpriseResource(projectName string, serviceEndpointName string) string {
 	serviceEndpointResource := fmt.Sprintf(`
 resource "azuredevops_serviceendpoint_github_enterprise" "serviceendpoint" {
@@ -680,6 +701,17 @@ data "azuredevops_agent_queue" "queue" {
 }`, HclProjectResource(projectName), queueName)
 }
 
+// HclBuildQueuePositionDataSource HCL describing a data source for the pending builds of an AzDO Agent Queue
+func HclBuildQueuePositionDataSource(projectName, queueName string) string {
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_build_queue_position" "position" {
+	project_id = azuredevops_project.project.id
+	queue_id   = data.azuredevops_agent_queue.queue.id
+}`, HclAgentQueueDataSource(projectName, queueName))
+}
+
 // HclAgentQueueResource HCL describing an AzDO Agent Pool and Agent Queue
 func HclAgentQueueResource(projectName, poolName string) string {
 	poolHCL := HclAgentPoolResource(poolName)
@@ -923,6 +955,25 @@ resource "azuredevops_project_permissions" "project-permissions" {
 `, projectResource)
 }
 
+// HclPermissionsBaseline creates HCL for testing the deny-by-default permissions baseline of a project
+func HclPermissionsBaseline(projectName string, denyBuildDefinitionDeletion bool) string {
+	projectResource := HclProjectResource(projectName)
+	return fmt.Sprintf(`
+%s
+
+data "azuredevops_group" "tf-project-contributors" {
+	project_id = azuredevops_project.project.id
+	name       = "Contributors"
+}
+
+resource "azuredevops_permissions_baseline" "baseline" {
+	project_id                     = azuredevops_project.project.id
+	principal                      = data.azuredevops_group.tf-project-contributors.id
+	deny_build_definition_deletion = %t
+}
+`, projectResource, denyBuildDefinitionDeletion)
+}
+
 // HclBuildFolder creates HCL for testing Build Folders
 func HclBuildFolder(projectName string, path string, description string) string {
 	projectResource := HclProjectResource(projectName)
@@ -1051,3 +1102,40 @@ func HclEnvironmentResource(projectName string, environmentName string) string {
 	projectResource := HclProjectResource(projectName)
 	return fmt.Sprintf("%s\n%s", projectResource, azureEnvironmentResource)
 }
+
+// HclGovernancePolicyAssignment HCL describing a document in the data storage of an installed extension
+func HclGovernancePolicyAssignment(publisherName string, extensionName string, documentID string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "azuredevops_governance_policy_assignment" "assignment" {
+	publisher_name  = "%s"
+	extension_name  = "%s"
+	collection_name = "terraform-acceptance-tests"
+	document_id     = "%s"
+	document = jsonencode({
+		enabled = %t
+	})
+}`, publisherName, extensionName, documentID, enabled)
+}
+
+// HclWellKnownGroupDescriptorsDataSource HCL describing a data source for the well-known groups of an AzDO project
+func HclWellKnownGroupDescriptorsDataSource(projectName string) string {
+	dataSource := `
+data "azuredevops_well_known_group_descriptors" "groups" {
+	project_id = azuredevops_project.project.id
+}`
+
+	projectResource := HclProjectResource(projectName)
+	return fmt.Sprintf("%s\n%s", projectResource, dataSource)
+}
+
+// HclGitRepositorySearchableBranches HCL describing the branches of an AzDO GIT repository indexed by code search
+func HclGitRepositorySearchableBranches(projectName, gitRepoName string, branches []string) string {
+	searchableBranchesResource := fmt.Sprintf(`
+resource "azuredevops_git_repository_searchable_branches" "branches" {
+	project_id    = azuredevops_project.project.id
+	repository_id = azuredevops_git_repository.repository.id
+	branches      = ["%s"]
+}`, strings.Join(branches, `", "`))
+	gitRepoResource := HclGitRepoResource(projectName, gitRepoName, "Clean")
+	return fmt.Sprintf("%s\n%s", gitRepoResource, searchableBranchesResource)
+}
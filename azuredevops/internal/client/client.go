@@ -19,12 +19,21 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/memberentitlementmanagement"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/operations"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinepermissions"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/release"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/work"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/environmentkubernetes"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/extensiondata"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/organizationpolicy"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/pipelineschecksextras"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securefiles"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles"
 	"github.com/microsoft/terraform-provider-azuredevops/version"
 )
 
@@ -53,6 +62,15 @@ type AggregatedClient struct {
 	SecurityClient                security.Client
 	IdentityClient                identity.Client
 	WorkItemTrackingClient        workitemtracking.Client
+	PipelinePermissionsClient     pipelinepermissions.Client
+	PipelinesChecksClientExtras   pipelineschecksextras.Client
+	SecurityRolesClient           securityroles.Client
+	SecureFilesClient             securefiles.Client
+	WorkClient                    work.Client
+	WorkItemTrackingProcessClient workitemtrackingprocess.Client
+	OrganizationPolicyClient      organizationpolicy.Client
+	ExtensionDataClient           extensiondata.Client
+	EnvironmentKubernetesClient   environmentkubernetes.Client
 	Ctx                           context.Context
 }
 
@@ -172,6 +190,60 @@ func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*A
 		return nil, err
 	}
 
+	pipelinepermissionsClient, err := pipelinepermissions.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): pipelinepermissions.NewClient failed.")
+		return nil, err
+	}
+
+	pipelineschecksClientExtras, err := pipelineschecksextras.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): pipelineschecksextras.NewClient failed.")
+		return nil, err
+	}
+
+	securityRolesClient, err := securityroles.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): securityroles.NewClient failed.")
+		return nil, err
+	}
+
+	secureFilesClient, err := securefiles.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): securefiles.NewClient failed.")
+		return nil, err
+	}
+
+	workClient, err := work.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): work.NewClient failed.")
+		return nil, err
+	}
+
+	workitemtrackingProcessClient, err := workitemtrackingprocess.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): workitemtrackingprocess.NewClient failed.")
+		return nil, err
+	}
+
+	organizationPolicyClient, err := organizationpolicy.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): organizationpolicy.NewClient failed.")
+		return nil, err
+	}
+
+	extensionDataClient, err := extensiondata.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): extensiondata.NewClient failed.")
+		return nil, err
+	}
+
+	environmentKubernetesClient, err := environmentkubernetes.NewClient(ctx, connection)
+	if err != nil {
+		log.Printf("getAzdoClient(): environmentkubernetes.NewClient failed.")
+		return nil, err
+	}
+
 	aggregatedClient := &AggregatedClient{
 		OrganizationURL:               organizationURL,
 		CoreClient:                    coreClient,
@@ -190,6 +262,15 @@ func GetAzdoClient(azdoPAT string, organizationURL string, tfVersion string) (*A
 		SecurityClient:                securityClient,
 		IdentityClient:                identityClient,
 		WorkItemTrackingClient:        workitemtrackingClient,
+		PipelinePermissionsClient:     pipelinepermissionsClient,
+		PipelinesChecksClientExtras:   pipelineschecksClientExtras,
+		SecurityRolesClient:           securityRolesClient,
+		SecureFilesClient:             secureFilesClient,
+		WorkClient:                    workClient,
+		WorkItemTrackingProcessClient: workitemtrackingProcessClient,
+		OrganizationPolicyClient:      organizationPolicyClient,
+		ExtensionDataClient:           extensionDataClient,
+		EnvironmentKubernetesClient:   environmentKubernetesClient,
 		Ctx:                           ctx,
 	}
 
@@ -0,0 +1,355 @@
+package approvalsandchecks
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/pipelineschecksextras"
+)
+
+/**
+ * This file contains base functionality that can be leveraged by all check configuration
+ * resources. This is possible because a single API is used for configuring the checks of
+ * every protected resource.
+ */
+
+// Check type IDs. Approvals, the exclusive lock and required templates are checks of their own, while
+// e.g. branch control and business hours are task checks which evaluate a built-in check definition.
+var (
+	approvalCheckType         = uuid.MustParse("8c6f20a7-a545-4486-9777-f762fafe0d4d")
+	taskCheckType             = uuid.MustParse("fe1de3ee-a436-41b4-bb20-f6eb4cb879a7")
+	exclusiveLockCheckType    = uuid.MustParse("2ef31ad6-baa0-403a-8b45-2cbc9b4e5563")
+	requiredTemplateCheckType = uuid.MustParse("4020e66e-b0f3-47e1-bc88-48f3cc59b5f3")
+)
+
+// Keys for schema elements
+const (
+	SchemaProjectID          = "project_id"
+	SchemaTargetResourceType = "target_resource_type"
+	SchemaTargetResourceID   = "target_resource_id"
+	SchemaTimeout            = "timeout"
+	SchemaDisplayName        = "display_name"
+)
+
+// The protected resource types checks can be configured on
+var targetResourceTypes = []string{
+	"endpoint",
+	"environment",
+	"queue",
+	"securefile",
+	"variablegroup",
+}
+
+// 30 days, the default timeout of checks created in the web UI
+const defaultCheckTimeout = 43200
+
+// The number of minutes after which a failed task check is evaluated again
+const defaultTaskCheckRetryInterval = 5
+
+// checkCrudArgs arguments for genBaseCheckResource
+type checkCrudArgs struct {
+	FlattenFunc func(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error
+	ExpandFunc  func(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error)
+	CheckType   uuid.UUID
+}
+
+// genBaseCheckResource creates a Resource with the common elements of a check configuration
+func genBaseCheckResource(crudArgs *checkCrudArgs, checkSchema map[string]*schema.Schema) *schema.Resource {
+	resource := &schema.Resource{
+		Create:   genCheckCreateFunc(crudArgs),
+		Read:     genCheckReadFunc(crudArgs),
+		Update:   genCheckUpdateFunc(crudArgs),
+		Delete:   genCheckDeleteFunc(crudArgs),
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			SchemaProjectID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			SchemaTargetResourceType: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(targetResourceTypes, false),
+			},
+			SchemaTargetResourceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			SchemaTimeout: {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultCheckTimeout,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+	for key, elem := range checkSchema {
+		resource.Schema[key] = elem
+	}
+	return resource
+}
+
+// baseFlattenFunc flattens each of the base elements of the schema
+func baseFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	if check.Id == nil {
+		d.SetId("")
+		return nil
+	}
+	d.SetId(strconv.Itoa(*check.Id))
+	d.Set(SchemaProjectID, projectID)
+	if check.Resource != nil {
+		d.Set(SchemaTargetResourceType, converter.ToString(check.Resource.Type, ""))
+		d.Set(SchemaTargetResourceID, converter.ToString(check.Resource.Id, ""))
+	}
+	if check.Timeout != nil {
+		d.Set(SchemaTimeout, *check.Timeout)
+	}
+	return nil
+}
+
+// baseExpandFunc expands each of the base elements of the schema
+func baseExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	projectID := d.Get(SchemaProjectID).(string)
+	check := pipelineschecks.GenericCheckConfiguration{
+		Type: &pipelineschecks.CheckType{
+			Id: &typeID,
+		},
+		Resource: &pipelineschecks.Resource{
+			Type: converter.String(d.Get(SchemaTargetResourceType).(string)),
+			Id:   converter.String(d.Get(SchemaTargetResourceID).(string)),
+		},
+		Timeout: converter.Int(d.Get(SchemaTimeout).(int)),
+	}
+
+	if d.Id() != "" {
+		checkID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return nil, "", fmt.Errorf("Error parsing check configuration ID: (%+v)", err)
+		}
+		check.Id = &checkID
+	}
+	return &check, projectID, nil
+}
+
+// decodeSettings converts the untyped settings of a check configuration into one of the typed settings of the SDK
+func decodeSettings(check *pipelineschecks.GenericCheckConfiguration, settings interface{}) error {
+	if check.Settings == nil {
+		return nil
+	}
+	settingsAsJSON, err := json.Marshal(check.Settings)
+	if err != nil {
+		return fmt.Errorf("Unable to marshal check settings into JSON: %+v", err)
+	}
+	if err := json.Unmarshal(settingsAsJSON, settings); err != nil {
+		return fmt.Errorf("Unable to unmarshal check settings: %+v", err)
+	}
+	return nil
+}
+
+//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
+func genCheckCreateFunc(crudArgs *checkCrudArgs) schema.CreateFunc { //nolint:staticcheck
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+		check, projectID, err := crudArgs.ExpandFunc(d, crudArgs.CheckType)
+		if err != nil {
+			return err
+		}
+
+		createdCheck, err := clients.PipelinesChecksClientExtras.AddCheckConfiguration(clients.Ctx, pipelineschecksextras.AddCheckConfigurationArgs{
+			Configuration: check,
+			Project:       &projectID,
+		})
+		if err != nil {
+			return fmt.Errorf("Error creating check in Azure DevOps: %+v", err)
+		}
+
+		d.SetId(strconv.Itoa(*createdCheck.Id))
+		return genCheckReadFunc(crudArgs)(d, m)
+	}
+}
+
+//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
+func genCheckReadFunc(crudArgs *checkCrudArgs) schema.ReadFunc { //nolint:staticcheck
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+		projectID := d.Get(SchemaProjectID).(string)
+		checkID, err := strconv.Atoi(d.Id())
+		if err != nil {
+			return fmt.Errorf("Error converting check ID to an integer: (%+v)", err)
+		}
+
+		check, err := clients.PipelinesChecksClientExtras.GetCheckConfiguration(clients.Ctx, pipelineschecksextras.GetCheckConfigurationArgs{
+			Project: &projectID,
+			Id:      &checkID,
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				d.SetId("")
+				return nil
+			}
+			return fmt.Errorf("Error looking up check configuration with ID (%v) and project ID (%v): %v", checkID, projectID, err)
+		}
+
+		return crudArgs.FlattenFunc(d, check, projectID)
+	}
+}
+
+//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
+func genCheckUpdateFunc(crudArgs *checkCrudArgs) schema.UpdateFunc { //nolint:staticcheck
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+		check, projectID, err := crudArgs.ExpandFunc(d, crudArgs.CheckType)
+		if err != nil {
+			return err
+		}
+
+		_, err = clients.PipelinesChecksClientExtras.UpdateCheckConfiguration(clients.Ctx, pipelineschecksextras.UpdateCheckConfigurationArgs{
+			Configuration: check,
+			Project:       &projectID,
+			Id:            check.Id,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating check in Azure DevOps: %+v", err)
+		}
+
+		return genCheckReadFunc(crudArgs)(d, m)
+	}
+}
+
+//lint:ignore SA1019 SDKv2 migration  - staticcheck's own linter directives are currently being ignored under golanci-lint
+func genCheckDeleteFunc(crudArgs *checkCrudArgs) schema.DeleteFunc { //nolint:staticcheck
+	return func(d *schema.ResourceData, m interface{}) error {
+		clients := m.(*client.AggregatedClient)
+		check, projectID, err := crudArgs.ExpandFunc(d, crudArgs.CheckType)
+		if err != nil {
+			return err
+		}
+
+		err = clients.PipelinesChecksClientExtras.DeleteCheckConfiguration(clients.Ctx, pipelineschecksextras.DeleteCheckConfigurationArgs{
+			Project: &projectID,
+			Id:      check.Id,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf("Error deleting check in Azure DevOps: %+v", err)
+		}
+
+		d.SetId("")
+		return nil
+	}
+}
+
+// expandTaskCheckSettings returns the settings of a task check evaluating the given check definition
+func expandTaskCheckSettings(d *schema.ResourceData, definitionRef *pipelinestaskcheck.TaskCheckDefinitionReference, inputs map[string]string) *pipelinestaskcheck.TaskCheckConfig {
+	return &pipelinestaskcheck.TaskCheckConfig{
+		DefinitionRef: definitionRef,
+		DisplayName:   converter.String(d.Get(SchemaDisplayName).(string)),
+		Inputs:        &inputs,
+		RetryInterval: converter.Int(defaultTaskCheckRetryInterval),
+	}
+}
+
+// flattenTaskCheckSettings flattens the display name of a task check and returns its settings
+func flattenTaskCheckSettings(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration) (*pipelinestaskcheck.TaskCheckConfig, error) {
+	settings := pipelinestaskcheck.TaskCheckConfig{}
+	if err := decodeSettings(check, &settings); err != nil {
+		return nil, err
+	}
+	d.Set(SchemaDisplayName, converter.ToString(settings.DisplayName, ""))
+	if settings.Inputs == nil {
+		settings.Inputs = &map[string]string{}
+	}
+	return &settings, nil
+}
+
+// The events which complete the checks invoking an Azure Function or a REST API
+const (
+	completionEventAPIResponse = "ApiResponse"
+	completionEventCallback    = "Callback"
+)
+
+// genInvokeCheckSchema returns the schema elements shared by the checks invoking an Azure Function or a REST API
+func genInvokeCheckSchema(displayName string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		SchemaDisplayName: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      displayName,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"method": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "POST",
+			ValidateFunc: validation.StringInSlice([]string{"OPTIONS", "GET", "HEAD", "POST", "PUT", "DELETE", "TRACE", "PATCH"}, false),
+		},
+		"headers": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "{\n\"Content-Type\":\"application/json\"\n}",
+			ValidateFunc: validation.StringIsJSON,
+		},
+		"body": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"completion_event": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      completionEventAPIResponse,
+			ValidateFunc: validation.StringInSlice([]string{completionEventAPIResponse, completionEventCallback}, false),
+		},
+		"success_criteria": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"retry_interval": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      defaultTaskCheckRetryInterval,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+	}
+}
+
+// expandInvokeCheckInputs adds the inputs shared by the checks invoking an Azure Function or a REST API
+func expandInvokeCheckInputs(d *schema.ResourceData, inputs map[string]string) map[string]string {
+	inputs["method"] = d.Get("method").(string)
+	inputs["headers"] = d.Get("headers").(string)
+	inputs["body"] = d.Get("body").(string)
+	inputs["waitForCompletion"] = strconv.FormatBool(d.Get("completion_event").(string) == completionEventCallback)
+	inputs["successCriteria"] = d.Get("success_criteria").(string)
+	return inputs
+}
+
+// flattenInvokeCheckSettings flattens the settings shared by the checks invoking an Azure Function or a REST API
+func flattenInvokeCheckSettings(d *schema.ResourceData, settings *pipelinestaskcheck.TaskCheckConfig) {
+	inputs := *settings.Inputs
+	d.Set("method", inputs["method"])
+	d.Set("headers", inputs["headers"])
+	d.Set("body", inputs["body"])
+	if strings.EqualFold(inputs["waitForCompletion"], "true") {
+		d.Set("completion_event", completionEventCallback)
+	} else {
+		d.Set("completion_event", completionEventAPIResponse)
+	}
+	d.Set("success_criteria", inputs["successCriteria"])
+	if settings.RetryInterval != nil {
+		d.Set("retry_interval", *settings.RetryInterval)
+	}
+}
@@ -0,0 +1,95 @@
+package approvalsandchecks
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinesapproval"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceCheckApproval schema and implementation for the approvals check resource
+func ResourceCheckApproval() *schema.Resource {
+	return genBaseCheckResource(&checkCrudArgs{
+		FlattenFunc: approvalFlattenFunc,
+		ExpandFunc:  approvalExpandFunc,
+		CheckType:   approvalCheckType,
+	}, map[string]*schema.Schema{
+		"approvers": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+		},
+		"minimum_required_approvers": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		},
+		"instructions": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"requester_can_approve": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	})
+}
+
+func approvalFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	if err := baseFlattenFunc(d, check, projectID); err != nil {
+		return err
+	}
+
+	settings := pipelinesapproval.ApprovalConfigSettings{}
+	if err := decodeSettings(check, &settings); err != nil {
+		return err
+	}
+
+	approvers := []string{}
+	if settings.Approvers != nil {
+		for _, approver := range *settings.Approvers {
+			approvers = append(approvers, converter.ToString(approver.Id, ""))
+		}
+	}
+	d.Set("approvers", approvers)
+	minRequiredApprovers := 0
+	if settings.MinRequiredApprovers != nil {
+		minRequiredApprovers = *settings.MinRequiredApprovers
+	}
+	d.Set("minimum_required_approvers", minRequiredApprovers)
+	d.Set("instructions", converter.ToString(settings.Instructions, ""))
+	d.Set("requester_can_approve", !converter.ToBool(settings.RequesterCannotBeApprover, false))
+	return nil
+}
+
+func approvalExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	check, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	approvers := []webapi.IdentityRef{}
+	for _, approver := range d.Get("approvers").([]interface{}) {
+		approvers = append(approvers, webapi.IdentityRef{
+			Id: converter.String(approver.(string)),
+		})
+	}
+
+	check.Settings = pipelinesapproval.ApprovalConfigSettings{
+		Approvers:                 &approvers,
+		ExecutionOrder:            &pipelinesapproval.ApprovalExecutionOrderValues.AnyOrder,
+		Instructions:              converter.String(d.Get("instructions").(string)),
+		MinRequiredApprovers:      converter.Int(d.Get("minimum_required_approvers").(int)),
+		RequesterCannotBeApprover: converter.Bool(!d.Get("requester_can_approve").(bool)),
+	}
+	return check, projectID, nil
+}
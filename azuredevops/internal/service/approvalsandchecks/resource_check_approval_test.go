@@ -0,0 +1,157 @@
+//go:build (all || resource_check_approval) && !exclude_resource_check_approval
+// +build all resource_check_approval
+// +build !exclude_resource_check_approval
+
+package approvalsandchecks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinesapproval"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/pipelineschecksextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testApprovalProjectID = uuid.New().String()
+var testApprover = uuid.New().String()
+
+var testApprovalCheck = pipelineschecks.GenericCheckConfiguration{
+	Id: converter.Int(11),
+	Type: &pipelineschecks.CheckType{
+		Id: &approvalCheckType,
+	},
+	Resource: &pipelineschecks.Resource{
+		Type: converter.String("environment"),
+		Id:   converter.String("3"),
+	},
+	Timeout: converter.Int(1440),
+	Settings: pipelinesapproval.ApprovalConfigSettings{
+		Approvers: &[]webapi.IdentityRef{
+			{Id: converter.String(testApprover)},
+		},
+		ExecutionOrder:            &pipelinesapproval.ApprovalExecutionOrderValues.AnyOrder,
+		Instructions:              converter.String("Check the release notes"),
+		MinRequiredApprovers:      converter.Int(1),
+		RequesterCannotBeApprover: converter.Bool(true),
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same check configuration
+func TestCheckApproval_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckApproval().Schema, nil)
+	require.Nil(t, approvalFlattenFunc(resourceData, &testApprovalCheck, testApprovalProjectID))
+
+	require.Equal(t, false, resourceData.Get("requester_can_approve"))
+	require.Equal(t, []interface{}{testApprover}, resourceData.Get("approvers"))
+
+	check, projectID, err := approvalExpandFunc(resourceData, approvalCheckType)
+	require.Nil(t, err)
+	require.Equal(t, testApprovalProjectID, projectID)
+	require.Equal(t, testApprovalCheck, *check)
+}
+
+// verifies that settings returned as untyped JSON by the service are flattened
+func TestCheckApproval_Flatten_DecodesUntypedSettings(t *testing.T) {
+	check := testApprovalCheck
+	check.Settings = map[string]interface{}{
+		"approvers": []interface{}{
+			map[string]interface{}{"id": testApprover, "displayName": "Approver"},
+		},
+		"minRequiredApprovers":      float64(0),
+		"requesterCannotBeApprover": false,
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckApproval().Schema, nil)
+	require.Nil(t, approvalFlattenFunc(resourceData, &check, testApprovalProjectID))
+
+	require.Equal(t, "11", resourceData.Id())
+	require.Equal(t, "environment", resourceData.Get(SchemaTargetResourceType))
+	require.Equal(t, "3", resourceData.Get(SchemaTargetResourceID))
+	require.Equal(t, []interface{}{testApprover}, resourceData.Get("approvers"))
+	require.Equal(t, 0, resourceData.Get("minimum_required_approvers"))
+	require.Equal(t, true, resourceData.Get("requester_can_approve"))
+}
+
+func TestCheckApproval_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: checksClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckApproval().Schema, nil)
+	require.Nil(t, approvalFlattenFunc(resourceData, &testApprovalCheck, testApprovalProjectID))
+	resourceData.SetId("")
+
+	expectedCheck := testApprovalCheck
+	expectedCheck.Id = nil
+	checksClient.
+		EXPECT().
+		AddCheckConfiguration(clients.Ctx, pipelineschecksextras.AddCheckConfigurationArgs{
+			Configuration: &expectedCheck,
+			Project:       converter.String(testApprovalProjectID),
+		}).
+		Return(nil, errors.New("AddCheckConfiguration() Failed")).
+		Times(1)
+
+	err := ResourceCheckApproval().Create(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AddCheckConfiguration() Failed")
+}
+
+func TestCheckApproval_Read_RemovesDeletedCheckFromState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: checksClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckApproval().Schema, nil)
+	require.Nil(t, approvalFlattenFunc(resourceData, &testApprovalCheck, testApprovalProjectID))
+
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.Ctx, pipelineschecksextras.GetCheckConfigurationArgs{
+			Project: converter.String(testApprovalProjectID),
+			Id:      converter.Int(11),
+		}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	require.Nil(t, ResourceCheckApproval().Read(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestCheckApproval_Delete_IgnoresAlreadyDeletedCheck(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: checksClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckApproval().Schema, nil)
+	require.Nil(t, approvalFlattenFunc(resourceData, &testApprovalCheck, testApprovalProjectID))
+
+	checksClient.
+		EXPECT().
+		DeleteCheckConfiguration(clients.Ctx, pipelineschecksextras.DeleteCheckConfigurationArgs{
+			Project: converter.String(testApprovalProjectID),
+			Id:      converter.Int(11),
+		}).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	require.Nil(t, ResourceCheckApproval().Delete(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
@@ -0,0 +1,78 @@
+package approvalsandchecks
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// The Invoke Azure Function task evaluated by the check
+var azureFunctionDefinitionRef = pipelinestaskcheck.TaskCheckDefinitionReference{
+	Id:      converter.UUID("537fdb7a-a601-4537-aa70-92645a2b5ce4"),
+	Name:    converter.String("AzureFunction"),
+	Version: converter.String("1.0.0"),
+}
+
+// ResourceCheckAzureFunction schema and implementation for the Invoke Azure Function check resource
+func ResourceCheckAzureFunction() *schema.Resource {
+	checkSchema := genInvokeCheckSchema("Invoke Azure Function")
+	checkSchema["function_url"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+	}
+	checkSchema["function_key"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+	}
+	checkSchema["query_parameters"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return genBaseCheckResource(&checkCrudArgs{
+		FlattenFunc: azureFunctionFlattenFunc,
+		ExpandFunc:  azureFunctionExpandFunc,
+		CheckType:   taskCheckType,
+	}, checkSchema)
+}
+
+func azureFunctionFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	if err := baseFlattenFunc(d, check, projectID); err != nil {
+		return err
+	}
+
+	settings, err := flattenTaskCheckSettings(d, check)
+	if err != nil {
+		return err
+	}
+	flattenInvokeCheckSettings(d, settings)
+
+	// the function key is a secret, which the service does not return
+	inputs := *settings.Inputs
+	d.Set("function_url", inputs["function"])
+	d.Set("query_parameters", inputs["queryParameters"])
+	return nil
+}
+
+func azureFunctionExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	check, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	definitionRef := azureFunctionDefinitionRef
+	settings := expandTaskCheckSettings(d, &definitionRef, expandInvokeCheckInputs(d, map[string]string{
+		"function":        d.Get("function_url").(string),
+		"key":             d.Get("function_key").(string),
+		"queryParameters": d.Get("query_parameters").(string),
+	}))
+	settings.RetryInterval = converter.Int(d.Get("retry_interval").(int))
+	check.Settings = settings
+	return check, projectID, nil
+}
@@ -0,0 +1,79 @@
+//go:build (all || resource_check_azure_function) && !exclude_resource_check_azure_function
+// +build all resource_check_azure_function
+// +build !exclude_resource_check_azure_function
+
+package approvalsandchecks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testAzureFunctionProjectID = uuid.New().String()
+
+var testAzureFunctionCheck = pipelineschecks.GenericCheckConfiguration{
+	Id: converter.Int(15),
+	Type: &pipelineschecks.CheckType{
+		Id: &taskCheckType,
+	},
+	Resource: &pipelineschecks.Resource{
+		Type: converter.String("endpoint"),
+		Id:   converter.String(uuid.New().String()),
+	},
+	Timeout: converter.Int(60),
+	Settings: &pipelinestaskcheck.TaskCheckConfig{
+		DefinitionRef: &azureFunctionDefinitionRef,
+		DisplayName:   converter.String("Compliance gate"),
+		Inputs: &map[string]string{
+			"function":          "https://compliance.azurewebsites.net/api/evaluate",
+			"key":               "secret",
+			"queryParameters":   "stage=prod",
+			"method":            "POST",
+			"headers":           `{"Content-Type":"application/json"}`,
+			"body":              `{"run":"$(system.planId)"}`,
+			"waitForCompletion": "false",
+			"successCriteria":   "eq(root['status'], 'compliant')",
+		},
+		RetryInterval: converter.Int(10),
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same check configuration
+func TestCheckAzureFunction_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckAzureFunction().Schema, nil)
+	require.Nil(t, azureFunctionFlattenFunc(resourceData, &testAzureFunctionCheck, testAzureFunctionProjectID))
+	// the key is not returned by the service and taken from the configuration
+	resourceData.Set("function_key", "secret")
+
+	require.Equal(t, completionEventAPIResponse, resourceData.Get("completion_event"))
+	require.Equal(t, 10, resourceData.Get("retry_interval"))
+
+	check, projectID, err := azureFunctionExpandFunc(resourceData, taskCheckType)
+	require.Nil(t, err)
+	require.Equal(t, testAzureFunctionProjectID, projectID)
+	require.Equal(t, testAzureFunctionCheck, *check)
+}
+
+func TestCheckAzureFunction_Flatten_DoesNotOverwriteKey(t *testing.T) {
+	check := testAzureFunctionCheck
+	check.Settings = map[string]interface{}{
+		"inputs": map[string]interface{}{
+			"function":          "https://compliance.azurewebsites.net/api/evaluate",
+			"key":               "********",
+			"waitForCompletion": "true",
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckAzureFunction().Schema, nil)
+	resourceData.Set("function_key", "secret")
+	require.Nil(t, azureFunctionFlattenFunc(resourceData, &check, testAzureFunctionProjectID))
+
+	require.Equal(t, "secret", resourceData.Get("function_key"))
+	require.Equal(t, completionEventCallback, resourceData.Get("completion_event"))
+}
@@ -0,0 +1,83 @@
+package approvalsandchecks
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// The built-in check definition evaluated by the branch control check
+var evaluateBranchProtectionDefinitionRef = pipelinestaskcheck.TaskCheckDefinitionReference{
+	Id:      converter.UUID("86b05a0c-73e6-4f7d-b3cf-e38f3b39a75b"),
+	Name:    converter.String("evaluatebranchProtection"),
+	Version: converter.String("0.0.1"),
+}
+
+// ResourceCheckBranchControl schema and implementation for the branch control check resource
+func ResourceCheckBranchControl() *schema.Resource {
+	return genBaseCheckResource(&checkCrudArgs{
+		FlattenFunc: branchControlFlattenFunc,
+		ExpandFunc:  branchControlExpandFunc,
+		CheckType:   taskCheckType,
+	}, map[string]*schema.Schema{
+		SchemaDisplayName: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Branch control",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"allowed_branches": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "*",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"verify_branch_protection": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+		"ignore_unknown_protection_status": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+	})
+}
+
+func branchControlFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	if err := baseFlattenFunc(d, check, projectID); err != nil {
+		return err
+	}
+
+	settings, err := flattenTaskCheckSettings(d, check)
+	if err != nil {
+		return err
+	}
+	inputs := *settings.Inputs
+	d.Set("allowed_branches", inputs["allowedBranches"])
+	d.Set("verify_branch_protection", strings.EqualFold(inputs["ensureProtectionOfBranch"], "true"))
+	d.Set("ignore_unknown_protection_status", strings.EqualFold(inputs["allowUnknownStatusBranch"], "true"))
+	return nil
+}
+
+func branchControlExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	check, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	definitionRef := evaluateBranchProtectionDefinitionRef
+	check.Settings = expandTaskCheckSettings(d, &definitionRef, map[string]string{
+		"allowedBranches":          d.Get("allowed_branches").(string),
+		"ensureProtectionOfBranch": strconv.FormatBool(d.Get("verify_branch_protection").(bool)),
+		"allowUnknownStatusBranch": strconv.FormatBool(d.Get("ignore_unknown_protection_status").(bool)),
+	})
+	return check, projectID, nil
+}
@@ -0,0 +1,92 @@
+//go:build (all || resource_check_branch_control) && !exclude_resource_check_branch_control
+// +build all resource_check_branch_control
+// +build !exclude_resource_check_branch_control
+
+package approvalsandchecks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testBranchControlProjectID = uuid.New().String()
+
+var testBranchControlCheck = pipelineschecks.GenericCheckConfiguration{
+	Id: converter.Int(12),
+	Type: &pipelineschecks.CheckType{
+		Id: &taskCheckType,
+	},
+	Resource: &pipelineschecks.Resource{
+		Type: converter.String("environment"),
+		Id:   converter.String("3"),
+	},
+	Timeout: converter.Int(1440),
+	Settings: &pipelinestaskcheck.TaskCheckConfig{
+		DefinitionRef: &evaluateBranchProtectionDefinitionRef,
+		DisplayName:   converter.String("Protected branches only"),
+		Inputs: &map[string]string{
+			"allowedBranches":          "refs/heads/main,refs/heads/release/*",
+			"ensureProtectionOfBranch": "true",
+			"allowUnknownStatusBranch": "false",
+		},
+		RetryInterval: converter.Int(defaultTaskCheckRetryInterval),
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same check configuration
+func TestCheckBranchControl_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBranchControl().Schema, nil)
+	require.Nil(t, branchControlFlattenFunc(resourceData, &testBranchControlCheck, testBranchControlProjectID))
+
+	require.Equal(t, "refs/heads/main,refs/heads/release/*", resourceData.Get("allowed_branches"))
+	require.Equal(t, true, resourceData.Get("verify_branch_protection"))
+	require.Equal(t, false, resourceData.Get("ignore_unknown_protection_status"))
+
+	check, projectID, err := branchControlExpandFunc(resourceData, taskCheckType)
+	require.Nil(t, err)
+	require.Equal(t, testBranchControlProjectID, projectID)
+	require.Equal(t, testBranchControlCheck, *check)
+}
+
+func TestCheckBranchControl_Read_DecodesUntypedSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: checksClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBranchControl().Schema, nil)
+	resourceData.Set(SchemaProjectID, testBranchControlProjectID)
+	resourceData.SetId("12")
+
+	check := testBranchControlCheck
+	check.Settings = map[string]interface{}{
+		"displayName": "Branch control",
+		"inputs": map[string]interface{}{
+			"allowedBranches":          "refs/heads/main",
+			"ensureProtectionOfBranch": "false",
+			"allowUnknownStatusBranch": "true",
+		},
+	}
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.Ctx, gomock.Any()).
+		Return(&check, nil).
+		Times(1)
+
+	require.Nil(t, ResourceCheckBranchControl().Read(resourceData, clients))
+	require.Equal(t, "Branch control", resourceData.Get(SchemaDisplayName))
+	require.Equal(t, "refs/heads/main", resourceData.Get("allowed_branches"))
+	require.Equal(t, false, resourceData.Get("verify_branch_protection"))
+	require.Equal(t, true, resourceData.Get("ignore_unknown_protection_status"))
+}
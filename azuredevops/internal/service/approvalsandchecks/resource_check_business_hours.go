@@ -0,0 +1,134 @@
+package approvalsandchecks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// The built-in check definition evaluated by the business hours check
+var evaluateBusinessHoursDefinitionRef = pipelinestaskcheck.TaskCheckDefinitionReference{
+	Id:      converter.UUID("445fde2f-6c39-441c-ac4d-a37c2b1ab8c5"),
+	Name:    converter.String("evaluatebusinesshours"),
+	Version: converter.String("0.0.1"),
+}
+
+var businessDays = []string{
+	"Monday",
+	"Tuesday",
+	"Wednesday",
+	"Thursday",
+	"Friday",
+	"Saturday",
+	"Sunday",
+}
+
+// ResourceCheckBusinessHours schema and implementation for the business hours check resource
+func ResourceCheckBusinessHours() *schema.Resource {
+	return genBaseCheckResource(&checkCrudArgs{
+		FlattenFunc: businessHoursFlattenFunc,
+		ExpandFunc:  businessHoursExpandFunc,
+		CheckType:   taskCheckType,
+	}, map[string]*schema.Schema{
+		SchemaDisplayName: {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "Business hours",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"days": {
+			Type:     schema.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringInSlice(businessDays, false),
+			},
+		},
+		"start_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateTimeOfDay,
+		},
+		"end_time": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateTimeOfDay,
+		},
+		"time_zone": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "UTC",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+	})
+}
+
+// validateTimeOfDay validates a time of day in the 24 hour format hh:mm used by the check
+func validateTimeOfDay(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	var hours, minutes int
+	if n, err := fmt.Sscanf(v, "%02d:%02d", &hours, &minutes); err != nil || n != 2 || len(v) != 5 ||
+		hours < 0 || hours > 23 || minutes < 0 || minutes > 59 {
+		return nil, []error{fmt.Errorf("expected %q to be a time of day in the format hh:mm, got %q", k, v)}
+	}
+	return nil, nil
+}
+
+func businessHoursFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	if err := baseFlattenFunc(d, check, projectID); err != nil {
+		return err
+	}
+
+	settings, err := flattenTaskCheckSettings(d, check)
+	if err != nil {
+		return err
+	}
+	inputs := *settings.Inputs
+
+	days := []string{}
+	for _, day := range strings.Split(inputs["businessDays"], ",") {
+		if day = strings.TrimSpace(day); day != "" {
+			days = append(days, day)
+		}
+	}
+	d.Set("days", days)
+	d.Set("start_time", inputs["startTime"])
+	d.Set("end_time", inputs["endTime"])
+	d.Set("time_zone", inputs["timeZone"])
+	return nil
+}
+
+func businessHoursExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	check, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// keep the days in the order of the week, as the web UI does
+	selectedDays := d.Get("days").(*schema.Set)
+	days := []string{}
+	for _, day := range businessDays {
+		if selectedDays.Contains(day) {
+			days = append(days, day)
+		}
+	}
+
+	definitionRef := evaluateBusinessHoursDefinitionRef
+	check.Settings = expandTaskCheckSettings(d, &definitionRef, map[string]string{
+		"businessDays": strings.Join(days, ","),
+		"startTime":    d.Get("start_time").(string),
+		"endTime":      d.Get("end_time").(string),
+		"timeZone":     d.Get("time_zone").(string),
+	})
+	return check, projectID, nil
+}
@@ -0,0 +1,66 @@
+//go:build (all || resource_check_business_hours) && !exclude_resource_check_business_hours
+// +build all resource_check_business_hours
+// +build !exclude_resource_check_business_hours
+
+package approvalsandchecks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testBusinessHoursProjectID = uuid.New().String()
+
+var testBusinessHoursCheck = pipelineschecks.GenericCheckConfiguration{
+	Id: converter.Int(13),
+	Type: &pipelineschecks.CheckType{
+		Id: &taskCheckType,
+	},
+	Resource: &pipelineschecks.Resource{
+		Type: converter.String("environment"),
+		Id:   converter.String("3"),
+	},
+	Timeout: converter.Int(1440),
+	Settings: &pipelinestaskcheck.TaskCheckConfig{
+		DefinitionRef: &evaluateBusinessHoursDefinitionRef,
+		DisplayName:   converter.String("Office hours"),
+		Inputs: &map[string]string{
+			"businessDays": "Monday,Wednesday,Friday",
+			"startTime":    "08:30",
+			"endTime":      "17:00",
+			"timeZone":     "W. Europe Standard Time",
+		},
+		RetryInterval: converter.Int(defaultTaskCheckRetryInterval),
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same check configuration
+func TestCheckBusinessHours_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckBusinessHours().Schema, nil)
+	require.Nil(t, businessHoursFlattenFunc(resourceData, &testBusinessHoursCheck, testBusinessHoursProjectID))
+
+	require.Equal(t, 3, resourceData.Get("days").(*schema.Set).Len())
+	require.Equal(t, "W. Europe Standard Time", resourceData.Get("time_zone"))
+
+	check, projectID, err := businessHoursExpandFunc(resourceData, taskCheckType)
+	require.Nil(t, err)
+	require.Equal(t, testBusinessHoursProjectID, projectID)
+	require.Equal(t, testBusinessHoursCheck, *check)
+}
+
+func TestCheckBusinessHours_ValidateTimeOfDay(t *testing.T) {
+	for _, valid := range []string{"00:00", "08:30", "23:59"} {
+		_, errs := validateTimeOfDay(valid, "start_time")
+		require.Empty(t, errs, "expected %q to be valid", valid)
+	}
+	for _, invalid := range []string{"", "8:30", "24:00", "12:60", "12-30", "12:30pm"} {
+		_, errs := validateTimeOfDay(invalid, "start_time")
+		require.NotEmpty(t, errs, "expected %q to be invalid", invalid)
+	}
+}
@@ -0,0 +1,25 @@
+package approvalsandchecks
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+)
+
+// ResourceCheckExclusiveLock schema and implementation for the exclusive lock check resource
+func ResourceCheckExclusiveLock() *schema.Resource {
+	return genBaseCheckResource(&checkCrudArgs{
+		FlattenFunc: exclusiveLockFlattenFunc,
+		ExpandFunc:  exclusiveLockExpandFunc,
+		CheckType:   exclusiveLockCheckType,
+	}, nil)
+}
+
+// The check has no settings besides the common timeout, which is how long a run waits for the lock
+func exclusiveLockFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	return baseFlattenFunc(d, check, projectID)
+}
+
+func exclusiveLockExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	return baseExpandFunc(d, typeID)
+}
@@ -0,0 +1,80 @@
+//go:build (all || resource_check_exclusive_lock) && !exclude_resource_check_exclusive_lock
+// +build all resource_check_exclusive_lock
+// +build !exclude_resource_check_exclusive_lock
+
+package approvalsandchecks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/pipelineschecksextras"
+	"github.com/stretchr/testify/require"
+)
+
+var testExclusiveLockProjectID = uuid.New().String()
+
+var testExclusiveLockCheck = pipelineschecks.GenericCheckConfiguration{
+	Id: converter.Int(14),
+	Type: &pipelineschecks.CheckType{
+		Id: &exclusiveLockCheckType,
+	},
+	Resource: &pipelineschecks.Resource{
+		Type: converter.String("environment"),
+		Id:   converter.String("3"),
+	},
+	Timeout: converter.Int(60),
+}
+
+// verifies that the flatten/expand round trip yields the same check configuration
+func TestCheckExclusiveLock_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckExclusiveLock().Schema, nil)
+	require.Nil(t, exclusiveLockFlattenFunc(resourceData, &testExclusiveLockCheck, testExclusiveLockProjectID))
+
+	check, projectID, err := exclusiveLockExpandFunc(resourceData, exclusiveLockCheckType)
+	require.Nil(t, err)
+	require.Equal(t, testExclusiveLockProjectID, projectID)
+	require.Equal(t, testExclusiveLockCheck, *check)
+}
+
+func TestCheckExclusiveLock_Update_UpdatesTimeout(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	checksClient := azdosdkmocks.NewMockPipelineschecksextrasClient(ctrl)
+	clients := &client.AggregatedClient{PipelinesChecksClientExtras: checksClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckExclusiveLock().Schema, nil)
+	require.Nil(t, exclusiveLockFlattenFunc(resourceData, &testExclusiveLockCheck, testExclusiveLockProjectID))
+	resourceData.Set(SchemaTimeout, 120)
+
+	updatedCheck := testExclusiveLockCheck
+	updatedCheck.Timeout = converter.Int(120)
+	checksClient.
+		EXPECT().
+		UpdateCheckConfiguration(clients.Ctx, pipelineschecksextras.UpdateCheckConfigurationArgs{
+			Configuration: &updatedCheck,
+			Project:       converter.String(testExclusiveLockProjectID),
+			Id:            converter.Int(14),
+		}).
+		Return(&updatedCheck, nil).
+		Times(1)
+	checksClient.
+		EXPECT().
+		GetCheckConfiguration(clients.Ctx, pipelineschecksextras.GetCheckConfigurationArgs{
+			Project: converter.String(testExclusiveLockProjectID),
+			Id:      converter.Int(14),
+		}).
+		Return(&updatedCheck, nil).
+		Times(1)
+
+	require.Nil(t, ResourceCheckExclusiveLock().Update(resourceData, clients))
+	require.Equal(t, 120, resourceData.Get(SchemaTimeout))
+}
@@ -0,0 +1,109 @@
+package approvalsandchecks
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+)
+
+// The repository types of required templates, as used by the service
+var requiredTemplateRepositoryTypes = []string{
+	"azuregit",
+	"github",
+	"bitbucket",
+}
+
+type requiredTemplate struct {
+	RepositoryType string `json:"repositoryType"`
+	RepositoryName string `json:"repositoryName"`
+	RepositoryRef  string `json:"repositoryRef"`
+	TemplatePath   string `json:"templatePath"`
+}
+
+type requiredTemplateSettings struct {
+	ExtendsChecks []requiredTemplate `json:"extendsChecks"`
+}
+
+// ResourceCheckRequiredTemplate schema and implementation for the required template check resource
+func ResourceCheckRequiredTemplate() *schema.Resource {
+	return genBaseCheckResource(&checkCrudArgs{
+		FlattenFunc: requiredTemplateFlattenFunc,
+		ExpandFunc:  requiredTemplateExpandFunc,
+		CheckType:   requiredTemplateCheckType,
+	}, map[string]*schema.Schema{
+		"required_template": {
+			Type:     schema.TypeList,
+			Required: true,
+			MinItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repository_type": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						Default:      "azuregit",
+						ValidateFunc: validation.StringInSlice(requiredTemplateRepositoryTypes, false),
+					},
+					"repository_name": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"repository_ref": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+					"template_path": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+					},
+				},
+			},
+		},
+	})
+}
+
+func requiredTemplateFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	if err := baseFlattenFunc(d, check, projectID); err != nil {
+		return err
+	}
+
+	settings := requiredTemplateSettings{}
+	if err := decodeSettings(check, &settings); err != nil {
+		return err
+	}
+
+	templates := make([]interface{}, len(settings.ExtendsChecks))
+	for i, template := range settings.ExtendsChecks {
+		templates[i] = map[string]interface{}{
+			"repository_type": template.RepositoryType,
+			"repository_name": template.RepositoryName,
+			"repository_ref":  template.RepositoryRef,
+			"template_path":   template.TemplatePath,
+		}
+	}
+	d.Set("required_template", templates)
+	return nil
+}
+
+func requiredTemplateExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	check, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	settings := requiredTemplateSettings{ExtendsChecks: []requiredTemplate{}}
+	for _, item := range d.Get("required_template").([]interface{}) {
+		template := item.(map[string]interface{})
+		settings.ExtendsChecks = append(settings.ExtendsChecks, requiredTemplate{
+			RepositoryType: template["repository_type"].(string),
+			RepositoryName: template["repository_name"].(string),
+			RepositoryRef:  template["repository_ref"].(string),
+			TemplatePath:   template["template_path"].(string),
+		})
+	}
+	check.Settings = settings
+	return check, projectID, nil
+}
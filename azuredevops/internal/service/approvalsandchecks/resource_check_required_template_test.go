@@ -0,0 +1,79 @@
+//go:build (all || resource_check_required_template) && !exclude_resource_check_required_template
+// +build all resource_check_required_template
+// +build !exclude_resource_check_required_template
+
+package approvalsandchecks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testRequiredTemplateProjectID = uuid.New().String()
+
+var testRequiredTemplateCheck = pipelineschecks.GenericCheckConfiguration{
+	Id: converter.Int(17),
+	Type: &pipelineschecks.CheckType{
+		Id: &requiredTemplateCheckType,
+	},
+	Resource: &pipelineschecks.Resource{
+		Type: converter.String("environment"),
+		Id:   converter.String("3"),
+	},
+	Timeout: converter.Int(43200),
+	Settings: requiredTemplateSettings{
+		ExtendsChecks: []requiredTemplate{
+			{
+				RepositoryType: "azuregit",
+				RepositoryName: "Platform/pipeline-templates",
+				RepositoryRef:  "refs/heads/main",
+				TemplatePath:   "deploy.yml",
+			},
+			{
+				RepositoryType: "github",
+				RepositoryName: "contoso/templates",
+				RepositoryRef:  "refs/tags/v1",
+				TemplatePath:   "stages/release.yml",
+			},
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same check configuration
+func TestCheckRequiredTemplate_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckRequiredTemplate().Schema, nil)
+	require.Nil(t, requiredTemplateFlattenFunc(resourceData, &testRequiredTemplateCheck, testRequiredTemplateProjectID))
+
+	require.Equal(t, "stages/release.yml", resourceData.Get("required_template.1.template_path"))
+
+	check, projectID, err := requiredTemplateExpandFunc(resourceData, requiredTemplateCheckType)
+	require.Nil(t, err)
+	require.Equal(t, testRequiredTemplateProjectID, projectID)
+	require.Equal(t, testRequiredTemplateCheck, *check)
+}
+
+// verifies that settings returned as untyped JSON by the service are flattened
+func TestCheckRequiredTemplate_Flatten_DecodesUntypedSettings(t *testing.T) {
+	check := testRequiredTemplateCheck
+	check.Settings = map[string]interface{}{
+		"extendsChecks": []interface{}{
+			map[string]interface{}{
+				"repositoryType": "azuregit",
+				"repositoryName": "Platform/pipeline-templates",
+				"repositoryRef":  "refs/heads/main",
+				"templatePath":   "deploy.yml",
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckRequiredTemplate().Schema, nil)
+	require.Nil(t, requiredTemplateFlattenFunc(resourceData, &check, testRequiredTemplateProjectID))
+
+	require.Equal(t, 1, resourceData.Get("required_template.#"))
+	require.Equal(t, "Platform/pipeline-templates", resourceData.Get("required_template.0.repository_name"))
+}
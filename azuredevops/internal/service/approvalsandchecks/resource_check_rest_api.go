@@ -0,0 +1,71 @@
+package approvalsandchecks
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// The Invoke REST API task evaluated by the check
+var restAPIDefinitionRef = pipelinestaskcheck.TaskCheckDefinitionReference{
+	Id:      converter.UUID("9c3e8943-130d-4c78-ac63-8af81df62dfb"),
+	Name:    converter.String("InvokeRESTAPI"),
+	Version: converter.String("1.0.0"),
+}
+
+// ResourceCheckRestAPI schema and implementation for the Invoke REST API check resource
+func ResourceCheckRestAPI() *schema.Resource {
+	checkSchema := genInvokeCheckSchema("Invoke REST API")
+	checkSchema["service_endpoint_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	checkSchema["url_suffix"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+	}
+
+	return genBaseCheckResource(&checkCrudArgs{
+		FlattenFunc: restAPIFlattenFunc,
+		ExpandFunc:  restAPIExpandFunc,
+		CheckType:   taskCheckType,
+	}, checkSchema)
+}
+
+func restAPIFlattenFunc(d *schema.ResourceData, check *pipelineschecks.GenericCheckConfiguration, projectID string) error {
+	if err := baseFlattenFunc(d, check, projectID); err != nil {
+		return err
+	}
+
+	settings, err := flattenTaskCheckSettings(d, check)
+	if err != nil {
+		return err
+	}
+	flattenInvokeCheckSettings(d, settings)
+
+	inputs := *settings.Inputs
+	d.Set("service_endpoint_id", inputs["connectedServiceName"])
+	d.Set("url_suffix", inputs["urlSuffix"])
+	return nil
+}
+
+func restAPIExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*pipelineschecks.GenericCheckConfiguration, string, error) {
+	check, projectID, err := baseExpandFunc(d, typeID)
+	if err != nil {
+		return nil, "", err
+	}
+
+	definitionRef := restAPIDefinitionRef
+	settings := expandTaskCheckSettings(d, &definitionRef, expandInvokeCheckInputs(d, map[string]string{
+		"connectedServiceNameSelector": "connectedServiceName",
+		"connectedServiceName":         d.Get("service_endpoint_id").(string),
+		"urlSuffix":                    d.Get("url_suffix").(string),
+	}))
+	settings.RetryInterval = converter.Int(d.Get("retry_interval").(int))
+	check.Settings = settings
+	return check, projectID, nil
+}
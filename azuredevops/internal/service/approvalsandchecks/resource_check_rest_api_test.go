@@ -0,0 +1,58 @@
+//go:build (all || resource_check_rest_api) && !exclude_resource_check_rest_api
+// +build all resource_check_rest_api
+// +build !exclude_resource_check_rest_api
+
+package approvalsandchecks
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinestaskcheck"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testRestAPIProjectID = uuid.New().String()
+
+var testRestAPICheck = pipelineschecks.GenericCheckConfiguration{
+	Id: converter.Int(16),
+	Type: &pipelineschecks.CheckType{
+		Id: &taskCheckType,
+	},
+	Resource: &pipelineschecks.Resource{
+		Type: converter.String("environment"),
+		Id:   converter.String("3"),
+	},
+	Timeout: converter.Int(60),
+	Settings: &pipelinestaskcheck.TaskCheckConfig{
+		DefinitionRef: &restAPIDefinitionRef,
+		DisplayName:   converter.String("Change ticket approved"),
+		Inputs: &map[string]string{
+			"connectedServiceNameSelector": "connectedServiceName",
+			"connectedServiceName":         uuid.New().String(),
+			"urlSuffix":                    "api/changes/$(Build.BuildId)",
+			"method":                       "GET",
+			"headers":                      `{"Content-Type":"application/json"}`,
+			"body":                         "",
+			"waitForCompletion":            "true",
+			"successCriteria":              "",
+		},
+		RetryInterval: converter.Int(0),
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same check configuration
+func TestCheckRestAPI_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceCheckRestAPI().Schema, nil)
+	require.Nil(t, restAPIFlattenFunc(resourceData, &testRestAPICheck, testRestAPIProjectID))
+
+	require.Equal(t, completionEventCallback, resourceData.Get("completion_event"))
+
+	check, projectID, err := restAPIExpandFunc(resourceData, taskCheckType)
+	require.Nil(t, err)
+	require.Equal(t, testRestAPIProjectID, projectID)
+	require.Equal(t, testRestAPICheck, *check)
+}
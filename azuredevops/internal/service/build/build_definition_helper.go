@@ -1,5 +1,7 @@
 package build
 
+import "strings"
+
 var Date = []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
 
 var DaysToBuild = map[string]int{
@@ -46,6 +48,18 @@ func DateToDays(date []interface{}) int {
 	return daysToBuild
 }
 
+// NamedDaysToDays named days, as returned by the service, to days
+// "monday" -> 1
+// "monday, wednesday" -> 5
+// "all" -> 127
+func NamedDaysToDays(namedDays string) int {
+	daysToBuild := 0
+	for _, day := range strings.Split(namedDays, ",") {
+		daysToBuild |= DaysToBuild[strings.TrimSpace(day)]
+	}
+	return daysToBuild
+}
+
 var TimeZones = []string{
 	"(UTC-12:00) International Date Line West",
 	"(UTC-11:00) Coordinated Universal Time-11",
@@ -102,3 +102,40 @@ func TestDaysToDate(t *testing.T) {
 		}
 	}
 }
+
+func TestNamedDaysToDays(t *testing.T) {
+	cases := []struct {
+		Input  string
+		Expect int
+	}{
+		{
+			Input:  "monday",
+			Expect: 1,
+		},
+		{
+			Input:  "monday, wednesday",
+			Expect: 5,
+		},
+		{
+			Input:  "monday,tuesday,wednesday,thursday,friday",
+			Expect: 31,
+		},
+		{
+			Input:  "all",
+			Expect: 127,
+		},
+		{
+			Input:  "none",
+			Expect: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		days := NamedDaysToDays(tc.Input)
+
+		if days != tc.Expect {
+			t.Fatalf("Expected %d but got %d", tc.Expect, days)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package build
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -107,10 +109,53 @@ func DataBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"variable_names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"queue_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"agent_pool_name": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"classic_process_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"demand": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"job_authorization_scope": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_timeout_in_minutes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"job_cancel_timeout_in_minutes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 			"repository": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -144,6 +189,26 @@ func DataBuildDefinition() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"tfvc_mapping": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"server_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"local_path": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"mapping_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -297,11 +362,28 @@ func dataSourceGitRepositoryRead(d *schema.ResourceData, m interface{}) error {
 		return fmt.Errorf("Multiple build definitions with name %s found in project %s", name, projectID)
 	}
 
-	flattenBuildDefinition(d, &(*buildDefinitions)[0], projectID)
+	buildDefinition := &(*buildDefinitions)[0]
+	flattenBuildDefinition(d, buildDefinition, projectID)
+	if buildDefinition.Queue != nil && buildDefinition.Queue.Id != nil {
+		d.Set("queue_id", *buildDefinition.Queue.Id)
+	}
+	d.Set("variable_names", flattenBuildVariableNames(buildDefinition))
 
 	return nil
 }
 
+// flattenBuildVariableNames returns the sorted names of the variables of a build definition
+func flattenBuildVariableNames(buildDefinition *build.BuildDefinition) []string {
+	names := []string{}
+	if buildDefinition.Variables != nil {
+		for name := range *buildDefinition.Variables {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
 func getBuildDefinitionsByNameAndProject(clients *client.AggregatedClient, name string, path string, projectID string) (*[]build.BuildDefinition, error) {
 	getArgs := build.GetDefinitionsArgs{
 		Project: &projectID,
@@ -318,6 +400,11 @@ func getBuildDefinitionsByNameAndProject(clients *client.AggregatedClient, name
 	}
 	var buildDefinitions []build.BuildDefinition
 	for _, buildDefinition := range builds.Value {
+		// the path filter of the API also matches definitions in sub folders and is not applied for the root folder
+		if buildDefinition.Path != nil && !strings.EqualFold(*buildDefinition.Path, path) {
+			continue
+		}
+
 		build, err := clients.BuildClient.GetDefinition(clients.Ctx, build.GetDefinitionArgs{
 			Project:      &projectID,
 			DefinitionId: buildDefinition.Id,
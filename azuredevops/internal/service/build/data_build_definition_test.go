@@ -0,0 +1,79 @@
+//go:build (all || data_build_definition) && !exclude_data_build_definition
+// +build all data_build_definition
+// +build !exclude_data_build_definition
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that definitions with the same name in other folders do not match the requested path
+func TestDataBuildDefinition_Read_FiltersByPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetDefinitions(clients.Ctx, build.GetDefinitionsArgs{
+			Project: converter.String("project"),
+			Name:    converter.String("ci"),
+		}).
+		Return(&build.GetDefinitionsResponseValue{
+			Value: []build.BuildDefinitionReference{
+				{Id: converter.Int(1), Path: converter.String(`\`)},
+				{Id: converter.Int(2), Path: converter.String(`\team`)},
+			},
+		}, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.Ctx, build.GetDefinitionArgs{
+			Project:      converter.String("project"),
+			DefinitionId: converter.Int(1),
+		}).
+		Return(&build.BuildDefinition{
+			Id:       converter.Int(1),
+			Name:     converter.String("ci"),
+			Path:     converter.String(`\`),
+			Revision: converter.Int(4),
+			Queue:    &build.AgentPoolQueue{Id: converter.Int(9), Pool: &build.TaskAgentPoolReference{Name: converter.String("Azure Pipelines")}},
+			Repository: &build.BuildRepository{
+				Id:            converter.String("repo"),
+				Type:          converter.String("TfsGit"),
+				DefaultBranch: converter.String("refs/heads/main"),
+				Properties:    &map[string]string{},
+			},
+			Process: &build.YamlProcess{YamlFilename: converter.String("azure-pipelines.yml")},
+			Variables: &map[string]build.BuildDefinitionVariable{
+				"environment": {Value: converter.String("test")},
+				"api_key":     {IsSecret: converter.Bool(true)},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", "project")
+	resourceData.Set("name", "ci")
+
+	err := dataSourceGitRepositoryRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "1", resourceData.Id())
+	require.Equal(t, 4, resourceData.Get("revision"))
+	require.Equal(t, 9, resourceData.Get("queue_id"))
+	require.Equal(t, "Azure Pipelines", resourceData.Get("agent_pool_name"))
+	require.Equal(t, []interface{}{"api_key", "environment"}, resourceData.Get("variable_names"))
+}
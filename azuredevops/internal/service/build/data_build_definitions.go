@@ -0,0 +1,164 @@
+package build
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/validate"
+)
+
+// DataBuildDefinitions schema and implementation for build definitions data source
+func DataBuildDefinitions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceBuildDefinitionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      `\`,
+				ValidateFunc: validate.Path,
+			},
+			"include_sub_folders": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"definitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"path": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"queue_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBuildDefinitionsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	name := d.Get("name").(string)
+	path := d.Get("path").(string)
+	includeSubFolders := d.Get("include_sub_folders").(bool)
+
+	definitions, err := getBuildDefinitionReferences(clients, projectID, name)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" finding build definitions in project %s: %+v", projectID, err))
+	}
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Read [%d] build definitions from project %s", len(definitions), projectID)
+
+	results := make([]interface{}, 0, len(definitions))
+	ids := make([]string, 0, len(definitions))
+	for _, definition := range definitions {
+		if !isBuildDefinitionInFolder(converter.ToString(definition.Path, `\`), path, includeSubFolders) {
+			continue
+		}
+		results = append(results, flattenBuildDefinitionReference(&definition))
+		ids = append(ids, strconv.Itoa(converter.ToInt(definition.Id, 0)))
+	}
+
+	h := sha1.New()
+	if _, err := h.Write([]byte(projectID + name + path + strconv.FormatBool(includeSubFolders) + strings.Join(ids, "-"))); err != nil {
+		return diag.FromErr(fmt.Errorf(" computing hash for build definitions: %+v", err))
+	}
+	d.SetId("definitions#" + base64.URLEncoding.EncodeToString(h.Sum(nil)))
+	if err := d.Set("definitions", results); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func getBuildDefinitionReferences(clients *client.AggregatedClient, projectID string, name string) ([]build.BuildDefinitionReference, error) {
+	var definitions []build.BuildDefinitionReference
+	var currentToken string
+
+	for hasMore := true; hasMore; {
+		args := build.GetDefinitionsArgs{
+			Project:    converter.String(projectID),
+			QueryOrder: &build.DefinitionQueryOrderValues.DefinitionNameAscending,
+		}
+		if name != "" {
+			args.Name = converter.String(name)
+		}
+		if currentToken != "" {
+			args.ContinuationToken = converter.String(currentToken)
+		}
+
+		response, err := clients.BuildClient.GetDefinitions(clients.Ctx, args)
+		if err != nil {
+			return nil, err
+		}
+
+		definitions = append(definitions, response.Value...)
+		currentToken = response.ContinuationToken
+		hasMore = currentToken != ""
+	}
+
+	return definitions, nil
+}
+
+// isBuildDefinitionInFolder returns whether a build definition path equals a folder, or is below it
+func isBuildDefinitionInFolder(definitionPath string, folder string, includeSubFolders bool) bool {
+	definitionPath = strings.TrimSuffix(definitionPath, `\`)
+	folder = strings.TrimSuffix(folder, `\`)
+	if strings.EqualFold(definitionPath, folder) {
+		return true
+	}
+	return includeSubFolders && strings.HasPrefix(strings.ToLower(definitionPath), strings.ToLower(folder)+`\`)
+}
+
+func flattenBuildDefinitionReference(definition *build.BuildDefinitionReference) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":       converter.ToInt(definition.Id, 0),
+		"name":     converter.ToString(definition.Name, ""),
+		"path":     converter.ToString(definition.Path, ""),
+		"revision": converter.ToInt(definition.Revision, 0),
+	}
+	if definition.QueueStatus != nil {
+		result["queue_status"] = string(*definition.QueueStatus)
+	}
+	return result
+}
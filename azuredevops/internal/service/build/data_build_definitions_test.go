@@ -0,0 +1,77 @@
+//go:build (all || data_sources || data_build_definitions) && (!exclude_data_sources || !exclude_data_build_definitions)
+// +build all data_sources data_build_definitions
+// +build !exclude_data_sources !exclude_data_build_definitions
+
+package build
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataBuildDefinitions_IsBuildDefinitionInFolder(t *testing.T) {
+	require.True(t, isBuildDefinitionInFolder(`\`, `\`, false))
+	require.True(t, isBuildDefinitionInFolder(`\team`, `\`, true))
+	require.False(t, isBuildDefinitionInFolder(`\team`, `\`, false))
+	require.True(t, isBuildDefinitionInFolder(`\Team\web`, `\team`, true))
+	require.True(t, isBuildDefinitionInFolder(`\team`, `\Team\`, false))
+	require.False(t, isBuildDefinitionInFolder(`\teamcity`, `\team`, true))
+}
+
+// verifies that all pages of build definitions are read and filtered by folder
+func TestDataBuildDefinitions_Read_ReadsAllPages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	firstPage := buildClient.
+		EXPECT().
+		GetDefinitions(clients.Ctx, build.GetDefinitionsArgs{
+			Project:    converter.String("project"),
+			QueryOrder: &build.DefinitionQueryOrderValues.DefinitionNameAscending,
+		}).
+		Return(&build.GetDefinitionsResponseValue{
+			Value:             []build.BuildDefinitionReference{{Id: converter.Int(1), Name: converter.String("api"), Path: converter.String(`\team`)}},
+			ContinuationToken: "next",
+		}, nil).
+		Times(1)
+
+	buildClient.
+		EXPECT().
+		GetDefinitions(clients.Ctx, build.GetDefinitionsArgs{
+			Project:           converter.String("project"),
+			QueryOrder:        &build.DefinitionQueryOrderValues.DefinitionNameAscending,
+			ContinuationToken: converter.String("next"),
+		}).
+		Return(&build.GetDefinitionsResponseValue{
+			Value: []build.BuildDefinitionReference{
+				{Id: converter.Int(2), Name: converter.String("tools"), Path: converter.String(`\`)},
+				{Id: converter.Int(3), Name: converter.String("web"), Path: converter.String(`\team\web`), Revision: converter.Int(5)},
+			},
+		}, nil).
+		After(firstPage).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataBuildDefinitions().Schema, nil)
+	resourceData.Set("project_id", "project")
+	resourceData.Set("path", `\team`)
+
+	diags := dataSourceBuildDefinitionsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+
+	definitions := resourceData.Get("definitions").([]interface{})
+	require.Len(t, definitions, 2)
+	require.Equal(t, 1, definitions[0].(map[string]interface{})["id"])
+	require.Equal(t, 3, definitions[1].(map[string]interface{})["id"])
+	require.Equal(t, 5, definitions[1].(map[string]interface{})["revision"])
+}
@@ -0,0 +1,126 @@
+package build
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataBuildQueuePosition schema and implementation for the pending jobs of an agent queue data source
+func DataBuildQueuePosition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBuildQueuePositionRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"queue_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"pending_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"running_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"oldest_pending_queue_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"longest_pending_wait_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"estimated_wait_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBuildQueuePositionRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	queueID := d.Get("queue_id").(int)
+
+	pending, err := getBuildsInQueue(clients, projectID, queueID, build.BuildStatusValues.NotStarted)
+	if err != nil {
+		return fmt.Errorf(" reading pending builds of queue %d in project %s: %+v", queueID, projectID, err)
+	}
+	running, err := getBuildsInQueue(clients, projectID, queueID, build.BuildStatusValues.InProgress)
+	if err != nil {
+		return fmt.Errorf(" reading running builds of queue %d in project %s: %+v", queueID, projectID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", projectID, queueID))
+	flattenBuildQueuePosition(d, pending, running, time.Now())
+	return nil
+}
+
+// flattenBuildQueuePosition computes the queue statistics. The estimated wait time is the average time
+// the currently running builds had been waiting in the queue before an agent picked them up.
+func flattenBuildQueuePosition(d *schema.ResourceData, pending []build.Build, running []build.Build, now time.Time) {
+	var oldest *time.Time
+	for _, b := range pending {
+		if b.QueueTime != nil && (oldest == nil || b.QueueTime.Time.Before(*oldest)) {
+			oldest = &b.QueueTime.Time
+		}
+	}
+
+	var waited time.Duration
+	var started int
+	for _, b := range running {
+		if b.QueueTime != nil && b.StartTime != nil {
+			waited += b.StartTime.Time.Sub(b.QueueTime.Time)
+			started++
+		}
+	}
+
+	d.Set("pending_count", len(pending))
+	d.Set("running_count", len(running))
+	if oldest != nil {
+		d.Set("oldest_pending_queue_time", oldest.UTC().Format(time.RFC3339))
+		d.Set("longest_pending_wait_seconds", int(now.Sub(*oldest).Seconds()))
+	} else {
+		d.Set("oldest_pending_queue_time", "")
+		d.Set("longest_pending_wait_seconds", 0)
+	}
+	if started > 0 {
+		d.Set("estimated_wait_seconds", int((waited / time.Duration(started)).Seconds()))
+	} else {
+		d.Set("estimated_wait_seconds", 0)
+	}
+}
+
+func getBuildsInQueue(clients *client.AggregatedClient, projectID string, queueID int, status build.BuildStatus) ([]build.Build, error) {
+	var builds []build.Build
+	var continuationToken *string
+	for {
+		response, err := clients.BuildClient.GetBuilds(clients.Ctx, build.GetBuildsArgs{
+			Project:           converter.String(projectID),
+			Queues:            &[]int{queueID},
+			StatusFilter:      &status,
+			ContinuationToken: continuationToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		builds = append(builds, response.Value...)
+		if response.ContinuationToken == "" {
+			return builds, nil
+		}
+		continuationToken = converter.String(response.ContinuationToken)
+	}
+}
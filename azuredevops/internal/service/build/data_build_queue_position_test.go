@@ -0,0 +1,91 @@
+//go:build (all || data_build_queue_position) && !exclude_data_build_queue_position
+// +build all data_build_queue_position
+// +build !exclude_data_build_queue_position
+
+package build
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the queue statistics are computed from pending and running builds
+func TestDataBuildQueuePosition_FlattenComputesStatistics(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+	pending := []build.Build{
+		{QueueTime: &azuredevops.Time{Time: now.Add(-5 * time.Minute)}},
+		{QueueTime: &azuredevops.Time{Time: now.Add(-1 * time.Minute)}},
+	}
+	running := []build.Build{
+		{QueueTime: &azuredevops.Time{Time: now.Add(-10 * time.Minute)}, StartTime: &azuredevops.Time{Time: now.Add(-8 * time.Minute)}},
+		{QueueTime: &azuredevops.Time{Time: now.Add(-6 * time.Minute)}, StartTime: &azuredevops.Time{Time: now.Add(-2 * time.Minute)}},
+	}
+
+	d := schema.TestResourceDataRaw(t, DataBuildQueuePosition().Schema, nil)
+	flattenBuildQueuePosition(d, pending, running, now)
+
+	require.Equal(t, 2, d.Get("pending_count"))
+	require.Equal(t, 2, d.Get("running_count"))
+	require.Equal(t, "2022-10-01T11:55:00Z", d.Get("oldest_pending_queue_time"))
+	require.Equal(t, 300, d.Get("longest_pending_wait_seconds"))
+	require.Equal(t, 180, d.Get("estimated_wait_seconds"))
+}
+
+// verifies that an idle queue reports zero values
+func TestDataBuildQueuePosition_FlattenEmptyQueue(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, DataBuildQueuePosition().Schema, nil)
+	flattenBuildQueuePosition(d, nil, nil, time.Now())
+
+	require.Equal(t, 0, d.Get("pending_count"))
+	require.Equal(t, "", d.Get("oldest_pending_queue_time"))
+	require.Equal(t, 0, d.Get("estimated_wait_seconds"))
+}
+
+// verifies that all pages of builds are read
+func TestDataBuildQueuePosition_ReadFollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{
+		BuildClient: buildClient,
+		Ctx:         context.Background(),
+	}
+
+	projectID := uuid.New().String()
+	status := build.BuildStatusValues.NotStarted
+	buildClient.
+		EXPECT().
+		GetBuilds(clients.Ctx, build.GetBuildsArgs{
+			Project:      converter.String(projectID),
+			Queues:       &[]int{7},
+			StatusFilter: &status,
+		}).
+		Return(&build.GetBuildsResponseValue{Value: []build.Build{{}}, ContinuationToken: "next"}, nil).
+		Times(1)
+	buildClient.
+		EXPECT().
+		GetBuilds(clients.Ctx, build.GetBuildsArgs{
+			Project:           converter.String(projectID),
+			Queues:            &[]int{7},
+			StatusFilter:      &status,
+			ContinuationToken: converter.String("next"),
+		}).
+		Return(&build.GetBuildsResponseValue{Value: []build.Build{{}}}, nil).
+		Times(1)
+
+	builds, err := getBuildsInQueue(clients, projectID, 7, status)
+	require.Nil(t, err)
+	require.Len(t, builds, 2)
+}
@@ -0,0 +1,142 @@
+package build
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataBuildStatusBadge schema and implementation for the status badge of a build definition data source
+func DataBuildStatusBadge() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBuildStatusBadgeRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"definition_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"branch_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"stage_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"job_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"stage_name"},
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"web_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"markdown": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBuildStatusBadgeRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	definitionID := d.Get("definition_id").(int)
+
+	definition, err := clients.BuildClient.GetDefinition(clients.Ctx, build.GetDefinitionArgs{
+		Project:      converter.String(projectID),
+		DefinitionId: converter.Int(definitionID),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading build definition %d in project %s: %+v", definitionID, projectID, err)
+	}
+
+	badgeURL, webURL, err := getBuildStatusBadgeURLs(definition, d)
+	if err != nil {
+		return fmt.Errorf(" computing status badge of build definition %d in project %s: %+v", definitionID, projectID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", projectID, definitionID))
+	d.Set("url", badgeURL)
+	d.Set("web_url", webURL)
+	d.Set("markdown", fmt.Sprintf("[![Build Status](%s)](%s)", badgeURL, webURL))
+	return nil
+}
+
+// getBuildStatusBadgeURLs returns the URL of the status badge image and the URL of the page the badge links to,
+// both derived from the links of the build definition
+func getBuildStatusBadgeURLs(definition *build.BuildDefinition, d *schema.ResourceData) (string, string, error) {
+	badgeHref, err := getBuildDefinitionLink(definition, "badge")
+	if err != nil {
+		return "", "", err
+	}
+	badgeURL, err := url.Parse(badgeHref)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing badge link %s: %+v", badgeHref, err)
+	}
+
+	webHref, err := getBuildDefinitionLink(definition, "web")
+	if err != nil {
+		return "", "", err
+	}
+	webURL, err := url.Parse(webHref)
+	if err != nil {
+		return "", "", fmt.Errorf("parsing web link %s: %+v", webHref, err)
+	}
+
+	badgeQuery := badgeURL.Query()
+	webQuery := webURL.Query()
+	if v, ok := d.GetOk("branch_name"); ok {
+		badgeQuery.Set("branchName", v.(string))
+		webQuery.Set("branchName", v.(string))
+	}
+	if v, ok := d.GetOk("stage_name"); ok {
+		badgeQuery.Set("stageName", v.(string))
+	}
+	if v, ok := d.GetOk("job_name"); ok {
+		badgeQuery.Set("jobName", v.(string))
+	}
+	if v, ok := d.GetOk("label"); ok {
+		badgeQuery.Set("label", v.(string))
+	}
+	badgeURL.RawQuery = badgeQuery.Encode()
+	webURL.RawQuery = webQuery.Encode()
+
+	return badgeURL.String(), webURL.String(), nil
+}
+
+func getBuildDefinitionLink(definition *build.BuildDefinition, name string) (string, error) {
+	if links, ok := definition.Links.(map[string]interface{}); ok {
+		if link, ok := links[name].(map[string]interface{}); ok {
+			if href, ok := link["href"].(string); ok && href != "" {
+				return href, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("build definition has no %s link", name)
+}
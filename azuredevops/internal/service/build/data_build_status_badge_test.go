@@ -0,0 +1,86 @@
+//go:build (all || data_sources || data_build_status_badge) && (!exclude_data_sources || !exclude_data_build_status_badge)
+// +build all data_sources data_build_status_badge
+// +build !exclude_data_sources !exclude_data_build_status_badge
+
+package build
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testStatusBadgeProjectID = uuid.New().String()
+
+var testStatusBadgeDefinition = build.BuildDefinition{
+	Id:   converter.Int(8),
+	Name: converter.String("ci"),
+	Links: map[string]interface{}{
+		"badge": map[string]interface{}{"href": "https://dev.azure.com/org/" + testStatusBadgeProjectID + "/_apis/build/status/8"},
+		"web":   map[string]interface{}{"href": "https://dev.azure.com/org/" + testStatusBadgeProjectID + "/_build/definition?definitionId=8"},
+	},
+}
+
+// verifies that the badge and web URLs are derived from the definition links and the configured branch
+func TestDataBuildStatusBadge_Read_ComputesURLs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.Ctx, build.GetDefinitionArgs{
+			Project:      converter.String(testStatusBadgeProjectID),
+			DefinitionId: converter.Int(8),
+		}).
+		Return(&testStatusBadgeDefinition, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataBuildStatusBadge().Schema, nil)
+	d.Set("project_id", testStatusBadgeProjectID)
+	d.Set("definition_id", 8)
+	d.Set("branch_name", "refs/heads/main")
+
+	err := dataSourceBuildStatusBadgeRead(d, clients)
+	require.Nil(t, err)
+
+	badgeURL := "https://dev.azure.com/org/" + testStatusBadgeProjectID + "/_apis/build/status/8?branchName=refs%2Fheads%2Fmain"
+	webURL := "https://dev.azure.com/org/" + testStatusBadgeProjectID + "/_build/definition?branchName=refs%2Fheads%2Fmain&definitionId=8"
+	require.Equal(t, badgeURL, d.Get("url"))
+	require.Equal(t, webURL, d.Get("web_url"))
+	require.Equal(t, "[![Build Status]("+badgeURL+")]("+webURL+")", d.Get("markdown"))
+}
+
+// verifies that an error is returned if the definition cannot be read
+func TestDataBuildStatusBadge_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		GetDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetDefinition() Failed")).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, DataBuildStatusBadge().Schema, nil)
+	d.Set("project_id", testStatusBadgeProjectID)
+	d.Set("definition_id", 8)
+
+	err := dataSourceBuildStatusBadgeRead(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "GetDefinition() Failed")
+}
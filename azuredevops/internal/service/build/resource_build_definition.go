@@ -1,9 +1,14 @@
 package build
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
 	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -27,6 +32,35 @@ const (
 	bdVariableAllowOverride = "allow_override"
 )
 
+// designerProcessType is the process type of classic (designer) build definitions
+const designerProcessType = 1
+
+// classicProcessServerDefaults are the values Azure DevOps fills in for properties of phases, steps and targets of a
+// designer process which are not configured. A property missing on one side is equal to its default on the other.
+var classicProcessServerDefaults = map[string]interface{}{
+	"alwaysRun":                    false,
+	"allowScriptsAuthAccessOption": false,
+	"condition":                    "succeeded()",
+	"continueOnError":              false,
+	"definitionType":               "task",
+	"enabled":                      true,
+	"environment":                  map[string]interface{}{},
+	"executionOptions":             map[string]interface{}{"type": float64(0)},
+	"jobAuthorizationScope":        "projectCollection",
+	"retryCountOnTaskFailure":      float64(0),
+	"timeoutInMinutes":             float64(0),
+}
+
+// classicProcessServerGeneratedKeys are the properties Azure DevOps generates if they are not configured
+var classicProcessServerGeneratedKeys = map[string]bool{
+	"refName": true,
+}
+
+const (
+	tfvcMappingTypeMap   = "map"
+	tfvcMappingTypeCloak = "cloak"
+)
+
 // ResourceBuildDefinition schema and implementation for build definition resource
 func ResourceBuildDefinition() *schema.Resource {
 	filterSchema := map[string]*schema.Schema{
@@ -135,11 +169,57 @@ func ResourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"classic_process_json": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: suppressEquivalentClassicProcess,
+				ConflictsWith:    []string{"repository.0.yml_path"},
+			},
 			"agent_pool_name": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Default:  "Azure Pipelines",
 			},
+			"demand": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+					},
+				},
+			},
+			"job_authorization_scope": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(build.BuildAuthorizationScopeValues.ProjectCollection),
+					string(build.BuildAuthorizationScopeValues.Project),
+				}, false),
+			},
+			"job_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"job_cancel_timeout_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 60),
+			},
 			"repository": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -149,7 +229,7 @@ func ResourceBuildDefinition() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"yml_path": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 						},
 						"repo_id": {
 							Type:     schema.TypeString,
@@ -163,6 +243,7 @@ func ResourceBuildDefinition() *schema.Resource {
 								string(model.RepoTypeValues.TfsGit),
 								string(model.RepoTypeValues.Bitbucket),
 								string(model.RepoTypeValues.GitHubEnterprise),
+								string(model.RepoTypeValues.Tfvc),
 							}, false),
 						},
 						"branch_name": {
@@ -185,6 +266,68 @@ func ResourceBuildDefinition() *schema.Resource {
 							Optional: true,
 							Default:  true,
 						},
+						"tfvc_mapping": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"server_path": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringMatch(regexp.MustCompile(`^\$/`), "server_path must start with $/"),
+									},
+									"local_path": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Default:  `\`,
+									},
+									"mapping_type": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      tfvcMappingTypeMap,
+										ValidateFunc: validation.StringInSlice([]string{tfvcMappingTypeMap, tfvcMappingTypeCloak}, false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"repository_resource": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"repo_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(model.RepoTypeValues.GitHub),
+								string(model.RepoTypeValues.TfsGit),
+								string(model.RepoTypeValues.Bitbucket),
+								string(model.RepoTypeValues.GitHubEnterprise),
+							}, false),
+						},
+						"repo_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"service_connection_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"alias": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
+						"ref": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "",
+						},
 					},
 				},
 			},
@@ -295,6 +438,43 @@ func ResourceBuildDefinition() *schema.Resource {
 					},
 				},
 			},
+			"gated_checkin_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"run_continuous_integration": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"use_workspace_mappings": {
+							Type:          schema.TypeBool,
+							Optional:      true,
+							Default:       true,
+							ConflictsWith: []string{"gated_checkin_trigger.0.path_filter"},
+						},
+						"path_filter": pathFilter,
+					},
+				},
+			},
+			"build_completion_trigger": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"build_definition_id": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"branch_filter": branchFilter,
+					},
+				},
+			},
 			"schedules": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -362,6 +542,9 @@ func resourceBuildDefinitionCreate(d *schema.ResourceData, m interface{}) error
 	}
 
 	flattenBuildDefinition(d, createdBuildDefinition, projectID)
+	if err := authorizeRepositoryResources(clients, projectID, *createdBuildDefinition.Id, nil, d.Get("repository_resource").(*schema.Set).List()); err != nil {
+		return err
+	}
 	return resourceBuildDefinitionRead(d, m)
 }
 
@@ -387,6 +570,12 @@ func resourceBuildDefinitionRead(d *schema.ResourceData, m interface{}) error {
 	}
 
 	flattenBuildDefinition(d, buildDefinition, projectID)
+
+	repositoryResources, err := readRepositoryResources(clients, projectID, buildDefinitionID, d.Get("repository_resource").(*schema.Set).List())
+	if err != nil {
+		return err
+	}
+	d.Set("repository_resource", repositoryResources)
 	return nil
 }
 
@@ -412,6 +601,14 @@ func resourceBuildDefinitionUpdate(d *schema.ResourceData, m interface{}) error
 	}
 
 	flattenBuildDefinition(d, updatedBuildDefinition, projectID)
+	if d.HasChange("repository_resource") {
+		oldResources, newResources := d.GetChange("repository_resource")
+		revoked := oldResources.(*schema.Set).Difference(newResources.(*schema.Set)).List()
+		authorized := newResources.(*schema.Set).Difference(oldResources.(*schema.Set)).List()
+		if err := authorizeRepositoryResources(clients, projectID, *updatedBuildDefinition.Id, revoked, authorized); err != nil {
+			return err
+		}
+	}
 	return resourceBuildDefinitionRead(d, m)
 }
 
@@ -426,12 +623,17 @@ func resourceBuildDefinitionDelete(d *schema.ResourceData, m interface{}) error
 		return err
 	}
 
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Deleting build definition [%d] in project [%s]", buildDefinitionID, projectID)
 	err = clients.BuildClient.DeleteDefinition(m.(*client.AggregatedClient).Ctx, build.DeleteDefinitionArgs{
 		Project:      &projectID,
 		DefinitionId: &buildDefinitionID,
 	})
+	if err != nil {
+		return err
+	}
 
-	return err
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Deleted build definition [%d] in project [%s]", buildDefinitionID, projectID)
+	return nil
 }
 
 func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.BuildDefinition, projectID string) {
@@ -441,11 +643,23 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 	d.Set("name", *buildDefinition.Name)
 	d.Set("path", *buildDefinition.Path)
 	d.Set("repository", flattenRepository(buildDefinition))
+	d.Set("classic_process_json", flattenClassicProcess(buildDefinition))
 
 	if buildDefinition.Queue != nil && buildDefinition.Queue.Pool != nil {
 		d.Set("agent_pool_name", *buildDefinition.Queue.Pool.Name)
 	}
 
+	d.Set("demand", flattenDemands(buildDefinition.Demands))
+	if buildDefinition.JobAuthorizationScope != nil {
+		d.Set("job_authorization_scope", string(*buildDefinition.JobAuthorizationScope))
+	}
+	if buildDefinition.JobTimeoutInMinutes != nil {
+		d.Set("job_timeout_in_minutes", *buildDefinition.JobTimeoutInMinutes)
+	}
+	if buildDefinition.JobCancelTimeoutInMinutes != nil {
+		d.Set("job_cancel_timeout_in_minutes", *buildDefinition.JobCancelTimeoutInMinutes)
+	}
+
 	d.Set("variable_groups", flattenVariableGroups(buildDefinition))
 	d.Set(bdVariable, flattenBuildVariables(d, buildDefinition))
 
@@ -463,6 +677,9 @@ func flattenBuildDefinition(d *schema.ResourceData, buildDefinition *build.Build
 		if triggers[build.DefinitionTriggerTypeValues.Schedule] != nil {
 			d.Set("schedules", triggers[build.DefinitionTriggerTypeValues.Schedule])
 		}
+
+		d.Set("build_completion_trigger", triggers[build.DefinitionTriggerTypeValues.BuildCompletion])
+		d.Set("gated_checkin_trigger", triggers[build.DefinitionTriggerTypeValues.GatedCheckIn])
 	}
 
 	revision := 0
@@ -538,7 +755,9 @@ func flattenRepository(buildDefinition *build.BuildDefinition) interface{} {
 	// available from the compiler is `interface{}` so we can probe for known
 	// implementations
 	if processMap, ok := buildDefinition.Process.(map[string]interface{}); ok {
-		yamlFilePath = processMap["yamlFilename"].(string)
+		if yamlFilename, ok := processMap["yamlFilename"].(string); ok {
+			yamlFilePath = yamlFilename
+		}
 	}
 	if yamlProcess, ok := buildDefinition.Process.(*build.YamlProcess); ok {
 		yamlFilePath = *yamlProcess.YamlFilename
@@ -570,6 +789,14 @@ func flattenRepository(buildDefinition *build.BuildDefinition) interface{} {
 			reportBuildStatus, _ := strconv.ParseBool(buildStatus)
 			repo[0]["report_build_status"] = reportBuildStatus
 		}
+
+		if tfvcMapping, ok := (*buildDefinition.Repository.Properties)["tfvcMapping"]; ok {
+			mappings, err := flattenTfvcMappings(tfvcMapping)
+			if err != nil {
+				return fmt.Errorf("Unable to parse TFVC mappings: %+v ", err)
+			}
+			repo[0]["tfvc_mapping"] = mappings
+		}
 	}
 	return repo
 }
@@ -662,28 +889,59 @@ func flattenBuildDefinitionScheduleTrigger(ms map[string]interface{}) []interfac
 	schedules := make([]interface{}, 0)
 	for _, schedule := range schedulesResp {
 		schedule := schedule.(map[string]interface{})
-		branchFilter := flattenBuildDefinitionBranchOrPathFilter(schedule["branchFilters"].([]interface{}))
 		scheduleConfig := map[string]interface{}{
-			"branch_filter":              branchFilter,
 			"schedule_only_with_changes": schedule["scheduleOnlyWithChanges"],
 			"start_hours":                schedule["startHours"],
 			"start_minutes":              schedule["startMinutes"],
-			"time_zone":                  IDToTimeZones[schedule["timeZoneId"].(string)],
 			"schedule_job_id":            schedule["scheduleJobId"],
 		}
+		if branchFilters, ok := schedule["branchFilters"].([]interface{}); ok {
+			scheduleConfig["branch_filter"] = flattenBuildDefinitionBranchOrPathFilter(branchFilters)
+		}
+		if timeZoneID, ok := schedule["timeZoneId"].(string); ok {
+			scheduleConfig["time_zone"] = IDToTimeZones[timeZoneID]
+		}
 
 		days := schedule["daysToBuild"]
 		switch day := days.(type) {
 		case float64:
 			scheduleConfig["days_to_build"] = DaysToDate(int(day))
 		case string:
-			scheduleConfig["days_to_build"] = DaysToDate(DaysToBuild[day])
+			// the service returns the days either as flags or by name, e.g. "monday, friday"
+			scheduleConfig["days_to_build"] = DaysToDate(NamedDaysToDays(day))
 		}
 		schedules = append(schedules, scheduleConfig)
 	}
 	return schedules
 }
 
+func flattenBuildDefinitionBuildCompletionTrigger(ms map[string]interface{}) interface{} {
+	f := map[string]interface{}{}
+	if definition, ok := ms["definition"].(map[string]interface{}); ok {
+		switch id := definition["id"].(type) {
+		case float64:
+			f["build_definition_id"] = int(id)
+		case int:
+			f["build_definition_id"] = id
+		}
+	}
+	if branchFilters, ok := ms["branchFilters"].([]interface{}); ok {
+		f["branch_filter"] = flattenBuildDefinitionBranchOrPathFilter(branchFilters)
+	}
+	return f
+}
+
+func flattenBuildDefinitionGatedCheckInTrigger(ms map[string]interface{}) interface{} {
+	f := map[string]interface{}{
+		"run_continuous_integration": ms["runContinuousIntegration"],
+		"use_workspace_mappings":     ms["useWorkspaceMappings"],
+	}
+	if pathFilters, ok := ms["pathFilters"].([]interface{}); ok && len(pathFilters) > 0 {
+		f["path_filter"] = flattenBuildDefinitionBranchOrPathFilter(pathFilters)
+	}
+	return f
+}
+
 func flattenTriggers(m *[]interface{}) map[build.DefinitionTriggerType][]interface{} {
 	buildTriggers := map[build.DefinitionTriggerType][]interface{}{}
 	for _, ds := range *m {
@@ -708,6 +966,16 @@ func flattenTriggers(m *[]interface{}) map[build.DefinitionTriggerType][]interfa
 		if strings.EqualFold(triggerType, string(build.DefinitionTriggerTypeValues.Schedule)) {
 			buildTriggers[build.DefinitionTriggerTypeValues.Schedule] = flattenBuildDefinitionScheduleTrigger(trigger)
 		}
+		if strings.EqualFold(triggerType, string(build.DefinitionTriggerTypeValues.GatedCheckIn)) {
+			buildTriggers[build.DefinitionTriggerTypeValues.GatedCheckIn] =
+				[]interface{}{flattenBuildDefinitionGatedCheckInTrigger(trigger)}
+		}
+		// every triggering definition is a trigger of its own
+		if strings.EqualFold(triggerType, string(build.DefinitionTriggerTypeValues.BuildCompletion)) {
+			buildTriggers[build.DefinitionTriggerTypeValues.BuildCompletion] = append(
+				buildTriggers[build.DefinitionTriggerTypeValues.BuildCompletion],
+				flattenBuildDefinitionBuildCompletionTrigger(trigger))
+		}
 	}
 	return buildTriggers
 }
@@ -868,6 +1136,25 @@ func expandBuildDefinitionTrigger(d map[string]interface{}, t build.DefinitionTr
 		}
 		scheduleConfig["daysToBuild"] = DateToDays(d["days_to_build"].([]interface{}))
 		return scheduleConfig
+	case build.DefinitionTriggerTypeValues.GatedCheckIn:
+		pathFilters := []interface{}{}
+		if filters := expandBuildDefinitionBranchOrPathFilterSet(d["path_filter"].(*schema.Set)); filters != nil {
+			pathFilters = filters
+		}
+		return map[string]interface{}{
+			"pathFilters":              pathFilters,
+			"runContinuousIntegration": d["run_continuous_integration"].(bool),
+			"useWorkspaceMappings":     d["use_workspace_mappings"].(bool),
+			"triggerType":              string(t),
+		}
+	case build.DefinitionTriggerTypeValues.BuildCompletion:
+		return map[string]interface{}{
+			"branchFilters": expandBuildDefinitionBranchOrPathFilterSet(d["branch_filter"].(*schema.Set)),
+			"definition": map[string]interface{}{
+				"id": d["build_definition_id"].(int),
+			},
+			"triggerType": string(t),
+		}
 	}
 	return nil
 }
@@ -984,6 +1271,21 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 		buildTriggers = append(buildTriggers, scheduleTriggers)
 	}
 
+	buildCompletionTriggers := expandBuildDefinitionTriggerList(
+		d.Get("build_completion_trigger").([]interface{}),
+		build.DefinitionTriggerTypeValues.BuildCompletion,
+	)
+	buildTriggers = append(buildTriggers, buildCompletionTriggers...)
+
+	gatedCheckInTriggers := expandBuildDefinitionTriggerList(
+		d.Get("gated_checkin_trigger").([]interface{}),
+		build.DefinitionTriggerTypeValues.GatedCheckIn,
+	)
+	if len(gatedCheckInTriggers) > 0 && !strings.EqualFold(string(repoType), string(model.RepoTypeValues.Tfvc)) {
+		return nil, "", fmt.Errorf("gated_checkin_trigger is only supported for %s repositories", model.RepoTypeValues.Tfvc)
+	}
+	buildTriggers = append(buildTriggers, gatedCheckInTriggers...)
+
 	// Look for the ID. This may not exist if we are within the context of a "create" operation,
 	// so it is OK if it is missing.
 	buildDefinitionID, err := strconv.Atoi(d.Id())
@@ -999,6 +1301,14 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 		return nil, "", fmt.Errorf("Error expanding varibles: %+v", err)
 	}
 
+	process, err := expandProcess(d, repository)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, isYaml := process.(*build.YamlProcess); isYaml && strings.EqualFold(string(repoType), string(model.RepoTypeValues.Tfvc)) {
+		return nil, "", fmt.Errorf("%s repositories are only supported by classic build definitions, use classic_process_json instead of repository.yml_path", model.RepoTypeValues.Tfvc)
+	}
+
 	buildDefinition := build.BuildDefinition{
 		Id:       buildDefinitionReference,
 		Name:     converter.String(d.Get("name").(string)),
@@ -1016,15 +1326,38 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 				"reportBuildStatus":  strconv.FormatBool(repository["report_build_status"].(bool)),
 			},
 		},
-		Process: &build.YamlProcess{
-			YamlFilename: converter.String(repository["yml_path"].(string)),
-		},
+		Process:        process,
 		QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
 		Type:           &build.DefinitionTypeValues.Build,
 		Quality:        &build.DefinitionQualityValues.Definition,
 		VariableGroups: expandVariableGroups(d),
 		Variables:      variables,
 		Triggers:       &buildTriggers,
+
+		Demands: expandDemands(d.Get("demand").(*schema.Set)),
+	}
+
+	// the job settings are only sent if they are configured or known from the state, so the service keeps
+	// the values of existing definitions instead of reverting them to the defaults of the provider
+	if v, ok := d.GetOk("job_authorization_scope"); ok {
+		jobAuthorizationScope := build.BuildAuthorizationScope(v.(string))
+		buildDefinition.JobAuthorizationScope = &jobAuthorizationScope
+	}
+	// 0 is a valid timeout that means no limit, so an explicitly configured 0 is sent as well
+	if v, ok := d.GetOk("job_timeout_in_minutes"); ok || isConfigured(d, "job_timeout_in_minutes") {
+		buildDefinition.JobTimeoutInMinutes = converter.Int(v.(int))
+	}
+	if v, ok := d.GetOk("job_cancel_timeout_in_minutes"); ok {
+		buildDefinition.JobCancelTimeoutInMinutes = converter.Int(v.(int))
+	}
+
+	if strings.EqualFold(string(repoType), string(model.RepoTypeValues.Tfvc)) {
+		tfvcMapping, err := expandTfvcMappings(repository["tfvc_mapping"].([]interface{}))
+		if err != nil {
+			return nil, "", fmt.Errorf("Error expanding TFVC mappings: %+v", err)
+		}
+		(*buildDefinition.Repository.Properties)["tfvcMapping"] = tfvcMapping
+		buildDefinition.Repository.RootFolder = &repoID
 	}
 
 	if agentPoolName, ok := d.GetOk("agent_pool_name"); ok {
@@ -1039,6 +1372,264 @@ func expandBuildDefinition(d *schema.ResourceData) (*build.BuildDefinition, stri
 	return &buildDefinition, projectID, nil
 }
 
+// expandDemands returns the agent demands in the form used by the service: the capability name, optionally followed
+// by " -equals <value>". The demands are sorted to keep the request stable.
+func expandDemands(configured *schema.Set) *[]interface{} {
+	values := make([]string, 0, configured.Len())
+	for _, v := range configured.List() {
+		demand := v.(map[string]interface{})
+		value := demand["name"].(string)
+		if demandValue := demand["value"].(string); demandValue != "" {
+			value = fmt.Sprintf("%s -equals %s", value, demandValue)
+		}
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	demands := make([]interface{}, len(values))
+	for i, v := range values {
+		demands[i] = v
+	}
+	return &demands
+}
+
+func flattenDemands(demands *[]interface{}) []interface{} {
+	if demands == nil {
+		return nil
+	}
+
+	result := make([]interface{}, 0, len(*demands))
+	for _, v := range *demands {
+		var name, value string
+		switch demand := v.(type) {
+		case string:
+			parts := strings.SplitN(demand, " -equals ", 2)
+			name = strings.TrimSpace(parts[0])
+			if len(parts) == 2 {
+				value = strings.TrimSpace(parts[1])
+			}
+		case map[string]interface{}:
+			name, _ = demand["name"].(string)
+			value, _ = demand["value"].(string)
+		default:
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"name":  name,
+			"value": value,
+		})
+	}
+	return result
+}
+
+// expandTfvcMappings returns the workspace mappings of a TFVC repository in the JSON form stored in
+// the repository properties
+func expandTfvcMappings(d []interface{}) (string, error) {
+	mappings := make([]map[string]interface{}, 0, len(d))
+	for _, v := range d {
+		if mapping, ok := v.(map[string]interface{}); ok {
+			mappings = append(mappings, map[string]interface{}{
+				"serverPath":  mapping["server_path"].(string),
+				"localPath":   mapping["local_path"].(string),
+				"mappingType": mapping["mapping_type"].(string),
+			})
+		}
+	}
+	raw, err := json.Marshal(map[string]interface{}{"mappings": mappings})
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func flattenTfvcMappings(tfvcMapping string) ([]interface{}, error) {
+	var mappings struct {
+		Mappings []struct {
+			ServerPath  string `json:"serverPath"`
+			LocalPath   string `json:"localPath"`
+			MappingType string `json:"mappingType"`
+		} `json:"mappings"`
+	}
+	if err := json.Unmarshal([]byte(tfvcMapping), &mappings); err != nil {
+		return nil, err
+	}
+
+	result := make([]interface{}, 0, len(mappings.Mappings))
+	for _, mapping := range mappings.Mappings {
+		result = append(result, map[string]interface{}{
+			"server_path":  mapping.ServerPath,
+			"local_path":   mapping.LocalPath,
+			"mapping_type": strings.ToLower(mapping.MappingType),
+		})
+	}
+	return result, nil
+}
+
+// expandProcess returns the YAML process referencing the configured pipeline file, or the designer
+// process described by classic_process_json
+func expandProcess(d *schema.ResourceData, repository map[string]interface{}) (interface{}, error) {
+	ymlPath := repository["yml_path"].(string)
+	processJSON := d.Get("classic_process_json").(string)
+
+	if processJSON == "" {
+		if ymlPath == "" {
+			return nil, errors.New("one of repository.yml_path or classic_process_json must be specified")
+		}
+		return &build.YamlProcess{
+			YamlFilename: converter.String(ymlPath),
+		}, nil
+	}
+
+	process, err := parseClassicProcess(processJSON)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing classic_process_json: %+v", err)
+	}
+	process["type"] = designerProcessType
+	return process, nil
+}
+
+// flattenClassicProcess returns the JSON of a designer process without its type marker, or an
+// empty string if the definition is YAML based
+func flattenClassicProcess(buildDefinition *build.BuildDefinition) string {
+	if buildDefinition.Process == nil {
+		return ""
+	}
+	raw, err := json.Marshal(buildDefinition.Process)
+	if err != nil {
+		return ""
+	}
+	process, err := parseClassicProcess(string(raw))
+	if err != nil || process["type"] != float64(designerProcessType) {
+		return ""
+	}
+	delete(process, "type")
+
+	raw, err = json.Marshal(process)
+	if err != nil {
+		return ""
+	}
+	return string(raw)
+}
+
+func parseClassicProcess(processJSON string) (map[string]interface{}, error) {
+	var process map[string]interface{}
+	if err := json.Unmarshal([]byte(processJSON), &process); err != nil {
+		return nil, err
+	}
+	if process == nil {
+		return nil, errors.New("process must be a JSON object")
+	}
+	return process, nil
+}
+
+// suppressEquivalentClassicProcess ignores formatting, key ordering, the type marker, which is
+// always managed by the provider, and the properties Azure DevOps fills in for a designer process
+func suppressEquivalentClassicProcess(_, old, new string, _ *schema.ResourceData) bool {
+	oldProcess, err := parseClassicProcess(old)
+	if err != nil {
+		return false
+	}
+	newProcess, err := parseClassicProcess(new)
+	if err != nil {
+		return false
+	}
+	delete(oldProcess, "type")
+	delete(newProcess, "type")
+	return equivalentClassicProcessValues(oldProcess, newProcess)
+}
+
+// equivalentClassicProcessValues compares the state of a designer process with its configuration. Properties
+// missing on one side are compared with their server defaults, generated properties only need to be present in
+// the state.
+func equivalentClassicProcessValues(state interface{}, config interface{}) bool {
+	switch stateValue := state.(type) {
+	case map[string]interface{}:
+		configValue, ok := config.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key, value := range stateValue {
+			if configItem, ok := configValue[key]; ok {
+				if !equivalentClassicProcessValues(value, configItem) {
+					return false
+				}
+			} else if !classicProcessServerGeneratedKeys[key] && !isClassicProcessServerDefault(key, value) {
+				return false
+			}
+		}
+		for key, value := range configValue {
+			if _, ok := stateValue[key]; !ok && !isClassicProcessServerDefault(key, value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		configValue, ok := config.([]interface{})
+		if !ok || len(stateValue) != len(configValue) {
+			return false
+		}
+		for i := range stateValue {
+			if !equivalentClassicProcessValues(stateValue[i], configValue[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(state, config)
+	}
+}
+
+func isClassicProcessServerDefault(key string, value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	defaultValue, ok := classicProcessServerDefaults[key]
+	return ok && reflect.DeepEqual(defaultValue, value)
+}
+
+// authorizeRepositoryResources authorizes the build definition to use the additional repositories declared in
+// resources.repositories of its YAML file, so that the first run does not wait for a manual approval. Repositories
+// of the project are authorized directly, other repositories through their service connection.
+func authorizeRepositoryResources(clients *client.AggregatedClient, projectID string, definitionID int, revoked []interface{}, authorized []interface{}) error {
+	for _, changes := range []struct {
+		resources  []interface{}
+		authorized bool
+	}{{revoked, false}, {authorized, true}} {
+		for _, raw := range changes.resources {
+			resourceType, resourceID := expandRepositoryResource(raw.(map[string]interface{}))
+			if err := setPipelinePermission(clients, projectID, resourceType, resourceID, definitionID, changes.authorized); err != nil {
+				return fmt.Errorf(" updating authorization of build definition %d for %s %s: %+v", definitionID, resourceType, resourceID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// readRepositoryResources returns the repository resources the build definition is still authorized for. The
+// repositories of a YAML pipeline are declared in its YAML file, so only the repositories of the state are checked.
+func readRepositoryResources(clients *client.AggregatedClient, projectID string, definitionID int, resources []interface{}) ([]interface{}, error) {
+	authorizedResources := make([]interface{}, 0, len(resources))
+	for _, raw := range resources {
+		resourceType, resourceID := expandRepositoryResource(raw.(map[string]interface{}))
+		authorized, err := getPipelinePermission(clients, projectID, resourceType, resourceID, definitionID)
+		if err != nil {
+			return nil, fmt.Errorf(" reading authorization of build definition %d for %s %s: %+v", definitionID, resourceType, resourceID, err)
+		}
+		if authorized {
+			authorizedResources = append(authorizedResources, raw)
+		}
+	}
+	return authorizedResources, nil
+}
+
+// expandRepositoryResource returns the type and ID of the protected resource a pipeline must be authorized for to use the repository
+func expandRepositoryResource(repository map[string]interface{}) (string, string) {
+	if strings.EqualFold(repository["repo_type"].(string), string(model.RepoTypeValues.TfsGit)) {
+		return repositoryResourceType, repository["repo_id"].(string)
+	}
+	return "endpoint", repository["service_connection_id"].(string)
+}
+
 /**
  * certain types of build definitions require a service connection to run. This function
  * returns an error if a service connection was needed but not provided
@@ -1056,6 +1647,13 @@ func validateServiceConnectionIDExistsIfNeeded(d *schema.ResourceData) error {
 	if strings.EqualFold(repoType, string(model.RepoTypeValues.GitHubEnterprise)) && serviceConnectionID == "" {
 		return errors.New("GitHub Enterprise repositories need a referenced service connection ID")
 	}
+
+	for _, raw := range d.Get("repository_resource").(*schema.Set).List() {
+		repositoryResource := raw.(map[string]interface{})
+		if !strings.EqualFold(repositoryResource["repo_type"].(string), string(model.RepoTypeValues.TfsGit)) && repositoryResource["service_connection_id"].(string) == "" {
+			return fmt.Errorf("repository resource %s of type %s needs a referenced service connection ID", repositoryResource["repo_id"].(string), repositoryResource["repo_type"].(string))
+		}
+	}
 	return nil
 }
 
@@ -1064,3 +1662,13 @@ func buildVariableGroup(id int) *build.VariableGroup {
 		Id: &id,
 	}
 }
+
+// isConfigured returns true if the attribute has a value in the configuration of the resource
+func isConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return false
+	}
+	value := rawConfig.GetAttr(key)
+	return value.IsKnown() && !value.IsNull()
+}
@@ -6,14 +6,17 @@ package build
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinepermissions"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -83,9 +86,21 @@ var yamlPrTrigger = map[string]interface{}{
 	"triggerType":                          "pullRequest",
 }
 
+var buildCompletionTrigger = map[string]interface{}{
+	"branchFilters": []interface{}{
+		"+master",
+		"+releases/*",
+	},
+	"definition": map[string]interface{}{
+		"id": 10,
+	},
+	"triggerType": "buildCompletion",
+}
+
 var triggerGroups = [][]interface{}{
 	{manualCiTrigger, manualPrTrigger},
 	{yamlCiTrigger, yamlPrTrigger},
+	{buildCompletionTrigger},
 }
 
 // This definition matches the overall structure of what a configured git repository would
@@ -117,11 +132,16 @@ var testBuildDefinition = build.BuildDefinition{
 			Name: converter.String("BuildPoolName"),
 		},
 	},
-	QueueStatus:    &build.DefinitionQueueStatusValues.Enabled,
-	Type:           &build.DefinitionTypeValues.Build,
-	Quality:        &build.DefinitionQualityValues.Definition,
-	Triggers:       &[]interface{}{},
-	VariableGroups: &[]build.VariableGroup{},
+	QueueStatus: &build.DefinitionQueueStatusValues.Enabled,
+	Type:        &build.DefinitionTypeValues.Build,
+	Quality:     &build.DefinitionQualityValues.Definition,
+	Triggers:    &[]interface{}{},
+
+	Demands:                   &[]interface{}{"Agent.OS -equals Windows_NT", "java"},
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.Project,
+	JobTimeoutInMinutes:       converter.Int(30),
+	JobCancelTimeoutInMinutes: converter.Int(15),
+	VariableGroups:            &[]build.VariableGroup{},
 }
 
 // This definition matches the overall structure of what a configured Bitbucket git repository would
@@ -159,6 +179,11 @@ var testBuildDefinitionBitbucketWithCITrigger = build.BuildDefinition{
 	Type:           &build.DefinitionTypeValues.Build,
 	Quality:        &build.DefinitionQualityValues.Definition,
 	VariableGroups: &[]build.VariableGroup{},
+
+	Demands:                   &[]interface{}{},
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
 }
 
 // This definition matches the overall structure of what a configured GitHub Enterprise git repository would
@@ -197,6 +222,11 @@ var testBuildDefinitionGitHubEnterpriseWithCITrigger = build.BuildDefinition{
 	Type:           &build.DefinitionTypeValues.Build,
 	Quality:        &build.DefinitionQualityValues.Definition,
 	VariableGroups: &[]build.VariableGroup{},
+
+	Demands:                   &[]interface{}{},
+	JobAuthorizationScope:     &build.BuildAuthorizationScopeValues.ProjectCollection,
+	JobTimeoutInMinutes:       converter.Int(60),
+	JobCancelTimeoutInMinutes: converter.Int(5),
 }
 
 // This definition matches the overall structure of what a configured Bitbucket git repository would
@@ -272,7 +302,7 @@ func testBuildDefinitionGitHubEnterprise() build.BuildDefinition {
 
 // validates that all supported repo types are allowed by the schema
 func TestBuildDefinition_RepoTypeListIsCorrect(t *testing.T) {
-	expectedRepoTypes := []string{"GitHub", "TfsGit", "Bitbucket", "GitHubEnterprise"}
+	expectedRepoTypes := []string{"GitHub", "TfsGit", "Bitbucket", "GitHubEnterprise", "TfsVersionControl"}
 	repoSchema := ResourceBuildDefinition().Schema["repository"]
 	repoTypeSchema := repoSchema.Elem.(*schema.Resource).Schema["repo_type"]
 
@@ -424,8 +454,8 @@ func TestAzureDevOpsBuildDefinition_CITriggers_GitHubEnterprise(t *testing.T) {
 
 // verifies that the flatten/expand round trip yields the same build definition
 func TestBuildDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
-	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
 	for _, triggerGroup := range triggerGroups {
+		resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
 		testBuildDefinitionWithCustomTriggers := testBuildDefinition
 		testBuildDefinitionWithCustomTriggers.Triggers = &triggerGroup
 		flattenBuildDefinition(resourceData, &testBuildDefinitionWithCustomTriggers, testProjectID)
@@ -437,6 +467,215 @@ func TestBuildDefinition_ExpandFlatten_Roundtrip(t *testing.T) {
 	}
 }
 
+// verifies that a designer process returned by the service round trips through classic_process_json
+func TestBuildDefinition_ExpandFlatten_ClassicProcessRoundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	classicBuildDefinition := testBuildDefinition
+	classicBuildDefinition.Triggers = &[]interface{}{}
+	classicBuildDefinition.Process = map[string]interface{}{
+		"type": float64(1),
+		"phases": []interface{}{
+			map[string]interface{}{
+				"name":    "Agent job 1",
+				"refName": "Job_1",
+				"steps": []interface{}{
+					map[string]interface{}{
+						"displayName": "Run a script",
+						"enabled":     true,
+						"task": map[string]interface{}{
+							"id":          "6c731c3c-3c68-459a-a5c9-bde6e6595b5b",
+							"versionSpec": "3.*",
+						},
+						"inputs": map[string]interface{}{"script": "make"},
+					},
+				},
+			},
+		},
+	}
+
+	flattenBuildDefinition(resourceData, &classicBuildDefinition, testProjectID)
+	require.Equal(t, "", resourceData.Get("repository.0.yml_path"))
+	require.NotContains(t, resourceData.Get("classic_process_json"), `"type"`)
+
+	buildDefinitionAfterRoundTrip, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	expected, _ := json.Marshal(classicBuildDefinition.Process)
+	actual, _ := json.Marshal(buildDefinitionAfterRoundTrip.Process)
+	require.JSONEq(t, string(expected), string(actual))
+}
+
+// verifies that classic process JSON differing only in formatting, key order or type marker is not a diff
+func TestBuildDefinition_SuppressEquivalentClassicProcess(t *testing.T) {
+	require.True(t, suppressEquivalentClassicProcess("", `{"phases":[],"target":{"type":1}}`, "{\n  \"target\": {\"type\": 1},\n  \"phases\": []\n}", nil))
+	require.True(t, suppressEquivalentClassicProcess("", `{"phases":[],"type":1}`, `{"phases":[]}`, nil))
+	require.False(t, suppressEquivalentClassicProcess("", `{"phases":[]}`, `{"phases":[{"name":"Job"}]}`, nil))
+	require.False(t, suppressEquivalentClassicProcess("", `{"phases":[]}`, `not json`, nil))
+}
+
+// verifies that the properties Azure DevOps fills in for a designer process do not show up as a diff
+func TestBuildDefinition_SuppressEquivalentClassicProcess_ServerDefaults(t *testing.T) {
+	config := `{
+  "phases": [{
+    "name": "Agent job 1",
+    "target": {"type": 1},
+    "steps": [{
+      "displayName": "Run a script",
+      "task": {"id": "d9bafed4-0b18-4f58-968d-86655b4d2ce9", "versionSpec": "2.*"},
+      "inputs": {"script": "echo hello"}
+    }]
+  }]
+}`
+	server := `{
+  "phases": [{
+    "steps": [{
+      "environment": {},
+      "enabled": true,
+      "continueOnError": false,
+      "alwaysRun": false,
+      "displayName": "Run a script",
+      "timeoutInMinutes": 0,
+      "retryCountOnTaskFailure": 0,
+      "condition": "succeeded()",
+      "refName": "CmdLine1",
+      "task": {"id": "d9bafed4-0b18-4f58-968d-86655b4d2ce9", "versionSpec": "2.*", "definitionType": "task"},
+      "inputs": {"script": "echo hello"}
+    }],
+    "name": "Agent job 1",
+    "refName": "Job_1",
+    "condition": "succeeded()",
+    "target": {"executionOptions": {"type": 0}, "allowScriptsAuthAccessOption": false, "type": 1},
+    "jobAuthorizationScope": "projectCollection"
+  }],
+  "type": 1
+}`
+	require.True(t, suppressEquivalentClassicProcess("", server, config, nil))
+
+	// changed values of server defaulted or generated properties are still a diff
+	require.False(t, suppressEquivalentClassicProcess("", strings.Replace(server, `"enabled": true`, `"enabled": false`, 1), config, nil))
+	require.False(t, suppressEquivalentClassicProcess("", server, strings.Replace(config, `"name": "Agent job 1",`, `"name": "Agent job 1", "refName": "Build",`, 1), nil))
+	require.False(t, suppressEquivalentClassicProcess("", server, strings.Replace(config, `echo hello`, `echo bye`, 1), nil))
+}
+
+// verifies that TFVC mappings and gated check-in triggers round trip through the resource data
+func TestBuildDefinition_ExpandFlatten_TfvcRoundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	tfvcBuildDefinition := testBuildDefinition
+	tfvcBuildDefinition.Repository = &build.BuildRepository{
+		Url:           converter.String(""),
+		Id:            converter.String("$/Project"),
+		Name:          converter.String("$/Project"),
+		RootFolder:    converter.String("$/Project"),
+		DefaultBranch: converter.String("$/Project"),
+		Type:          converter.String("TfsVersionControl"),
+		Properties: &map[string]string{
+			"connectedServiceId": "",
+			"apiUrl":             "",
+			"reportBuildStatus":  "true",
+			"tfvcMapping":        `{"mappings":[{"localPath":"\\","mappingType":"map","serverPath":"$/Project/src"},{"localPath":"\\","mappingType":"cloak","serverPath":"$/Project/src/docs"}]}`,
+		},
+	}
+	tfvcBuildDefinition.Process = map[string]interface{}{"type": designerProcessType, "phases": []interface{}{}}
+	tfvcBuildDefinition.Triggers = &[]interface{}{
+		map[string]interface{}{
+			"pathFilters":              []interface{}{"+$/Project/src"},
+			"runContinuousIntegration": true,
+			"useWorkspaceMappings":     false,
+			"triggerType":              "gatedCheckIn",
+		},
+	}
+
+	flattenBuildDefinition(resourceData, &tfvcBuildDefinition, testProjectID)
+	require.Equal(t, "cloak", resourceData.Get("repository.0.tfvc_mapping.1.mapping_type"))
+	require.Equal(t, true, resourceData.Get("gated_checkin_trigger.0.run_continuous_integration"))
+
+	buildDefinitionAfterRoundTrip, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, *tfvcBuildDefinition.Repository, *buildDefinitionAfterRoundTrip.Repository)
+	require.Equal(t, *tfvcBuildDefinition.Triggers, *buildDefinitionAfterRoundTrip.Triggers)
+}
+
+// verifies that TFVC repositories cannot be used by YAML build definitions
+func TestBuildDefinition_Expand_TfvcRequiresClassicProcess(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+	resourceData.Set("repository", []interface{}{map[string]interface{}{
+		"repo_id":   "$/Project",
+		"repo_type": "TfsVersionControl",
+		"yml_path":  "azure-pipelines.yml",
+	}})
+
+	_, _, err := expandBuildDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "classic_process_json")
+}
+
+// verifies that gated check-in triggers are rejected for Git repositories
+func TestBuildDefinition_Expand_GatedCheckInRequiresTfvc(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	resourceData.Set("gated_checkin_trigger", []interface{}{map[string]interface{}{
+		"use_workspace_mappings": true,
+	}})
+
+	_, _, err := expandBuildDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "gated_checkin_trigger")
+}
+
+// verifies that a definition without a YAML file or classic process is rejected
+func TestBuildDefinition_Expand_RequiresYamlPathOrClassicProcess(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+	resourceData.Set("repository", []interface{}{map[string]interface{}{
+		"repo_id":   "RepoId",
+		"repo_type": "TfsGit",
+	}})
+
+	_, _, err := expandBuildDefinition(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "classic_process_json")
+}
+
+// verifies that job settings which are neither configured nor known are not sent, so the service keeps its values
+func TestBuildDefinition_Expand_OmitsUnconfiguredJobSettings(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	resourceData.Set("project_id", testProjectID)
+	resourceData.Set("repository", []interface{}{map[string]interface{}{
+		"repo_id":   "RepoId",
+		"repo_type": "TfsGit",
+		"yml_path":  "azure-pipelines.yml",
+	}})
+
+	buildDefinition, _, err := expandBuildDefinition(resourceData)
+	require.Nil(t, err)
+	require.Nil(t, buildDefinition.JobAuthorizationScope)
+	require.Nil(t, buildDefinition.JobTimeoutInMinutes)
+	require.Nil(t, buildDefinition.JobCancelTimeoutInMinutes)
+}
+
+// verifies that schedules returned with named days and without branch filters are flattened
+func TestBuildDefinition_Flatten_ScheduleWithNamedDays(t *testing.T) {
+	schedules := flattenBuildDefinitionScheduleTrigger(map[string]interface{}{
+		"schedules": []interface{}{
+			map[string]interface{}{
+				"daysToBuild":             "monday, wednesday, friday",
+				"scheduleOnlyWithChanges": false,
+				"startHours":              float64(2),
+				"startMinutes":            float64(30),
+				"timeZoneId":              "UTC",
+				"scheduleJobId":           "job-id",
+			},
+		},
+		"triggerType": "schedule",
+	})
+
+	require.Len(t, schedules, 1)
+	schedule := schedules[0].(map[string]interface{})
+	require.Equal(t, []string{"Mon", "Wed", "Fri"}, schedule["days_to_build"])
+	require.Equal(t, "(UTC) Coordinated Universal Time", schedule["time_zone"])
+	require.NotContains(t, schedule, "branch_filter")
+}
+
 // verifies that an expand will fail if there is insufficient configuration data found in the resource
 func TestBuildDefinition_Expand_FailsIfNotEnoughData(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
@@ -537,6 +776,97 @@ func TestBuildDefinition_Update_DoesNotSwallowError(t *testing.T) {
 	require.Equal(t, "UpdateDefinition() Failed", err.Error())
 }
 
+// verifies that removed repository resources are revoked and added ones are authorized for the build definition
+func TestBuildDefinition_AuthorizeRepositoryResources(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinePermissionsClient := azdosdkmocks.NewMockPipelinepermissionsClient(ctrl)
+	clients := &client.AggregatedClient{PipelinePermissionsClient: pipelinePermissionsClient, Ctx: context.Background()}
+
+	repositoryID := uuid.New().String()
+	serviceConnectionID := uuid.New().String()
+	gomock.InOrder(
+		pipelinePermissionsClient.
+			EXPECT().
+			UpdatePipelinePermisionsForResource(clients.Ctx, pipelinepermissions.UpdatePipelinePermisionsForResourceArgs{
+				ResourceAuthorization: expandPipelinePermissions(100, false),
+				Project:               converter.String(testProjectID),
+				ResourceType:          converter.String("endpoint"),
+				ResourceId:            converter.String(serviceConnectionID),
+			}).
+			Return(nil, nil).
+			Times(1),
+		pipelinePermissionsClient.
+			EXPECT().
+			UpdatePipelinePermisionsForResource(clients.Ctx, pipelinepermissions.UpdatePipelinePermisionsForResourceArgs{
+				ResourceAuthorization: expandPipelinePermissions(100, true),
+				Project:               converter.String(testProjectID),
+				ResourceType:          converter.String("repository"),
+				ResourceId:            converter.String(testProjectID + "." + repositoryID),
+			}).
+			Return(nil, nil).
+			Times(1),
+	)
+
+	err := authorizeRepositoryResources(clients, testProjectID, 100,
+		[]interface{}{map[string]interface{}{"repo_type": "GitHub", "repo_id": "org/templates", "service_connection_id": serviceConnectionID}},
+		[]interface{}{map[string]interface{}{"repo_type": "TfsGit", "repo_id": repositoryID, "service_connection_id": ""}})
+	require.Nil(t, err)
+}
+
+// verifies that repository resources the build definition is no longer authorized for are removed on read
+func TestBuildDefinition_ReadRepositoryResources_DetectsRevokedAuthorizations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinePermissionsClient := azdosdkmocks.NewMockPipelinepermissionsClient(ctrl)
+	clients := &client.AggregatedClient{PipelinePermissionsClient: pipelinePermissionsClient, Ctx: context.Background()}
+
+	repositoryID := uuid.New().String()
+	serviceConnectionID := uuid.New().String()
+	pipelinePermissionsClient.
+		EXPECT().
+		GetPipelinePermissionsForResource(clients.Ctx, pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+			Project:      converter.String(testProjectID),
+			ResourceType: converter.String("repository"),
+			ResourceId:   converter.String(testProjectID + "." + repositoryID),
+		}).
+		Return(expandPipelinePermissions(100, true), nil).
+		Times(1)
+	pipelinePermissionsClient.
+		EXPECT().
+		GetPipelinePermissionsForResource(clients.Ctx, pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+			Project:      converter.String(testProjectID),
+			ResourceType: converter.String("endpoint"),
+			ResourceId:   converter.String(serviceConnectionID),
+		}).
+		Return(&pipelinepermissions.ResourcePipelinePermissions{}, nil).
+		Times(1)
+
+	authorizedResource := map[string]interface{}{"repo_type": "TfsGit", "repo_id": repositoryID, "service_connection_id": "", "alias": "tools", "ref": "refs/heads/main"}
+	resources, err := readRepositoryResources(clients, testProjectID, 100, []interface{}{
+		authorizedResource,
+		map[string]interface{}{"repo_type": "GitHub", "repo_id": "org/templates", "service_connection_id": serviceConnectionID, "alias": "templates", "ref": ""},
+	})
+	require.Nil(t, err)
+	require.Equal(t, []interface{}{authorizedResource}, resources)
+}
+
+// verifies that repository resources outside of the project require a service connection
+func TestBuildDefinition_ValidatesServiceConnection_RepositoryResource(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
+	flattenBuildDefinition(resourceData, &testBuildDefinition, testProjectID)
+	resourceData.Set("repository_resource", []interface{}{map[string]interface{}{
+		"repo_type": "GitHub",
+		"repo_id":   "org/templates",
+	}})
+
+	err := validateServiceConnectionIDExistsIfNeeded(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "org/templates")
+}
+
 func TestExpandVariables_CatchesDuplicateVariables(t *testing.T) {
 	resourceData := schema.TestResourceDataRaw(t, ResourceBuildDefinition().Schema, nil)
 	resourceData.Set(bdVariable, []map[string]interface{}{
@@ -94,7 +94,8 @@ func resourceBuildFolderRead(d *schema.ResourceData, m interface{}) error {
 func resourceBuildFolderUpdate(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 
-	path := d.Get("path").(string)
+	// the folder is addressed by its current path, a renamed path is passed with the folder
+	path := d.Id()
 	buildFolder, projectID, err := expandBuildFolder(d)
 	if err != nil {
 		return fmt.Errorf(" failed to expand build folder configurations. Project ID: %s , Error: %+v", projectID, err)
@@ -142,3 +142,33 @@ func TestBuildFolder_Update_DoesNotSwallowError(t *testing.T) {
 	err := resourceBuildFolderUpdate(resourceData, clients)
 	require.Contains(t, err.Error(), "UpdateFolder() Failed")
 }
+
+// verifies that a renamed folder is addressed by its current path
+func TestBuildFolder_Update_RenamesFolder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildFolder().Schema, nil)
+	flattenBuildFolder(resourceData, &build.Folder{
+		Description: converter.String("My Folder Description"),
+		Path:        converter.String("\\OldFolder"),
+		Project:     &testProjectReference,
+	}, testProjectUUID.String())
+	resourceData.Set("path", "\\NewFolder")
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	buildClient.
+		EXPECT().
+		UpdateFolder(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args build.UpdateFolderArgs) (*build.Folder, error) {
+			require.Equal(t, "\\OldFolder", *args.Path)
+			require.Equal(t, "\\NewFolder", *args.Folder.Path)
+			return nil, errors.New("UpdateFolder() Failed")
+		}).
+		Times(1)
+
+	err := resourceBuildFolderUpdate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateFolder() Failed")
+}
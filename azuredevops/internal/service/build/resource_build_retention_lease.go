@@ -0,0 +1,151 @@
+package build
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceBuildRetentionLease schema and implementation for a retention lease protecting a pipeline run.
+// The lease cannot be imported, because the service returns neither days_valid nor protect_pipeline.
+func ResourceBuildRetentionLease() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBuildRetentionLeaseCreate,
+		Read:   resourceBuildRetentionLeaseRead,
+		Delete: resourceBuildRetentionLeaseDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"definition_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"run_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"owner_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "User:Terraform",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"days_valid": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"protect_pipeline": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"valid_until": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBuildRetentionLeaseCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	leases, err := clients.BuildClient.AddRetentionLeases(clients.Ctx, build.AddRetentionLeasesArgs{
+		NewLeases: &[]build.NewRetentionLease{{
+			DefinitionId:    converter.Int(d.Get("definition_id").(int)),
+			RunId:           converter.Int(d.Get("run_id").(int)),
+			OwnerId:         converter.String(d.Get("owner_id").(string)),
+			DaysValid:       converter.Int(d.Get("days_valid").(int)),
+			ProtectPipeline: converter.Bool(d.Get("protect_pipeline").(bool)),
+		}},
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating retention lease for run %d: %+v", d.Get("run_id").(int), err)
+	}
+	if leases == nil || len(*leases) != 1 || (*leases)[0].LeaseId == nil {
+		return fmt.Errorf(" creating retention lease for run %d: unexpected response", d.Get("run_id").(int))
+	}
+
+	d.SetId(strconv.Itoa(*(*leases)[0].LeaseId))
+	return resourceBuildRetentionLeaseRead(d, m)
+}
+
+func resourceBuildRetentionLeaseRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, leaseID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return fmt.Errorf(" parsing retention lease ID: %+v", err)
+	}
+
+	lease, err := clients.BuildClient.GetRetentionLease(clients.Ctx, build.GetRetentionLeaseArgs{
+		Project: converter.String(projectID),
+		LeaseId: &leaseID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading retention lease %d: %+v", leaseID, err)
+	}
+
+	flattenBuildRetentionLease(d, lease)
+	return nil
+}
+
+func resourceBuildRetentionLeaseDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, leaseID, err := tfhelper.ParseProjectIDAndResourceID(d)
+	if err != nil {
+		return fmt.Errorf(" parsing retention lease ID: %+v", err)
+	}
+
+	err = clients.BuildClient.DeleteRetentionLeasesById(clients.Ctx, build.DeleteRetentionLeasesByIdArgs{
+		Project: converter.String(projectID),
+		Ids:     &[]int{leaseID},
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting retention lease %d: %+v", leaseID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenBuildRetentionLease(d *schema.ResourceData, lease *build.RetentionLease) {
+	if lease.DefinitionId != nil {
+		d.Set("definition_id", *lease.DefinitionId)
+	}
+	if lease.RunId != nil {
+		d.Set("run_id", *lease.RunId)
+	}
+	if lease.OwnerId != nil {
+		d.Set("owner_id", *lease.OwnerId)
+	}
+	if lease.ValidUntil != nil {
+		d.Set("valid_until", lease.ValidUntil.Time.Format(time.RFC3339))
+	}
+}
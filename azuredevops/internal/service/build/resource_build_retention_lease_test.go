@@ -0,0 +1,86 @@
+//go:build (all || resource_build_retention_lease) && !exclude_resource_build_retention_lease
+// +build all resource_build_retention_lease
+// +build !exclude_resource_build_retention_lease
+
+package build
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testRetentionLeaseProjectID = uuid.New().String()
+
+func TestBuildRetentionLease_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildRetentionLease().Schema, nil)
+	resourceData.Set("project_id", testRetentionLeaseProjectID)
+	resourceData.Set("definition_id", 12)
+	resourceData.Set("run_id", 345)
+	resourceData.Set("days_valid", 30)
+
+	buildClient.
+		EXPECT().
+		AddRetentionLeases(clients.Ctx, build.AddRetentionLeasesArgs{
+			NewLeases: &[]build.NewRetentionLease{{
+				DefinitionId:    converter.Int(12),
+				RunId:           converter.Int(345),
+				OwnerId:         converter.String("User:Terraform"),
+				DaysValid:       converter.Int(30),
+				ProtectPipeline: converter.Bool(false),
+			}},
+			Project: converter.String(testRetentionLeaseProjectID),
+		}).
+		Return(nil, errors.New("AddRetentionLeases() Failed")).
+		Times(1)
+
+	err := resourceBuildRetentionLeaseCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AddRetentionLeases() Failed")
+}
+
+func TestBuildRetentionLease_Read_NotFoundClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBuildRetentionLease().Schema, nil)
+	resourceData.Set("project_id", testRetentionLeaseProjectID)
+	resourceData.SetId("42")
+
+	buildClient.
+		EXPECT().
+		GetRetentionLease(clients.Ctx, build.GetRetentionLeaseArgs{
+			Project: converter.String(testRetentionLeaseProjectID),
+			LeaseId: converter.Int(42),
+		}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	err := resourceBuildRetentionLeaseRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that leases cannot be imported, because days_valid and protect_pipeline are not returned by the service
+func TestBuildRetentionLease_DoesNotSupportImport(t *testing.T) {
+	require.Nil(t, ResourceBuildRetentionLease().Importer)
+}
@@ -0,0 +1,240 @@
+package build
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinepermissions"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// The pipeline permissions API identifies a repository by <project ID>.<repository ID>
+const repositoryResourceType = "repository"
+
+// The protected resource types supported by the pipeline permissions API
+var pipelineAuthorizationResourceTypes = []string{
+	"endpoint",
+	"queue",
+	"variablegroup",
+	"environment",
+	"securefile",
+	repositoryResourceType,
+}
+
+// ResourcePipelineAuthorization schema and implementation for pipeline authorization resource
+func ResourcePipelineAuthorization() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePipelineAuthorizationCreate,
+		Read:   resourcePipelineAuthorizationRead,
+		Delete: resourcePipelineAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourcePipelineAuthorizationImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"resource_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(pipelineAuthorizationResourceTypes, false),
+			},
+			"pipeline_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func resourcePipelineAuthorizationCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := updatePipelineAuthorization(d, clients, true); err != nil {
+		return fmt.Errorf(" authorizing pipeline for %s %s: %+v", d.Get("type").(string), d.Get("resource_id").(string), err)
+	}
+
+	d.SetId(getPipelineAuthorizationID(d))
+	return resourcePipelineAuthorizationRead(d, m)
+}
+
+func resourcePipelineAuthorizationRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	resourceType := d.Get("type").(string)
+	authorized, err := getPipelinePermission(clients, d.Get("project_id").(string), resourceType, d.Get("resource_id").(string), d.Get("pipeline_id").(int))
+	if err != nil {
+		return fmt.Errorf(" reading pipeline permissions of %s %s: %+v", resourceType, d.Get("resource_id").(string), err)
+	}
+
+	if !authorized {
+		log.Printf(msgErrorAuthorizationNoLongerExists, d.Id())
+		d.SetId("")
+		return nil
+	}
+	return nil
+}
+
+func resourcePipelineAuthorizationDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	if err := updatePipelineAuthorization(d, clients, false); err != nil {
+		return fmt.Errorf(" revoking pipeline authorization for %s %s: %+v", d.Get("type").(string), d.Get("resource_id").(string), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourcePipelineAuthorizationImport imports a pipeline authorization by an ID that looks like
+// <project>/<type>/<resource ID> for all pipelines, or <project>/<type>/<resource ID>/<pipeline ID> for a single pipeline
+func resourcePipelineAuthorizationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	parts := strings.Split(d.Id(), "/")
+	if (len(parts) != 3 && len(parts) != 4) || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<type>/<resource ID>[/<pipeline ID>]", d.Id())
+	}
+
+	resourceType := strings.ToLower(parts[1])
+	supported := false
+	for _, supportedType := range pipelineAuthorizationResourceTypes {
+		supported = supported || supportedType == resourceType
+	}
+	if !supported {
+		return nil, fmt.Errorf("unsupported resource type (%s), expected one of %s", parts[1], strings.Join(pipelineAuthorizationResourceTypes, ", "))
+	}
+
+	pipelineID := 0
+	if len(parts) == 4 {
+		var err error
+		if pipelineID, err = strconv.Atoi(parts[3]); err != nil || pipelineID < 1 {
+			return nil, fmt.Errorf("pipeline ID was expected to be a positive integer, but was %s", parts[3])
+		}
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], clients)
+	if err != nil {
+		return nil, err
+	}
+
+	authorized, err := getPipelinePermission(clients, projectID, resourceType, parts[2], pipelineID)
+	if err != nil {
+		return nil, fmt.Errorf(" reading pipeline permissions of %s %s: %+v", resourceType, parts[2], err)
+	}
+	if !authorized {
+		return nil, fmt.Errorf(" %s %s is not authorized for the pipeline", resourceType, parts[2])
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("type", resourceType)
+	d.Set("resource_id", parts[2])
+	if pipelineID != 0 {
+		d.Set("pipeline_id", pipelineID)
+	}
+	d.SetId(getPipelineAuthorizationID(d))
+	return []*schema.ResourceData{d}, nil
+}
+
+func updatePipelineAuthorization(d *schema.ResourceData, clients *client.AggregatedClient, authorized bool) error {
+	return setPipelinePermission(clients, d.Get("project_id").(string), d.Get("type").(string), d.Get("resource_id").(string), d.Get("pipeline_id").(int), authorized)
+}
+
+// setPipelinePermission authorizes a single pipeline, or all pipelines of the project if pipelineID is 0, to use
+// a protected resource of the project. It is shared by all resources which authorize pipelines.
+func setPipelinePermission(clients *client.AggregatedClient, projectID string, resourceType string, resourceID string, pipelineID int, authorized bool) error {
+	_, err := clients.PipelinePermissionsClient.UpdatePipelinePermisionsForResource(clients.Ctx, pipelinepermissions.UpdatePipelinePermisionsForResourceArgs{
+		ResourceAuthorization: expandPipelinePermissions(pipelineID, authorized),
+		Project:               converter.String(projectID),
+		ResourceType:          converter.String(resourceType),
+		ResourceId:            converter.String(pipelinePermissionsResourceID(projectID, resourceType, resourceID)),
+	})
+	return err
+}
+
+// getPipelinePermission returns whether a single pipeline, or all pipelines of the project if pipelineID is 0, may use
+// a protected resource of the project. It is shared by all resources which authorize pipelines.
+func getPipelinePermission(clients *client.AggregatedClient, projectID string, resourceType string, resourceID string, pipelineID int) (bool, error) {
+	permissions, err := clients.PipelinePermissionsClient.GetPipelinePermissionsForResource(clients.Ctx, pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+		Project:      converter.String(projectID),
+		ResourceType: converter.String(resourceType),
+		ResourceId:   converter.String(pipelinePermissionsResourceID(projectID, resourceType, resourceID)),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return isPipelineAuthorized(permissions, pipelineID), nil
+}
+
+// pipelinePermissionsResourceID returns the ID of a resource in the pipeline permissions API,
+// which identifies a repository by <project ID>.<repository ID>
+func pipelinePermissionsResourceID(projectID string, resourceType string, resourceID string) string {
+	if resourceType == repositoryResourceType {
+		return fmt.Sprintf("%s.%s", projectID, resourceID)
+	}
+	return resourceID
+}
+
+func getPipelineAuthorizationID(d *schema.ResourceData) string {
+	id := fmt.Sprintf("%s/%s/%s", d.Get("project_id").(string), d.Get("type").(string), d.Get("resource_id").(string))
+	if pipelineID := d.Get("pipeline_id").(int); pipelineID != 0 {
+		id += "/" + strconv.Itoa(pipelineID)
+	}
+	return id
+}
+
+// expandPipelinePermissions authorizes a single pipeline, or all pipelines of the project if pipelineID is 0
+func expandPipelinePermissions(pipelineID int, authorized bool) *pipelinepermissions.ResourcePipelinePermissions {
+	permissions := pipelinepermissions.ResourcePipelinePermissions{}
+	if pipelineID == 0 {
+		permissions.AllPipelines = &pipelinepermissions.Permission{
+			Authorized: converter.Bool(authorized),
+		}
+	} else {
+		permissions.Pipelines = &[]pipelinepermissions.PipelinePermission{{
+			Id:         converter.Int(pipelineID),
+			Authorized: converter.Bool(authorized),
+		}}
+	}
+	return &permissions
+}
+
+// isPipelineAuthorized returns whether a single pipeline, or all pipelines if pipelineID is 0, may use the resource
+func isPipelineAuthorized(permissions *pipelinepermissions.ResourcePipelinePermissions, pipelineID int) bool {
+	if permissions == nil {
+		return false
+	}
+	if pipelineID == 0 {
+		return permissions.AllPipelines != nil && converter.ToBool(permissions.AllPipelines.Authorized, false)
+	}
+	if permissions.Pipelines != nil {
+		for _, pipeline := range *permissions.Pipelines {
+			if pipeline.Id != nil && *pipeline.Id == pipelineID {
+				return converter.ToBool(pipeline.Authorized, false)
+			}
+		}
+	}
+	return false
+}
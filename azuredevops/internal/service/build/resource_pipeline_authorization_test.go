@@ -0,0 +1,205 @@
+//go:build (all || resource_pipeline_authorization) && !exclude_resource_pipeline_authorization
+// +build all resource_pipeline_authorization
+// +build !exclude_resource_pipeline_authorization
+
+package build
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelinepermissions"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func getPipelineAuthorizationResourceData(t *testing.T, projectID string, resourceType string, resourceID string, pipelineID int) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourcePipelineAuthorization().Schema, nil)
+	resourceData.Set("project_id", projectID)
+	resourceData.Set("type", resourceType)
+	resourceData.Set("resource_id", resourceID)
+	if pipelineID != 0 {
+		resourceData.Set("pipeline_id", pipelineID)
+	}
+	return resourceData
+}
+
+// verifies that a single pipeline is authorized to use an environment
+func TestPipelineAuthorization_Create_AuthorizesPipeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinePermissionsClient := azdosdkmocks.NewMockPipelinepermissionsClient(ctrl)
+	clients := &client.AggregatedClient{PipelinePermissionsClient: pipelinePermissionsClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	resourceData := getPipelineAuthorizationResourceData(t, projectID, "environment", "7", 12)
+
+	pipelinePermissionsClient.
+		EXPECT().
+		UpdatePipelinePermisionsForResource(clients.Ctx, pipelinepermissions.UpdatePipelinePermisionsForResourceArgs{
+			ResourceAuthorization: &pipelinepermissions.ResourcePipelinePermissions{
+				Pipelines: &[]pipelinepermissions.PipelinePermission{{
+					Id:         converter.Int(12),
+					Authorized: converter.Bool(true),
+				}},
+			},
+			Project:      &projectID,
+			ResourceType: converter.String("environment"),
+			ResourceId:   converter.String("7"),
+		}).
+		Return(nil, nil).
+		Times(1)
+
+	pipelinePermissionsClient.
+		EXPECT().
+		GetPipelinePermissionsForResource(clients.Ctx, gomock.Any()).
+		Return(&pipelinepermissions.ResourcePipelinePermissions{
+			Pipelines: &[]pipelinepermissions.PipelinePermission{{
+				Id:         converter.Int(12),
+				Authorized: converter.Bool(true),
+			}},
+		}, nil).
+		Times(1)
+
+	require.Nil(t, resourcePipelineAuthorizationCreate(resourceData, clients))
+	require.Equal(t, projectID+"/environment/7/12", resourceData.Id())
+}
+
+// verifies that repositories are addressed by <project ID>.<repository ID>
+func TestPipelineAuthorization_Create_RepositoryForAllPipelines(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinePermissionsClient := azdosdkmocks.NewMockPipelinepermissionsClient(ctrl)
+	clients := &client.AggregatedClient{PipelinePermissionsClient: pipelinePermissionsClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	repositoryID := uuid.New().String()
+	resourceData := getPipelineAuthorizationResourceData(t, projectID, "repository", repositoryID, 0)
+
+	pipelinePermissionsClient.
+		EXPECT().
+		UpdatePipelinePermisionsForResource(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args pipelinepermissions.UpdatePipelinePermisionsForResourceArgs) (*pipelinepermissions.ResourcePipelinePermissions, error) {
+			require.Equal(t, projectID+"."+repositoryID, *args.ResourceId)
+			require.Nil(t, args.ResourceAuthorization.Pipelines)
+			require.True(t, *args.ResourceAuthorization.AllPipelines.Authorized)
+			return nil, errors.New("UpdatePipelinePermisionsForResource() Failed")
+		}).
+		Times(1)
+
+	err := resourcePipelineAuthorizationCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdatePipelinePermisionsForResource() Failed")
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that an authorization revoked outside of Terraform is removed from the state
+func TestPipelineAuthorization_Read_Revoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinePermissionsClient := azdosdkmocks.NewMockPipelinepermissionsClient(ctrl)
+	clients := &client.AggregatedClient{PipelinePermissionsClient: pipelinePermissionsClient, Ctx: context.Background()}
+
+	resourceData := getPipelineAuthorizationResourceData(t, uuid.New().String(), "queue", "3", 12)
+	resourceData.SetId("id")
+
+	pipelinePermissionsClient.
+		EXPECT().
+		GetPipelinePermissionsForResource(clients.Ctx, gomock.Any()).
+		Return(&pipelinepermissions.ResourcePipelinePermissions{
+			AllPipelines: &pipelinepermissions.Permission{Authorized: converter.Bool(true)},
+			Pipelines: &[]pipelinepermissions.PipelinePermission{{
+				Id:         converter.Int(13),
+				Authorized: converter.Bool(true),
+			}},
+		}, nil).
+		Times(1)
+
+	require.Nil(t, resourcePipelineAuthorizationRead(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that the authorization is revoked on delete
+func TestPipelineAuthorization_Delete_RevokesAuthorization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinePermissionsClient := azdosdkmocks.NewMockPipelinepermissionsClient(ctrl)
+	clients := &client.AggregatedClient{PipelinePermissionsClient: pipelinePermissionsClient, Ctx: context.Background()}
+
+	resourceData := getPipelineAuthorizationResourceData(t, uuid.New().String(), "securefile", uuid.New().String(), 12)
+	resourceData.SetId("id")
+
+	pipelinePermissionsClient.
+		EXPECT().
+		UpdatePipelinePermisionsForResource(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args pipelinepermissions.UpdatePipelinePermisionsForResourceArgs) (*pipelinepermissions.ResourcePipelinePermissions, error) {
+			require.False(t, *(*args.ResourceAuthorization.Pipelines)[0].Authorized)
+			return nil, nil
+		}).
+		Times(1)
+
+	require.Nil(t, resourcePipelineAuthorizationDelete(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that an authorization of a single pipeline is imported by <project>/<type>/<resource ID>/<pipeline ID>
+func TestPipelineAuthorization_Import_SinglePipeline(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	pipelinePermissionsClient := azdosdkmocks.NewMockPipelinepermissionsClient(ctrl)
+	clients := &client.AggregatedClient{PipelinePermissionsClient: pipelinePermissionsClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	pipelinePermissionsClient.
+		EXPECT().
+		GetPipelinePermissionsForResource(clients.Ctx, pipelinepermissions.GetPipelinePermissionsForResourceArgs{
+			Project:      &projectID,
+			ResourceType: converter.String("securefile"),
+			ResourceId:   converter.String("b4a1e5b5-9d9a-4a5b-8a1e-2d3c4b5a6f70"),
+		}).
+		Return(&pipelinepermissions.ResourcePipelinePermissions{
+			Pipelines: &[]pipelinepermissions.PipelinePermission{{
+				Id:         converter.Int(12),
+				Authorized: converter.Bool(true),
+			}},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourcePipelineAuthorization().Schema, nil)
+	resourceData.SetId(projectID + "/securefile/b4a1e5b5-9d9a-4a5b-8a1e-2d3c4b5a6f70/12")
+
+	imported, err := resourcePipelineAuthorizationImport(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, projectID+"/securefile/b4a1e5b5-9d9a-4a5b-8a1e-2d3c4b5a6f70/12", imported[0].Id())
+	require.Equal(t, projectID, imported[0].Get("project_id"))
+	require.Equal(t, "securefile", imported[0].Get("type"))
+	require.Equal(t, 12, imported[0].Get("pipeline_id"))
+}
+
+// verifies that malformed import IDs and unsupported types are rejected without calling the service
+func TestPipelineAuthorization_Import_RejectsInvalidID(t *testing.T) {
+	projectID := uuid.New().String()
+	for _, id := range []string{
+		projectID + "/environment",
+		projectID + "/pipeline/7",
+		projectID + "/environment/7/all",
+		projectID + "/environment/7/12/1",
+	} {
+		resourceData := schema.TestResourceDataRaw(t, ResourcePipelineAuthorization().Schema, nil)
+		resourceData.SetId(id)
+
+		_, err := resourcePipelineAuthorizationImport(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+		require.NotNil(t, err, id)
+	}
+}
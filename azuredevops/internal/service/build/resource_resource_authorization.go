@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,6 +13,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 const msgErrorFailedResourceCreate = "error creating authorized resource: %+v"
@@ -26,6 +28,9 @@ func ResourceResourceAuthorization() *schema.Resource {
 		Read:   resourceResourceAuthorizationRead,
 		Update: resourceResourceAuthorizationUpdate,
 		Delete: resourceResourceAuthorizationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceResourceAuthorizationImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -167,6 +172,58 @@ func resourceResourceAuthorizationUpdate(d *schema.ResourceData, m interface{})
 	return resourceResourceAuthorizationRead(d, m)
 }
 
+// resourceResourceAuthorizationImport imports the authorization of a resource for all pipelines of a project
+// by an ID that looks like <project>/<type>/<resource ID>
+func resourceResourceAuthorizationImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<type>/<resource ID>", d.Id())
+	}
+
+	resourceType := strings.ToLower(parts[1])
+	switch resourceType {
+	case "endpoint", "queue", "variablegroup":
+	default:
+		return nil, fmt.Errorf("unsupported resource type (%s), expected one of endpoint, queue, variablegroup", parts[1])
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], clients)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceRef := &build.DefinitionResourceReference{
+		Id:   converter.String(parts[2]),
+		Type: converter.String(resourceType),
+	}
+	authorized, err := isProjectResourceAuthorized(clients, resourceRef, projectID)
+	if err != nil {
+		return nil, fmt.Errorf(" reading authorization of %s %s: %+v", resourceType, parts[2], err)
+	}
+	resourceRef.Authorized = converter.Bool(authorized)
+
+	flattenAuthorizedResource(d, resourceRef, projectID, 0)
+	return []*schema.ResourceData{d}, nil
+}
+
+// isProjectResourceAuthorized returns whether a resource is authorized for all pipelines of a project
+func isProjectResourceAuthorized(clients *client.AggregatedClient, resourceRef *build.DefinitionResourceReference, projectID string) (bool, error) {
+	resourceRefs, err := clients.BuildClient.GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+		Project: converter.String(projectID),
+		Type:    resourceRef.Type,
+		Id:      resourceRef.Id,
+	})
+	if err != nil {
+		return false, err
+	}
+	if resourceRefs == nil || len(*resourceRefs) == 0 {
+		return false, nil
+	}
+	return converter.ToBool((*resourceRefs)[0].Authorized, false), nil
+}
+
 func flattenAuthorizedResource(d *schema.ResourceData, authorizedResource *build.DefinitionResourceReference, projectID string, definitionID int) {
 	d.SetId(*authorizedResource.Id)
 	d.Set("resource_id", authorizedResource.Id)
@@ -182,3 +182,47 @@ func TestResourceAuthorization_DoesNotSwallowError(t *testing.T) {
 		})
 	}
 }
+
+// verifies that importing a variable group authorization reads whether it is authorized for all pipelines
+func TestResourceAuthorization_Import_VariableGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	importProjectID := uuid.New().String()
+	buildClient.
+		EXPECT().
+		GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+			Project: converter.String(importProjectID),
+			Type:    converter.String("variablegroup"),
+			Id:      converter.String("12"),
+		}).
+		Return(&[]build.DefinitionResourceReference{{
+			Authorized: converter.Bool(true),
+			Id:         converter.String("12"),
+			Type:       converter.String("variablegroup"),
+		}}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceResourceAuthorization().Schema, nil)
+	resourceData.SetId(importProjectID + "/variablegroup/12")
+
+	imported, err := resourceResourceAuthorizationImport(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, imported, 1)
+	require.Equal(t, "12", imported[0].Id())
+	require.Equal(t, importProjectID, imported[0].Get("project_id"))
+	require.Equal(t, "variablegroup", imported[0].Get("type"))
+	require.Equal(t, true, imported[0].Get("authorized"))
+}
+
+func TestResourceAuthorization_Import_RejectsInvalidID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceResourceAuthorization().Schema, nil)
+	resourceData.SetId("project/variablegroup")
+
+	_, err := resourceResourceAuthorizationImport(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "<project>/<type>/<resource ID>")
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/organizationpolicy"
+)
+
+// DataOrgPolicyValues schema and implementation for a snapshot of the policy values of an organization
+func DataOrgPolicyValues() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceOrgPolicyValuesRead,
+
+		Schema: map[string]*schema.Schema{
+			"policies": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeBool,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrgPolicyValuesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	policies := map[string]interface{}{}
+	for _, setting := range organizationPolicySettings {
+		policy, err := clients.OrganizationPolicyClient.GetPolicy(ctx, organizationpolicy.GetPolicyArgs{
+			PolicyName: converter.String(setting.policyName),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(" reading organization policy %s: %+v", setting.policyName, err))
+		}
+		policies[setting.policyName] = organizationPolicyValue(policy)
+	}
+
+	d.SetId(clients.OrganizationURL)
+	d.Set("policies", policies)
+	return nil
+}
@@ -0,0 +1,83 @@
+//go:build (all || core || data_sources || data_org_policy_values) && (!data_sources || !exclude_data_org_policy_values)
+// +build all core data_sources data_org_policy_values
+// +build !data_sources !exclude_data_org_policy_values
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/organizationpolicy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataOrgPolicyValues_Read_ReturnsAllPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockOrganizationpolicyClient(ctrl)
+	clients := &client.AggregatedClient{
+		OrganizationPolicyClient: policyClient,
+		OrganizationURL:          "https://dev.azure.com/example",
+		Ctx:                      context.Background(),
+	}
+
+	policies := map[string]*organizationpolicy.Policy{
+		organizationpolicy.PolicyNameValues.DisallowOAuthAuthentication: {Value: converter.Bool(true)},
+		organizationpolicy.PolicyNameValues.DisallowSecureShell:         {Value: converter.Bool(false)},
+		organizationpolicy.PolicyNameValues.AllowAnonymousAccess:        {EffectiveValue: converter.Bool(true)},
+		organizationpolicy.PolicyNameValues.DisallowAadGuestUserAccess:  {Value: converter.Bool(true)},
+		organizationpolicy.PolicyNameValues.EnforceAADConditionalAccess: {},
+	}
+	for name, policy := range policies {
+		policyClient.
+			EXPECT().
+			GetPolicy(clients.Ctx, organizationpolicy.GetPolicyArgs{
+				PolicyName: converter.String(name),
+			}).
+			Return(policy, nil).
+			Times(1)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, DataOrgPolicyValues().Schema, nil)
+	diags := dataSourceOrgPolicyValuesRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "https://dev.azure.com/example", resourceData.Id())
+	require.Equal(t, map[string]interface{}{
+		organizationpolicy.PolicyNameValues.DisallowOAuthAuthentication: true,
+		organizationpolicy.PolicyNameValues.DisallowSecureShell:         false,
+		organizationpolicy.PolicyNameValues.AllowAnonymousAccess:        true,
+		organizationpolicy.PolicyNameValues.DisallowAadGuestUserAccess:  true,
+		organizationpolicy.PolicyNameValues.EnforceAADConditionalAccess: false,
+	}, resourceData.Get("policies"))
+}
+
+func TestDataOrgPolicyValues_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockOrganizationpolicyClient(ctrl)
+	clients := &client.AggregatedClient{
+		OrganizationPolicyClient: policyClient,
+		Ctx:                      context.Background(),
+	}
+
+	policyClient.
+		EXPECT().
+		GetPolicy(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("@@GetPolicy@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataOrgPolicyValues().Schema, nil)
+	diags := dataSourceOrgPolicyValuesRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "@@GetPolicy@@failed@@")
+	require.Empty(t, resourceData.Id())
+}
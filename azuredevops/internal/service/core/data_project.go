@@ -55,6 +55,25 @@ func DataProject() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_team_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_team_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -94,5 +113,36 @@ func dataProjectRead(ctx context.Context, d *schema.ResourceData, m interface{})
 	if err != nil {
 		return diag.FromErr(fmt.Errorf("Error flattening project: %v", err))
 	}
+
+	properties, err := clients.CoreClient.GetProjectProperties(ctx, core.GetProjectPropertiesArgs{
+		ProjectId: project.Id,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error looking up properties of project with Name %s or ID %s, %+v ", name, id, err))
+	}
+	flattenProjectDetails(d, project, properties)
 	return nil
 }
+
+func flattenProjectDetails(d *schema.ResourceData, project *core.TeamProject, properties *[]core.ProjectProperty) {
+	if project.State != nil {
+		d.Set("state", string(*project.State))
+	}
+	if project.DefaultTeam != nil {
+		if project.DefaultTeam.Id != nil {
+			d.Set("default_team_id", project.DefaultTeam.Id.String())
+		}
+		d.Set("default_team_name", converter.ToString(project.DefaultTeam.Name, ""))
+	}
+
+	projectProperties := map[string]string{}
+	if properties != nil {
+		for _, property := range *properties {
+			if property.Name == nil || property.Value == nil {
+				continue
+			}
+			projectProperties[*property.Name] = fmt.Sprintf("%v", property.Value)
+		}
+	}
+	d.Set("properties", projectProperties)
+}
@@ -0,0 +1,128 @@
+//go:build (all || core || data_sources || resource_project || data_project) && (!data_sources || !exclude_data_project)
+// +build all core data_sources resource_project data_project
+// +build !data_sources !exclude_data_project
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var dataProjectTestProcessID = uuid.New()
+var dataProjectTestDefaultTeamID = uuid.New()
+
+func dataProjectTestProject() *core.TeamProject {
+	projectID := uuid.New()
+	return &core.TeamProject{
+		Id:          &projectID,
+		Name:        converter.String("project"),
+		Description: converter.String("description"),
+		Visibility:  &core.ProjectVisibilityValues.Private,
+		State:       &core.ProjectStateValues.WellFormed,
+		Capabilities: &map[string]map[string]string{
+			"versioncontrol": {
+				"sourceControlType": "Git",
+			},
+			"processTemplate": {
+				"templateTypeId": dataProjectTestProcessID.String(),
+			},
+		},
+		DefaultTeam: &core.WebApiTeamRef{
+			Id:   &dataProjectTestDefaultTeamID,
+			Name: converter.String("project Team"),
+		},
+	}
+}
+
+// verifies that the details of a project are read
+func TestDataSourceProject_Read_ReadsProjectDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, Ctx: context.Background()}
+
+	project := dataProjectTestProject()
+	resourceData := schema.TestResourceDataRaw(t, DataProject().Schema, nil)
+	resourceData.Set("name", "project")
+
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, core.GetProjectArgs{
+			ProjectId:           converter.String("project"),
+			IncludeCapabilities: converter.Bool(true),
+			IncludeHistory:      converter.Bool(false),
+		}).
+		Return(project, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetProcessById(clients.Ctx, core.GetProcessByIdArgs{ProcessId: &dataProjectTestProcessID}).
+		Return(&core.Process{Name: converter.String("Agile")}, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetProjectProperties(clients.Ctx, core.GetProjectPropertiesArgs{ProjectId: project.Id}).
+		Return(&[]core.ProjectProperty{
+			{Name: converter.String("System.Process Template"), Value: "Agile"},
+			{Name: converter.String("System.Wiki.1"), Value: nil},
+		}, nil).
+		Times(1)
+
+	diags := dataProjectRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, project.Id.String(), resourceData.Get("project_id"))
+	require.Equal(t, "wellFormed", resourceData.Get("state"))
+	require.Equal(t, "Git", resourceData.Get("version_control"))
+	require.Equal(t, dataProjectTestProcessID.String(), resourceData.Get("process_template_id"))
+	require.Equal(t, "Agile", resourceData.Get("work_item_template"))
+	require.Equal(t, dataProjectTestDefaultTeamID.String(), resourceData.Get("default_team_id"))
+	require.Equal(t, "project Team", resourceData.Get("default_team_name"))
+	require.Equal(t, map[string]interface{}{
+		"System.Process Template": "Agile",
+	}, resourceData.Get("properties"))
+}
+
+// verifies that if an error is produced while reading the project properties, it is not swallowed
+func TestDataSourceProject_Read_DoesNotSwallowPropertiesError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, Ctx: context.Background()}
+
+	project := dataProjectTestProject()
+	resourceData := schema.TestResourceDataRaw(t, DataProject().Schema, nil)
+	resourceData.Set("project_id", project.Id.String())
+
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, gomock.Any()).
+		Return(project, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetProcessById(clients.Ctx, gomock.Any()).
+		Return(&core.Process{Name: converter.String("Agile")}, nil).
+		Times(1)
+	coreClient.
+		EXPECT().
+		GetProjectProperties(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetProjectProperties() Failed")).
+		Times(1)
+
+	diags := dataProjectRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "GetProjectProperties() Failed")
+}
@@ -31,6 +31,13 @@ func DataProjects() *schema.Resource {
 				ValidateFunc:     validation.StringIsNotWhiteSpace,
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			"name_prefix": {
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"name"},
+			},
 			"state": {
 				Type:     schema.TypeString,
 				ForceNew: true,
@@ -84,6 +91,7 @@ func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m inter
 	clients := m.(*client.AggregatedClient)
 	state := d.Get("state").(string)
 	name := d.Get("name").(string)
+	namePrefix := d.Get("name_prefix").(string)
 
 	projects, err := getProjectsForStateAndName(clients, state, name)
 	if err != nil {
@@ -91,6 +99,11 @@ func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m inter
 	}
 	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Read [%d] projects from current organization", len(projects))
 
+	if namePrefix != "" {
+		projects = filterProjectsByNamePrefix(projects, namePrefix)
+		log.Printf("[TRACE] plugin.terraform-provider-azuredevops: [%d] projects match name prefix [%s]", len(projects), namePrefix)
+	}
+
 	results := flattenProjectReferences(&projects)
 
 	projectNames, err := datahelper.GetAttributeValues(results, "name")
@@ -100,6 +113,9 @@ func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, m inter
 	if len(projectNames) <= 0 && name != "" {
 		projectNames = append(projectNames, name)
 	}
+	if len(projectNames) <= 0 && namePrefix != "" {
+		projectNames = append(projectNames, namePrefix)
+	}
 	h := sha1.New()
 	if _, err := h.Write([]byte(state + strings.Join(projectNames, "-"))); err != nil {
 		return diag.FromErr(fmt.Errorf("Unable to compute hash for project names: %v", err))
@@ -143,6 +159,17 @@ func flattenProjectReferences(input *[]core.TeamProjectReference) []interface{}
 	return results
 }
 
+// Project names are case insensitive, so is the prefix
+func filterProjectsByNamePrefix(projects []core.TeamProjectReference, namePrefix string) []core.TeamProjectReference {
+	filtered := []core.TeamProjectReference{}
+	for _, project := range projects {
+		if project.Name != nil && strings.HasPrefix(strings.ToLower(*project.Name), strings.ToLower(namePrefix)) {
+			filtered = append(filtered, project)
+		}
+	}
+	return filtered
+}
+
 func getProjectsForStateAndName(clients *client.AggregatedClient, projectState string, projectName string) ([]core.TeamProjectReference, error) {
 	var projects []core.TeamProjectReference
 	var currentToken string
@@ -331,3 +331,51 @@ func TestDataSourceProjects_Read_TestContinuationToken(t *testing.T) {
 	require.NotNil(t, projectSet)
 	require.Equal(t, 6, projectSet.Len())
 }
+
+func TestDataSourceProjects_Read_TestFindProjectsByNamePrefix(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	var calls []*gomock.Call
+	calls = append(calls, coreClient.
+		EXPECT().
+		GetProjects(clients.Ctx, core.GetProjectsArgs{
+			StateFilter: &core.ProjectStateValues.All,
+		}).
+		Return(&core.GetProjectsResponseValue{
+			Value:             prjListStateWellFormed,
+			ContinuationToken: "2",
+		}, nil).
+		Times(1))
+
+	calls = append(calls, coreClient.
+		EXPECT().
+		GetProjects(clients.Ctx, core.GetProjectsArgs{
+			StateFilter:       &core.ProjectStateValues.All,
+			ContinuationToken: converter.String("2"),
+		}).
+		Return(&core.GetProjectsResponseValue{
+			Value:             prjListStateWellFormed2,
+			ContinuationToken: "",
+		}, nil).
+		Times(1))
+
+	gomock.InOrder(calls...)
+
+	resourceData := schema.TestResourceDataRaw(t, DataProjects().Schema, nil)
+	resourceData.Set("name_prefix", "VSTEAM-02")
+	err := dataSourceProjectsRead(clients.Ctx, resourceData, clients)
+	require.Nil(t, err)
+	projectSet := resourceData.Get("projects").(*schema.Set)
+	require.NotNil(t, projectSet)
+	require.Equal(t, 3, projectSet.Len())
+	for _, project := range projectSet.List() {
+		require.Contains(t, project.(map[string]interface{})["name"], "vsteam-02")
+	}
+}
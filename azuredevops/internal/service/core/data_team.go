@@ -1,9 +1,14 @@
 package core
 
 import (
+	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/work"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 )
 
 func DataTeam() *schema.Resource {
@@ -44,6 +49,14 @@ func DataTeam() *schema.Resource {
 				ConfigMode: schema.SchemaConfigModeAttr,
 				Set:        schema.HashString,
 			},
+			"descriptor": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_area_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -65,5 +78,35 @@ func dataTeamRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("administrators", administrators)
 	d.Set("members", members)
 
+	descriptor, err := clients.GraphClient.GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{
+		StorageKey: team.Id,
+	})
+	if err != nil {
+		return fmt.Errorf(" get team descriptor. Error: %+v", err)
+	}
+	d.Set("descriptor", descriptor.Value)
+
+	defaultAreaPath, err := readTeamDefaultAreaPath(clients, projectID, team.Id.String())
+	if err != nil {
+		return err
+	}
+	d.Set("default_area_path", defaultAreaPath)
+
 	return nil
 }
+
+// the default area path is the default value of the team field, which is the area path unless
+// the project uses a custom team field
+func readTeamDefaultAreaPath(clients *client.AggregatedClient, projectID string, teamID string) (string, error) {
+	fieldValues, err := clients.WorkClient.GetTeamFieldValues(clients.Ctx, work.GetTeamFieldValuesArgs{
+		Project: converter.String(projectID),
+		Team:    converter.String(teamID),
+	})
+	if err != nil {
+		return "", fmt.Errorf(" reading team field values of team %s. Error: %+v", teamID, err)
+	}
+	if fieldValues == nil {
+		return "", nil
+	}
+	return converter.ToString(fieldValues.DefaultValue, ""), nil
+}
@@ -0,0 +1,155 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataTeamMembers schema and implementation for the transitive members of a team
+func DataTeamMembers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataTeamMembersRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"descriptor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"account_license_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_display_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataTeamMembersRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	team, err := clients.CoreClient.GetTeam(clients.Ctx, core.GetTeamArgs{
+		ProjectId:      converter.String(projectID),
+		TeamId:         converter.String(teamID),
+		ExpandIdentity: converter.Bool(false),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading team %s of project %s: %+v", teamID, projectID, err)
+	}
+
+	members, err := readTransitiveTeamMembers(clients, team)
+	if err != nil {
+		return fmt.Errorf(" reading members of team %s: %+v", teamID, err)
+	}
+
+	result := make([]interface{}, 0, len(members))
+	for _, member := range members {
+		s := map[string]interface{}{
+			"id":           member.Id.String(),
+			"descriptor":   converter.ToString(member.SubjectDescriptor, ""),
+			"display_name": converter.ToString(member.ProviderDisplayName, ""),
+		}
+
+		// users without an entitlement in the organization, e.g. inactive AAD group members, have no license
+		entitlement, err := clients.MemberEntitleManagementClient.GetUserEntitlement(clients.Ctx, memberentitlementmanagement.GetUserEntitlementArgs{
+			UserId: member.Id,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf(" reading entitlement of user %s: %+v", member.Id.String(), err)
+		}
+		if err == nil && entitlement != nil && entitlement.AccessLevel != nil {
+			if entitlement.AccessLevel.AccountLicenseType != nil {
+				s["account_license_type"] = string(*entitlement.AccessLevel.AccountLicenseType)
+			}
+			s["license_display_name"] = converter.ToString(entitlement.AccessLevel.LicenseDisplayName, "")
+		}
+		result = append(result, s)
+	}
+
+	d.SetId(team.Id.String())
+	if err := d.Set("members", result); err != nil {
+		return fmt.Errorf("Error setting `members`: %+v", err)
+	}
+	return nil
+}
+
+// readTransitiveTeamMembers returns all users which are members of the team, either directly or through
+// nested Azure DevOps or AAD groups. The groups themselves are not returned.
+func readTransitiveTeamMembers(clients *client.AggregatedClient, team *core.WebApiTeam) ([]identity.Identity, error) {
+	teamIdentities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		IdentityIds:     converter.String(team.Id.String()),
+		QueryMembership: &identity.QueryMembershipValues.ExpandedDown,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if teamIdentities == nil || len(*teamIdentities) <= 0 {
+		return nil, fmt.Errorf("Identity of team %s not found", team.Id.String())
+	}
+
+	teamIdentity := (*teamIdentities)[0]
+	if teamIdentity.Members == nil || len(*teamIdentity.Members) <= 0 {
+		return []identity.Identity{}, nil
+	}
+
+	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+		Descriptors: converter.String(strings.Join(*teamIdentity.Members, ",")),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := []identity.Identity{}
+	if identities != nil {
+		for _, member := range *identities {
+			if member.Id == nil || converter.ToBool(member.IsContainer, false) {
+				continue
+			}
+			users = append(users, member)
+		}
+	}
+	sort.Slice(users, func(i, j int) bool {
+		return strings.ToLower(converter.ToString(users[i].ProviderDisplayName, "")) < strings.ToLower(converter.ToString(users[j].ProviderDisplayName, ""))
+	})
+	return users, nil
+}
@@ -0,0 +1,128 @@
+//go:build (all || core || data_sources || data_team_members) && (!exclude_data_sources || !exclude_data_team_members)
+// +build all core data_sources data_team_members
+// +build !exclude_data_sources !exclude_data_team_members
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/licensing"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/memberentitlementmanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataTeamMembers_Read_ExpandsGroupsAndLicenses(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	entitlementClient := azdosdkmocks.NewMockMemberentitlementmanagementClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient:                    coreClient,
+		IdentityClient:                identityClient,
+		MemberEntitleManagementClient: entitlementClient,
+		Ctx:                           context.Background(),
+	}
+
+	projectID := uuid.New()
+	teamID := uuid.New()
+	userID := uuid.New()
+	guestID := uuid.New()
+
+	coreClient.
+		EXPECT().
+		GetTeam(clients.Ctx, core.GetTeamArgs{
+			ProjectId:      converter.String(projectID.String()),
+			TeamId:         converter.String(teamID.String()),
+			ExpandIdentity: converter.Bool(false),
+		}).
+		Return(&core.WebApiTeam{Id: &teamID}, nil)
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			IdentityIds:     converter.String(teamID.String()),
+			QueryMembership: &identity.QueryMembershipValues.ExpandedDown,
+		}).
+		Return(&[]identity.Identity{{
+			Id:      &teamID,
+			Members: &[]string{"aad-group", "user", "guest"},
+		}}, nil)
+
+	groupID := uuid.New()
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			Descriptors: converter.String("aad-group,user,guest"),
+		}).
+		Return(&[]identity.Identity{
+			{Id: &groupID, IsContainer: converter.Bool(true), ProviderDisplayName: converter.String("AAD Group")},
+			{Id: &userID, SubjectDescriptor: converter.String("aad.user"), ProviderDisplayName: converter.String("User")},
+			{Id: &guestID, SubjectDescriptor: converter.String("aad.guest"), ProviderDisplayName: converter.String("Guest")},
+		}, nil)
+
+	entitlementClient.
+		EXPECT().
+		GetUserEntitlement(clients.Ctx, memberentitlementmanagement.GetUserEntitlementArgs{UserId: &userID}).
+		Return(&memberentitlementmanagement.UserEntitlement{
+			AccessLevel: &licensing.AccessLevel{
+				AccountLicenseType: &licensing.AccountLicenseTypeValues.Express,
+				LicenseDisplayName: converter.String("Basic"),
+			},
+		}, nil)
+	entitlementClient.
+		EXPECT().
+		GetUserEntitlement(clients.Ctx, memberentitlementmanagement.GetUserEntitlementArgs{UserId: &guestID}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)})
+
+	resourceData := schema.TestResourceDataRaw(t, DataTeamMembers().Schema, nil)
+	resourceData.Set("project_id", projectID.String())
+	resourceData.Set("team_id", teamID.String())
+
+	require.Nil(t, dataTeamMembersRead(resourceData, clients))
+	require.Equal(t, teamID.String(), resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("members.#"))
+	require.Equal(t, guestID.String(), resourceData.Get("members.0.id"))
+	require.Equal(t, "", resourceData.Get("members.0.account_license_type"))
+	require.Equal(t, userID.String(), resourceData.Get("members.1.id"))
+	require.Equal(t, "aad.user", resourceData.Get("members.1.descriptor"))
+	require.Equal(t, "express", resourceData.Get("members.1.account_license_type"))
+	require.Equal(t, "Basic", resourceData.Get("members.1.license_display_name"))
+}
+
+func TestDataTeamMembers_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		GetTeam(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("@@GetTeam@@failed@@"))
+
+	resourceData := schema.TestResourceDataRaw(t, DataTeamMembers().Schema, nil)
+	resourceData.Set("project_id", uuid.New().String())
+	resourceData.Set("team_id", uuid.New().String())
+
+	err := dataTeamMembersRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@GetTeam@@failed@@")
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/work"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -107,3 +108,53 @@ func TestDataTeam_Read_FailOnNotFound(t *testing.T) {
 	require.Equal(t, testTeamName, resourceData.Get("name"))
 	require.Zero(t, resourceData.Get("description"))
 }
+
+func TestDataTeam_ReadDefaultAreaPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	testProjectID := uuid.New().String()
+	testTeamID := uuid.New().String()
+
+	workClient.
+		EXPECT().
+		GetTeamFieldValues(clients.Ctx, work.GetTeamFieldValuesArgs{
+			Project: converter.String(testProjectID),
+			Team:    converter.String(testTeamID),
+		}).
+		Return(&work.TeamFieldValues{
+			DefaultValue: converter.String("project\\team area"),
+		}, nil).
+		Times(1)
+
+	defaultAreaPath, err := readTeamDefaultAreaPath(clients, testProjectID, testTeamID)
+	require.Nil(t, err)
+	require.Equal(t, "project\\team area", defaultAreaPath)
+}
+
+func TestDataTeam_ReadDefaultAreaPath_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	workClient.
+		EXPECT().
+		GetTeamFieldValues(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("@@GetTeamFieldValues@@failed@@")).
+		Times(1)
+
+	_, err := readTeamDefaultAreaPath(clients, uuid.New().String(), uuid.New().String())
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@GetTeamFieldValues@@failed@@")
+}
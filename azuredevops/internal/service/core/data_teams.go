@@ -21,6 +21,16 @@ func DataTeams() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IsUUID,
 			},
+			"include_members": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"include_administrators": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"teams": {
 				Computed: true,
 				Type:     schema.TypeList,
@@ -86,6 +96,9 @@ func dataTeamsRead(d *schema.ResourceData, m interface{}) error {
 			ToSlice(&projectIDList)
 	}
 
+	includeMembers := d.Get("include_members").(bool)
+	includeAdministrators := d.Get("include_administrators").(bool)
+
 	result := make([]interface{}, 0)
 	for _, projectID := range projectIDList {
 		teamList, err := clients.CoreClient.GetTeams(clients.Ctx, core.GetTeamsArgs{
@@ -104,13 +117,18 @@ func dataTeamsRead(d *schema.ResourceData, m interface{}) error {
 
 		teams := make([]interface{}, len(*teamList))
 		for i, team := range *teamList {
-			members, err := readTeamMembers(clients, &team)
-			if err != nil {
-				return err
+			var members, administrators *schema.Set
+			if includeMembers {
+				members, err = readTeamMembers(clients, &team)
+				if err != nil {
+					return err
+				}
 			}
-			administrators, err := readTeamAdministrators(d, clients, &team)
-			if err != nil {
-				return err
+			if includeAdministrators {
+				administrators, err = readTeamAdministrators(d, clients, &team)
+				if err != nil {
+					return err
+				}
 			}
 
 			s := make(map[string]interface{})
@@ -316,3 +316,63 @@ func TestDataTeams_Read_EnsureAll(t *testing.T) {
 		require.Equal(t, testProjectID.String(), team["project_id"])
 	}
 }
+
+func TestDataTeams_Read_SkipsMembershipWhenDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+
+	clients := &client.AggregatedClient{
+		CoreClient:     coreClient,
+		IdentityClient: identityClient,
+		SecurityClient: securityClient,
+		Ctx:            context.Background(),
+	}
+
+	testProjectID := uuid.New()
+	testTeamID := uuid.New()
+	testTeamName := "@@TEST TEAM@@"
+
+	coreClient.
+		EXPECT().
+		GetTeams(clients.Ctx, core.GetTeamsArgs{
+			ProjectId:      converter.String(testProjectID.String()),
+			Mine:           converter.Bool(false),
+			ExpandIdentity: converter.Bool(false),
+		}).
+		Return(&[]core.WebApiTeam{
+			{
+				Id:        &testTeamID,
+				Name:      &testTeamName,
+				ProjectId: &testProjectID,
+			},
+		}, nil).
+		Times(1)
+
+	identityClient.
+		EXPECT().
+		ReadMembers(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := schema.TestResourceDataRaw(t, DataTeams().Schema, nil)
+	resourceData.Set("project_id", testProjectID.String())
+	resourceData.Set("include_members", false)
+	resourceData.Set("include_administrators", false)
+	err := dataTeamsRead(resourceData, clients)
+	require.Nil(t, err)
+
+	teams := resourceData.Get("teams").([]interface{})
+	require.Len(t, teams, 1)
+	team := teams[0].(map[string]interface{})
+	require.Equal(t, testTeamID.String(), team["id"])
+	require.Equal(t, 0, team["members"].(*schema.Set).Len())
+	require.Equal(t, 0, team["administrators"].(*schema.Set).Len())
+}
@@ -0,0 +1,139 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/organizationpolicy"
+)
+
+// organizationPolicySetting maps an attribute of the resource to an organization policy. Policies which
+// disallow something are inverted if the attribute allows it.
+type organizationPolicySetting struct {
+	attribute  string
+	policyName string
+	inverted   bool
+}
+
+var organizationPolicySettings = []organizationPolicySetting{
+	{attribute: "disallow_third_party_oauth", policyName: organizationpolicy.PolicyNameValues.DisallowOAuthAuthentication},
+	{attribute: "disallow_ssh_authentication", policyName: organizationpolicy.PolicyNameValues.DisallowSecureShell},
+	{attribute: "allow_public_projects", policyName: organizationpolicy.PolicyNameValues.AllowAnonymousAccess},
+	{attribute: "allow_external_guest_access", policyName: organizationpolicy.PolicyNameValues.DisallowAadGuestUserAccess, inverted: true},
+	{attribute: "enforce_aad_conditional_access", policyName: organizationpolicy.PolicyNameValues.EnforceAADConditionalAccess},
+}
+
+// ResourceOrganizationPolicies schema and implementation for the security policies of an organization
+func ResourceOrganizationPolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceOrganizationPoliciesCreateUpdate,
+		ReadContext:   resourceOrganizationPoliciesRead,
+		UpdateContext: resourceOrganizationPoliciesCreateUpdate,
+		DeleteContext: resourceOrganizationPoliciesDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrganizationPoliciesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disallow_third_party_oauth": {
+				Description: "Disallow third-party application access via OAuth",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"disallow_ssh_authentication": {
+				Description: "Disallow SSH authentication",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"allow_public_projects": {
+				Description: "Allow public projects",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"allow_external_guest_access": {
+				Description: "Allow access of external guest users",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"enforce_aad_conditional_access": {
+				Description: "Enable Azure Active Directory conditional access policy validation",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceOrganizationPoliciesCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	rawConfig := d.GetRawConfig().AsValueMap()
+	for _, setting := range organizationPolicySettings {
+		value := rawConfig[setting.attribute]
+		if value.IsNull() {
+			continue
+		}
+		policyValue := value.True() != setting.inverted
+		err := clients.OrganizationPolicyClient.UpdatePolicy(ctx, organizationpolicy.UpdatePolicyArgs{
+			PolicyName: converter.String(setting.policyName),
+			Value:      converter.Bool(policyValue),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(" updating organization policy %s: %+v", setting.policyName, err))
+		}
+	}
+
+	d.SetId(clients.OrganizationURL)
+	return resourceOrganizationPoliciesRead(ctx, d, m)
+}
+
+func resourceOrganizationPoliciesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	for _, setting := range organizationPolicySettings {
+		policy, err := clients.OrganizationPolicyClient.GetPolicy(ctx, organizationpolicy.GetPolicyArgs{
+			PolicyName: converter.String(setting.policyName),
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(" reading organization policy %s: %+v", setting.policyName, err))
+		}
+
+		d.Set(setting.attribute, organizationPolicyValue(policy) != setting.inverted)
+	}
+	return nil
+}
+
+// organizationPolicyValue returns the value of a policy, falling back to the effective value if the policy has not been set
+func organizationPolicyValue(policy *organizationpolicy.Policy) bool {
+	if policy.Value != nil {
+		return *policy.Value
+	}
+	return converter.ToBool(policy.EffectiveValue, false)
+}
+
+func resourceOrganizationPoliciesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// nothing to do, as the original policies are unknown.
+	return nil
+}
+
+// resourceOrganizationPoliciesImport imports the policies of the organization configured for the provider by its URL
+func resourceOrganizationPoliciesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	if !strings.EqualFold(strings.TrimSuffix(d.Id(), "/"), strings.TrimSuffix(clients.OrganizationURL, "/")) {
+		return nil, fmt.Errorf("unexpected ID (%s), expected the URL of the organization configured for the provider (%s)", d.Id(), clients.OrganizationURL)
+	}
+
+	d.SetId(clients.OrganizationURL)
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,96 @@
+//go:build (all || resource_organization_policies) && !exclude_resource_organization_policies
+// +build all resource_organization_policies
+// +build !exclude_resource_organization_policies
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/organizationpolicy"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationPolicies_Read_InvertsDisallowPolicies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockOrganizationpolicyClient(ctrl)
+	clients := &client.AggregatedClient{
+		OrganizationPolicyClient: policyClient,
+		Ctx:                      context.Background(),
+	}
+
+	policies := map[string]*organizationpolicy.Policy{
+		organizationpolicy.PolicyNameValues.DisallowOAuthAuthentication: {Value: converter.Bool(true)},
+		organizationpolicy.PolicyNameValues.DisallowSecureShell:         {Value: converter.Bool(false)},
+		organizationpolicy.PolicyNameValues.AllowAnonymousAccess:        {EffectiveValue: converter.Bool(true)},
+		organizationpolicy.PolicyNameValues.DisallowAadGuestUserAccess:  {Value: converter.Bool(true)},
+		organizationpolicy.PolicyNameValues.EnforceAADConditionalAccess: {Value: converter.Bool(false)},
+	}
+	for name, policy := range policies {
+		policyClient.
+			EXPECT().
+			GetPolicy(clients.Ctx, organizationpolicy.GetPolicyArgs{
+				PolicyName: converter.String(name),
+			}).
+			Return(policy, nil).
+			Times(1)
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceOrganizationPolicies().Schema, nil)
+	diags := resourceOrganizationPoliciesRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, true, resourceData.Get("disallow_third_party_oauth"))
+	require.Equal(t, false, resourceData.Get("disallow_ssh_authentication"))
+	require.Equal(t, true, resourceData.Get("allow_public_projects"))
+	require.Equal(t, false, resourceData.Get("allow_external_guest_access"))
+	require.Equal(t, false, resourceData.Get("enforce_aad_conditional_access"))
+}
+
+func TestOrganizationPolicies_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockOrganizationpolicyClient(ctrl)
+	clients := &client.AggregatedClient{
+		OrganizationPolicyClient: policyClient,
+		Ctx:                      context.Background(),
+	}
+
+	policyClient.
+		EXPECT().
+		GetPolicy(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("@@GetPolicy@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceOrganizationPolicies().Schema, nil)
+	diags := resourceOrganizationPoliciesRead(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "@@GetPolicy@@failed@@")
+}
+
+func TestOrganizationPolicies_Import_RequiresOrganizationURL(t *testing.T) {
+	clients := &client.AggregatedClient{
+		OrganizationURL: "https://dev.azure.com/example",
+		Ctx:             context.Background(),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceOrganizationPolicies().Schema, nil)
+	resourceData.SetId("https://dev.azure.com/Example/")
+	result, err := resourceOrganizationPoliciesImport(clients.Ctx, resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "https://dev.azure.com/example", resourceData.Id())
+
+	resourceData.SetId("https://dev.azure.com/other")
+	_, err = resourceOrganizationPoliciesImport(clients.Ctx, resourceData, clients)
+	require.NotNil(t, err)
+}
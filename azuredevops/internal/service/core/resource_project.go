@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"log"
 	"strings"
@@ -15,6 +16,8 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/featuremanagement"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/operations"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -32,6 +35,7 @@ func ResourceProject() *schema.Resource {
 		ReadContext:   resourceProjectRead,
 		UpdateContext: resourceProjectUpdate,
 		DeleteContext: resourceProjectDelete,
+		CustomizeDiff: customizeProjectProcessDiff,
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -76,7 +80,6 @@ func ResourceProject() *schema.Resource {
 			},
 			"work_item_template": {
 				Type:             schema.TypeString,
-				ForceNew:         true,
 				Optional:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
 				Default:          "Agile",
@@ -93,6 +96,12 @@ func ResourceProject() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			// write-only, Azure DevOps resizes the uploaded image, so it is not read back
+			"avatar_base64": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsBase64,
+			},
 		},
 	}
 }
@@ -109,6 +118,11 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		return diag.FromErr(fmt.Errorf(" creating project: %v", err))
 	}
 
+	createdProject, err := waitForProjectWellFormed(clients, *project.Name, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
 	featureStates, ok := d.GetOk("features")
 	if ok {
 		err = configureProjectFeatures(clients, "", *project.Name, &featureStates, d.Timeout(schema.TimeoutDelete))
@@ -117,6 +131,13 @@ func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, m interf
 		}
 	}
 
+	if avatar, ok := d.GetOk("avatar_base64"); ok {
+		err = setProjectAvatar(clients, createdProject.Id.String(), avatar.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	d.Set("name", *project.Name)
 	return resourceProjectRead(ctx, d, m)
 }
@@ -191,6 +212,75 @@ func projectStatusRefreshFunc(clients *client.AggregatedClient, operationRef *op
 	}
 }
 
+// waitForProjectWellFormed waits until a project identified by its ID or name leaves the states a project goes through
+// while it is being created or updated. Projects can only be used reliably once they are well formed.
+func waitForProjectWellFormed(clients *client.AggregatedClient, identifier string, timeout time.Duration) (*core.TeamProject, error) {
+	stateConf := &resource.StateChangeConf{
+		ContinuousTargetOccurence: 1,
+		Delay:                     2 * time.Second,
+		MinTimeout:                5 * time.Second,
+		Pending: []string{
+			string(core.ProjectStateValues.New),
+			string(core.ProjectStateValues.CreatePending),
+		},
+		Target: []string{
+			string(core.ProjectStateValues.WellFormed),
+		},
+		Refresh: projectStateRefreshFunc(clients, identifier),
+		Timeout: timeout,
+	}
+
+	project, err := stateConf.WaitForStateContext(clients.Ctx)
+	if err != nil {
+		return nil, fmt.Errorf(" waiting for project %s to become wellFormed. %v ", identifier, err)
+	}
+	return project.(*core.TeamProject), nil
+}
+
+func projectStateRefreshFunc(clients *client.AggregatedClient, identifier string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		project, err := clients.CoreClient.GetProject(clients.Ctx, core.GetProjectArgs{
+			ProjectId:           converter.String(identifier),
+			IncludeCapabilities: converter.Bool(false),
+			IncludeHistory:      converter.Bool(false),
+		})
+		if err != nil {
+			if utils.ResponseWasNotFound(err) {
+				return nil, "", nil
+			}
+			return nil, "", err
+		}
+
+		state := ""
+		if project.State != nil {
+			state = string(*project.State)
+		}
+		if state != string(core.ProjectStateValues.WellFormed) {
+			log.Printf("[DEBUG] Waiting for project %s to become wellFormed. Current state %s", identifier, state)
+		}
+		return project, state, nil
+	}
+}
+
+// setProjectAvatar sets the avatar of a project from a base64 encoded image
+func setProjectAvatar(clients *client.AggregatedClient, projectID string, avatarBase64 string) error {
+	image, err := base64.StdEncoding.DecodeString(avatarBase64)
+	if err != nil {
+		return fmt.Errorf(" decoding avatar of project %s: %+v", projectID, err)
+	}
+
+	err = clients.CoreClient.SetProjectAvatar(clients.Ctx, core.SetProjectAvatarArgs{
+		ProjectId: converter.String(projectID),
+		AvatarBlob: &core.ProjectAvatar{
+			Image: &image,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(" setting avatar of project %s: %+v", projectID, err)
+	}
+	return nil
+}
+
 func resourceProjectRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clients := m.(*client.AggregatedClient)
 	id := d.Id()
@@ -277,6 +367,36 @@ func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, m interf
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("Error updating project: %v", err))
 		}
+
+		_, err = waitForProjectWellFormed(clients, project.Id.String(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("avatar_base64") {
+		log.Printf("[TRACE] resourceProjectUpdate: updating project avatar")
+		if avatar, ok := d.GetOk("avatar_base64"); ok {
+			err = setProjectAvatar(clients, project.Id.String(), avatar.(string))
+		} else {
+			err = clients.CoreClient.RemoveProjectAvatar(clients.Ctx, core.RemoveProjectAvatarArgs{
+				ProjectId: converter.String(project.Id.String()),
+			})
+			if err != nil {
+				err = fmt.Errorf(" removing avatar of project %s: %+v", project.Id.String(), err)
+			}
+		}
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("work_item_template") {
+		log.Printf("[TRACE] resourceProjectUpdate: migrating project to a different process")
+		err = migrateProjectProcess(clients, project.Id.String(), d.Get("work_item_template").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if d.HasChange("features") {
@@ -415,20 +535,9 @@ func deleteProject(clients *client.AggregatedClient, id string, timeoutSeconds t
 
 // Convert internal Terraform data structure to an AzDO data structure
 func expandProject(clients *client.AggregatedClient, d *schema.ResourceData, forCreate bool) (*core.TeamProject, error) {
-	var processTemplateID string
-	var err error
-	workItemTemplate := strings.TrimSpace(d.Get("work_item_template").(string))
-	if len(workItemTemplate) > 0 {
-		processTemplateID, err = lookupProcessTemplateID(clients, workItemTemplate)
-		if err != nil {
-			return nil, err
-		}
-	} else { // use the organization default template if an empty string is set
-		processTemplateUUID, err := getDefaultProcessTemplateID(clients)
-		if err != nil {
-			return nil, err
-		}
-		processTemplateID = processTemplateUUID.String()
+	processTemplateID, err := getProcessTemplateID(clients, d.Get("work_item_template").(string))
+	if err != nil {
+		return nil, err
 	}
 
 	// an "error" is OK here as it is expected in the case that the ID is not set in the resource data
@@ -502,6 +611,101 @@ func flattenProject(clients *client.AggregatedClient, d *schema.ResourceData, pr
 	return nil
 }
 
+// Migrates a project to a different process. Azure DevOps only allows to migrate between processes
+// which are derived from the same system process, e.g. from Agile to an inherited Agile process.
+func migrateProjectProcess(clients *client.AggregatedClient, projectID string, workItemTemplate string) error {
+	processTemplateID, err := getProcessTemplateID(clients, workItemTemplate)
+	if err != nil {
+		return err
+	}
+	processTemplateUUID, err := uuid.Parse(processTemplateID)
+	if err != nil {
+		return fmt.Errorf(" parsing process template ID %s: %+v", processTemplateID, err)
+	}
+
+	_, err = clients.WorkItemTrackingClient.MigrateProjectsProcess(clients.Ctx, workitemtracking.MigrateProjectsProcessArgs{
+		NewProcess: &workitemtracking.ProcessIdModel{
+			TypeId: &processTemplateUUID,
+		},
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		return fmt.Errorf(" migrating project %s to process %s: %+v", projectID, workItemTemplate, err)
+	}
+	return nil
+}
+
+// Recreates the project if the work item template changes to a process which is not derived from the
+// same system process, because Azure DevOps cannot migrate the project to such a process.
+func customizeProjectProcessDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.HasChange("work_item_template") || !d.NewValueKnown("work_item_template") {
+		return nil
+	}
+
+	oldTemplate, newTemplate := d.GetChange("work_item_template")
+	if strings.EqualFold(oldTemplate.(string), newTemplate.(string)) {
+		return nil
+	}
+
+	clients := m.(*client.AggregatedClient)
+	supported, err := isProcessMigrationSupported(clients, oldTemplate.(string), newTemplate.(string))
+	if err != nil {
+		return err
+	}
+	if !supported {
+		log.Printf("[DEBUG] customizeProjectProcessDiff: processes %q and %q do not share a system process; recreating the project", oldTemplate, newTemplate)
+		return d.ForceNew("work_item_template")
+	}
+	return nil
+}
+
+// Reports whether both processes are derived from the same system process. An empty name resolves to the organization default process
+func isProcessMigrationSupported(clients *client.AggregatedClient, oldTemplate string, newTemplate string) (bool, error) {
+	processes, err := clients.WorkItemTrackingProcessClient.GetListOfProcesses(clients.Ctx, workitemtrackingprocess.GetListOfProcessesArgs{})
+	if err != nil {
+		return false, fmt.Errorf(" listing processes: %+v", err)
+	}
+
+	oldSystemProcessID := getSystemProcessTypeID(processes, oldTemplate)
+	newSystemProcessID := getSystemProcessTypeID(processes, newTemplate)
+	if oldSystemProcessID == nil || newSystemProcessID == nil {
+		return false, nil
+	}
+	return *oldSystemProcessID == *newSystemProcessID, nil
+}
+
+// given a process name, get the ID of the system process it is derived from
+func getSystemProcessTypeID(processes *[]workitemtrackingprocess.ProcessInfo, processName string) *uuid.UUID {
+	processName = strings.TrimSpace(processName)
+	for _, p := range *processes {
+		if len(processName) > 0 && !strings.EqualFold(converter.ToString(p.Name, ""), processName) {
+			continue
+		}
+		if len(processName) == 0 && !converter.ToBool(p.IsDefault, false) {
+			continue
+		}
+		if p.CustomizationType != nil && *p.CustomizationType == workitemtrackingprocess.CustomizationTypeValues.System {
+			return p.TypeId
+		}
+		return p.ParentProcessTypeId
+	}
+	return nil
+}
+
+// given a process template name, get the process template ID. An empty name resolves to the organization default process
+func getProcessTemplateID(clients *client.AggregatedClient, workItemTemplate string) (string, error) {
+	workItemTemplate = strings.TrimSpace(workItemTemplate)
+	if len(workItemTemplate) > 0 {
+		return lookupProcessTemplateID(clients, workItemTemplate)
+	}
+
+	processTemplateUUID, err := getDefaultProcessTemplateID(clients)
+	if err != nil {
+		return "", err
+	}
+	return processTemplateUUID.String(), nil
+}
+
 func getDefaultProcessTemplateID(clients *client.AggregatedClient) (*uuid.UUID, error) {
 	processes, err := clients.CoreClient.GetProcesses(clients.Ctx, core.GetProcessesArgs{})
 	if err != nil {
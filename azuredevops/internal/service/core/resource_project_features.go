@@ -11,6 +11,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/featuremanagement"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ProjectFeatureType Project feature in Azure DevOps
@@ -57,7 +58,7 @@ func ResourceProjectFeatures() *schema.Resource {
 		UpdateContext: resourceProjectFeaturesCreateUpdate,
 		DeleteContext: resourceProjectFeaturesDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceProjectFeaturesImport,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -97,9 +98,17 @@ func resourceProjectFeaturesCreateUpdate(ctx context.Context, d *schema.Resource
 func resourceProjectFeaturesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clients := m.(*client.AggregatedClient)
 
-	projectID := d.Get("project_id").(string)
+	projectID := d.Id()
 	featureStates := d.Get("features").(map[string]interface{})
-	currentFeatureStates, err := getConfiguredProjectFeatureStates(ctx, clients.FeatureManagementClient, &featureStates, projectID)
+
+	var currentFeatureStates *map[ProjectFeatureType]featuremanagement.ContributedFeatureEnabledValue
+	var err error
+	if len(featureStates) == 0 {
+		// nothing has been configured yet (e.g. on import), so the states of all features are read
+		currentFeatureStates, err = getProjectFeatureStates(ctx, clients.FeatureManagementClient, projectID)
+	} else {
+		currentFeatureStates, err = getConfiguredProjectFeatureStates(ctx, clients.FeatureManagementClient, &featureStates, projectID)
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -107,6 +116,7 @@ func resourceProjectFeaturesRead(ctx context.Context, d *schema.ResourceData, m
 		d.SetId("")
 		return diag.FromErr(fmt.Errorf(" failed to retrieve current feature states for project: %s", projectID))
 	}
+	d.Set("project_id", projectID)
 	d.Set("features", currentFeatureStates)
 	return nil
 }
@@ -128,6 +138,16 @@ func resourceProjectFeaturesDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+// resourceProjectFeaturesImport imports the features of a project by its ID or name
+func resourceProjectFeaturesImport(ctx context.Context, d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	projectID, err := tfhelper.GetRealProjectId(d.Id(), m)
+	if err != nil {
+		return nil, err
+	}
+	d.SetId(projectID)
+	return []*schema.ResourceData{d}, nil
+}
+
 func getConfiguredProjectFeatureStates(ctx context.Context, fc featuremanagement.Client, featureStates *map[string]interface{}, projectID string) (*map[ProjectFeatureType]featuremanagement.ContributedFeatureEnabledValue, error) {
 	if featureStates == nil {
 		return nil, nil
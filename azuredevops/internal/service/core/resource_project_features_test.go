@@ -0,0 +1,90 @@
+//go:build (all || resource_project_features) && !exclude_resource_project_features
+// +build all resource_project_features
+// +build !exclude_resource_project_features
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/featuremanagement"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/stretchr/testify/require"
+)
+
+func projectFeaturesTestStates() *featuremanagement.ContributedFeatureStateQuery {
+	enabled := featuremanagement.ContributedFeatureEnabledValueValues.Enabled
+	disabled := featuremanagement.ContributedFeatureEnabledValueValues.Disabled
+	return &featuremanagement.ContributedFeatureStateQuery{
+		FeatureStates: &map[string]featuremanagement.ContributedFeatureState{
+			"ms.vss-work.agile":           {State: &enabled},
+			"ms.vss-code.version-control": {State: &enabled},
+			"ms.vss-build.pipelines":      {State: &enabled},
+			"ms.vss-test-web.test":        {State: &disabled},
+			"ms.azure-artifacts.feature":  {State: &disabled},
+		},
+	}
+}
+
+// verifies that all feature states are read when no features are configured, e.g. after an import
+func TestProjectFeatures_Read_ReadsAllFeaturesWithoutConfiguration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	featureClient := azdosdkmocks.NewMockFeaturemanagementClient(ctrl)
+	clients := &client.AggregatedClient{FeatureManagementClient: featureClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectFeatures().Schema, nil)
+	resourceData.SetId(projectID)
+
+	featureClient.
+		EXPECT().
+		QueryFeatureStates(clients.Ctx, gomock.Any()).
+		Return(projectFeaturesTestStates(), nil).
+		Times(1)
+
+	diags := resourceProjectFeaturesRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, projectID, resourceData.Get("project_id"))
+	require.Equal(t, map[string]interface{}{
+		"boards":       "enabled",
+		"repositories": "enabled",
+		"pipelines":    "enabled",
+		"testplans":    "disabled",
+		"artifacts":    "disabled",
+	}, resourceData.Get("features"))
+}
+
+// verifies that only the configured feature states are read
+func TestProjectFeatures_Read_ReadsConfiguredFeatures(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	featureClient := azdosdkmocks.NewMockFeaturemanagementClient(ctrl)
+	clients := &client.AggregatedClient{FeatureManagementClient: featureClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectFeatures().Schema, nil)
+	resourceData.SetId(projectID)
+	resourceData.Set("features", map[string]interface{}{
+		"testplans": "enabled",
+	})
+
+	featureClient.
+		EXPECT().
+		QueryFeatureStates(clients.Ctx, gomock.Any()).
+		Return(projectFeaturesTestStates(), nil).
+		Times(1)
+
+	diags := resourceProjectFeaturesRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, map[string]interface{}{
+		"testplans": "disabled",
+	}, resourceData.Get("features"))
+}
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+var projectPropertyNameRegex = regexp.MustCompile(`^[^/]+$`)
+
+// ResourceProjectProperties schema and implementation for custom project properties. Only the
+// properties defined in the configuration are managed, all other properties of the project are left untouched.
+func ResourceProjectProperties() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProjectPropertiesCreateOrUpdate,
+		Read:   resourceProjectPropertiesRead,
+		Update: resourceProjectPropertiesCreateOrUpdate,
+		Delete: resourceProjectPropertiesDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateDiagFunc: validation.MapKeyMatch(projectPropertyNameRegex, "property names must not be empty or contain a slash"),
+			},
+		},
+	}
+}
+
+func resourceProjectPropertiesCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing project ID %s: %+v", d.Get("project_id").(string), err)
+	}
+
+	oldData, newData := d.GetChange("properties")
+	oldProperties := oldData.(map[string]interface{})
+	newProperties := newData.(map[string]interface{})
+
+	operations := []webapi.JsonPatchOperation{}
+	for _, name := range sortedPropertyNames(newProperties) {
+		if oldValue, ok := oldProperties[name]; ok && oldValue == newProperties[name] {
+			continue
+		}
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:    &webapi.OperationValues.Add,
+			Path:  converter.String("/" + name),
+			Value: newProperties[name].(string),
+		})
+	}
+	for _, name := range sortedPropertyNames(oldProperties) {
+		if _, ok := newProperties[name]; ok {
+			continue
+		}
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:   &webapi.OperationValues.Remove,
+			Path: converter.String("/" + name),
+		})
+	}
+
+	if len(operations) > 0 {
+		err = clients.CoreClient.SetProjectProperties(clients.Ctx, core.SetProjectPropertiesArgs{
+			ProjectId:     &projectID,
+			PatchDocument: &operations,
+		})
+		if err != nil {
+			return fmt.Errorf(" setting properties of project %s: %+v", projectID, err)
+		}
+	}
+
+	d.SetId(projectID.String())
+	return resourceProjectPropertiesRead(d, m)
+}
+
+func resourceProjectPropertiesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing project ID %s: %+v", d.Get("project_id").(string), err)
+	}
+
+	names := sortedPropertyNames(d.Get("properties").(map[string]interface{}))
+	if len(names) <= 0 {
+		return nil
+	}
+
+	properties, err := clients.CoreClient.GetProjectProperties(clients.Ctx, core.GetProjectPropertiesArgs{
+		ProjectId: &projectID,
+		Keys:      &names,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading properties of project %s: %+v", projectID, err)
+	}
+
+	projectProperties := map[string]string{}
+	if properties != nil {
+		for _, property := range *properties {
+			if property.Name == nil || property.Value == nil {
+				continue
+			}
+			projectProperties[*property.Name] = fmt.Sprintf("%v", property.Value)
+		}
+	}
+	d.Set("properties", projectProperties)
+	return nil
+}
+
+func resourceProjectPropertiesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing project ID %s: %+v", d.Get("project_id").(string), err)
+	}
+
+	operations := []webapi.JsonPatchOperation{}
+	for _, name := range sortedPropertyNames(d.Get("properties").(map[string]interface{})) {
+		operations = append(operations, webapi.JsonPatchOperation{
+			Op:   &webapi.OperationValues.Remove,
+			Path: converter.String("/" + name),
+		})
+	}
+
+	if len(operations) > 0 {
+		err = clients.CoreClient.SetProjectProperties(clients.Ctx, core.SetProjectPropertiesArgs{
+			ProjectId:     &projectID,
+			PatchDocument: &operations,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf(" removing properties of project %s: %+v", projectID, err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func sortedPropertyNames(properties map[string]interface{}) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
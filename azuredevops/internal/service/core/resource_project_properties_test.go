@@ -0,0 +1,148 @@
+//go:build (all || core || resource_project_properties) && !exclude_resource_project_properties
+// +build all core resource_project_properties
+// +build !exclude_resource_project_properties
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectProperties_Create_AddsProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	projectID := uuid.New()
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.Ctx, core.SetProjectPropertiesArgs{
+			ProjectId: &projectID,
+			PatchDocument: &[]webapi.JsonPatchOperation{
+				{
+					Op:    &webapi.OperationValues.Add,
+					Path:  converter.String("/CostCenter"),
+					Value: "1234",
+				},
+				{
+					Op:    &webapi.OperationValues.Add,
+					Path:  converter.String("/Owner"),
+					Value: "platform",
+				},
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	coreClient.
+		EXPECT().
+		GetProjectProperties(clients.Ctx, core.GetProjectPropertiesArgs{
+			ProjectId: &projectID,
+			Keys:      &[]string{"CostCenter", "Owner"},
+		}).
+		Return(&[]core.ProjectProperty{
+			{Name: converter.String("CostCenter"), Value: "1234"},
+			{Name: converter.String("Owner"), Value: "platform"},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectProperties().Schema, map[string]interface{}{
+		"project_id": projectID.String(),
+		"properties": map[string]interface{}{
+			"CostCenter": "1234",
+			"Owner":      "platform",
+		},
+	})
+
+	err := resourceProjectPropertiesCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, projectID.String(), resourceData.Id())
+	require.Equal(t, map[string]interface{}{
+		"CostCenter": "1234",
+		"Owner":      "platform",
+	}, resourceData.Get("properties"))
+}
+
+func TestProjectProperties_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.Ctx, gomock.Any()).
+		Return(fmt.Errorf("@@SetProjectProperties@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectProperties().Schema, map[string]interface{}{
+		"project_id": uuid.New().String(),
+		"properties": map[string]interface{}{
+			"Owner": "platform",
+		},
+	})
+
+	err := resourceProjectPropertiesCreateOrUpdate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@SetProjectProperties@@failed@@")
+	require.Empty(t, resourceData.Id())
+}
+
+func TestProjectProperties_Delete_RemovesManagedProperties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	projectID := uuid.New()
+	coreClient.
+		EXPECT().
+		SetProjectProperties(clients.Ctx, core.SetProjectPropertiesArgs{
+			ProjectId: &projectID,
+			PatchDocument: &[]webapi.JsonPatchOperation{
+				{
+					Op:   &webapi.OperationValues.Remove,
+					Path: converter.String("/Owner"),
+				},
+			},
+		}).
+		Return(nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectProperties().Schema, map[string]interface{}{
+		"project_id": projectID.String(),
+		"properties": map[string]interface{}{
+			"Owner": "platform",
+		},
+	})
+	resourceData.SetId(projectID.String())
+
+	err := resourceProjectPropertiesDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
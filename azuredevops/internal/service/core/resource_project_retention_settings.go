@@ -0,0 +1,141 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceProjectRetentionSettings schema and implementation for the retention settings of a project
+func ResourceProjectRetentionSettings() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectRetentionSettingsCreateUpdate,
+		ReadContext:   resourceProjectRetentionSettingsRead,
+		UpdateContext: resourceProjectRetentionSettingsCreateUpdate,
+		DeleteContext: resourceProjectRetentionSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"days_to_keep_runs": {
+				Description:  "Days to keep runs",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"days_to_keep_artifacts": {
+				Description:  "Days to keep artifacts, symbols and attachments",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"days_to_keep_pull_request_runs": {
+				Description:  "Days to keep pull request runs",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"runs_to_retain_per_protected_branch": {
+				Description:  "Number of recent runs to retain per pipeline and protected branch",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceProjectRetentionSettingsCreateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	_, err := clients.BuildClient.UpdateRetentionSettings(ctx, build.UpdateRetentionSettingsArgs{
+		Project:     converter.String(projectID),
+		UpdateModel: expandProjectRetentionSettings(d),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(" creating/updating project retention settings: %v", err))
+	}
+	d.SetId(projectID)
+	return resourceProjectRetentionSettingsRead(ctx, d, m)
+}
+
+func resourceProjectRetentionSettingsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Id()
+	settings, err := clients.BuildClient.GetRetentionSettings(ctx, build.GetRetentionSettingsArgs{
+		Project: converter.String(projectID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf(" reading project retention settings: %v", err))
+	}
+
+	d.Set("project_id", projectID)
+	flattenProjectRetentionSettings(d, settings)
+	return nil
+}
+
+// Retention settings cannot be removed from a project, so the last applied settings remain in effect
+func resourceProjectRetentionSettingsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	log.Printf("[INFO] Retention settings of project %s cannot be removed; removing them from the state only", d.Id())
+	d.SetId("")
+	return nil
+}
+
+func expandProjectRetentionSettings(d *schema.ResourceData) *build.UpdateProjectRetentionSettingModel {
+	model := &build.UpdateProjectRetentionSettingModel{}
+
+	rawConfig := d.GetRawConfig().AsValueMap()
+	if v := rawConfig["days_to_keep_runs"]; !v.IsNull() {
+		model.RunRetention = &build.UpdateRetentionSettingModel{Value: converter.Int(d.Get("days_to_keep_runs").(int))}
+	}
+	if v := rawConfig["days_to_keep_artifacts"]; !v.IsNull() {
+		model.ArtifactsRetention = &build.UpdateRetentionSettingModel{Value: converter.Int(d.Get("days_to_keep_artifacts").(int))}
+	}
+	if v := rawConfig["days_to_keep_pull_request_runs"]; !v.IsNull() {
+		model.PullRequestRunRetention = &build.UpdateRetentionSettingModel{Value: converter.Int(d.Get("days_to_keep_pull_request_runs").(int))}
+	}
+	if v := rawConfig["runs_to_retain_per_protected_branch"]; !v.IsNull() {
+		model.RetainRunsPerProtectedBranch = &build.UpdateRetentionSettingModel{Value: converter.Int(d.Get("runs_to_retain_per_protected_branch").(int))}
+	}
+	return model
+}
+
+func flattenProjectRetentionSettings(d *schema.ResourceData, settings *build.ProjectRetentionSetting) {
+	if settings.PurgeRuns != nil {
+		d.Set("days_to_keep_runs", converter.ToInt(settings.PurgeRuns.Value, 0))
+	}
+	if settings.PurgeArtifacts != nil {
+		d.Set("days_to_keep_artifacts", converter.ToInt(settings.PurgeArtifacts.Value, 0))
+	}
+	if settings.PurgePullRequestRuns != nil {
+		d.Set("days_to_keep_pull_request_runs", converter.ToInt(settings.PurgePullRequestRuns.Value, 0))
+	}
+	if settings.RetainRunsPerProtectedBranch != nil {
+		d.Set("runs_to_retain_per_protected_branch", converter.ToInt(settings.RetainRunsPerProtectedBranch.Value, 0))
+	}
+}
@@ -0,0 +1,59 @@
+//go:build (all || resource_project_retention_settings) && !exclude_resource_project_retention_settings
+// +build all resource_project_retention_settings
+// +build !exclude_resource_project_retention_settings
+
+package core
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectRetentionSettings_Read_NotFoundClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{BuildClient: buildClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectRetentionSettings().Schema, nil)
+	resourceData.SetId(projectID)
+
+	buildClient.
+		EXPECT().
+		GetRetentionSettings(clients.Ctx, build.GetRetentionSettingsArgs{
+			Project: converter.String(projectID),
+		}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	diags := resourceProjectRetentionSettingsRead(clients.Ctx, resourceData, clients)
+	require.False(t, diags.HasError())
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestProjectRetentionSettings_Flatten(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceProjectRetentionSettings().Schema, nil)
+	flattenProjectRetentionSettings(resourceData, &build.ProjectRetentionSetting{
+		PurgeRuns:                    &build.RetentionSetting{Value: converter.Int(30)},
+		PurgeArtifacts:               &build.RetentionSetting{Value: converter.Int(20)},
+		PurgePullRequestRuns:         &build.RetentionSetting{Value: converter.Int(10)},
+		RetainRunsPerProtectedBranch: &build.RetentionSetting{Value: converter.Int(3)},
+	})
+
+	require.Equal(t, 30, resourceData.Get("days_to_keep_runs"))
+	require.Equal(t, 20, resourceData.Get("days_to_keep_artifacts"))
+	require.Equal(t, 10, resourceData.Get("days_to_keep_pull_request_runs"))
+	require.Equal(t, 3, resourceData.Get("runs_to_retain_per_protected_branch"))
+}
@@ -0,0 +1,295 @@
+//go:build (all || core || resource_project) && !exclude_resource_project
+// +build all core resource_project
+// +build !exclude_resource_project
+
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var projectTestAgileProcessID = uuid.New()
+var projectTestInheritedProcessID = uuid.New()
+
+var projectTestProcesses = []core.Process{
+	{
+		Id:        &projectTestAgileProcessID,
+		Name:      converter.String("Agile"),
+		IsDefault: converter.Bool(true),
+	},
+	{
+		Id:        &projectTestInheritedProcessID,
+		Name:      converter.String("Custom Agile"),
+		IsDefault: converter.Bool(false),
+	},
+}
+
+// verifies that a project is migrated to the process with the given name
+func TestProject_MigrateProcess_UsesProcessByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, WorkItemTrackingClient: witClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	coreClient.
+		EXPECT().
+		GetProcesses(clients.Ctx, core.GetProcessesArgs{}).
+		Return(&projectTestProcesses, nil).
+		Times(1)
+	witClient.
+		EXPECT().
+		MigrateProjectsProcess(clients.Ctx, workitemtracking.MigrateProjectsProcessArgs{
+			NewProcess: &workitemtracking.ProcessIdModel{TypeId: &projectTestInheritedProcessID},
+			Project:    converter.String(projectID),
+		}).
+		Return(&workitemtracking.ProcessMigrationResultModel{}, nil).
+		Times(1)
+
+	err := migrateProjectProcess(clients, projectID, "custom agile")
+	require.Nil(t, err)
+}
+
+// verifies that an empty process name migrates the project to the organization default process
+func TestProject_MigrateProcess_EmptyNameUsesDefaultProcess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, WorkItemTrackingClient: witClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	coreClient.
+		EXPECT().
+		GetProcesses(clients.Ctx, core.GetProcessesArgs{}).
+		Return(&projectTestProcesses, nil).
+		Times(1)
+	witClient.
+		EXPECT().
+		MigrateProjectsProcess(clients.Ctx, workitemtracking.MigrateProjectsProcessArgs{
+			NewProcess: &workitemtracking.ProcessIdModel{TypeId: &projectTestAgileProcessID},
+			Project:    converter.String(projectID),
+		}).
+		Return(&workitemtracking.ProcessMigrationResultModel{}, nil).
+		Times(1)
+
+	err := migrateProjectProcess(clients, projectID, "")
+	require.Nil(t, err)
+}
+
+// verifies that an error of an incompatible migration is not swallowed
+func TestProject_MigrateProcess_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, WorkItemTrackingClient: witClient, Ctx: context.Background()}
+
+	coreClient.
+		EXPECT().
+		GetProcesses(clients.Ctx, core.GetProcessesArgs{}).
+		Return(&projectTestProcesses, nil).
+		Times(1)
+	witClient.
+		EXPECT().
+		MigrateProjectsProcess(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("MigrateProjectsProcess() Failed")).
+		Times(1)
+
+	err := migrateProjectProcess(clients, uuid.New().String(), "Custom Agile")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "MigrateProjectsProcess() Failed")
+}
+
+var projectTestScrumProcessID = uuid.New()
+
+var projectTestProcessInfos = []workitemtrackingprocess.ProcessInfo{
+	{
+		TypeId:            &projectTestAgileProcessID,
+		Name:              converter.String("Agile"),
+		IsDefault:         converter.Bool(true),
+		CustomizationType: &workitemtrackingprocess.CustomizationTypeValues.System,
+	},
+	{
+		TypeId:              &projectTestInheritedProcessID,
+		Name:                converter.String("Custom Agile"),
+		IsDefault:           converter.Bool(false),
+		CustomizationType:   &workitemtrackingprocess.CustomizationTypeValues.Inherited,
+		ParentProcessTypeId: &projectTestAgileProcessID,
+	},
+	{
+		TypeId:            &projectTestScrumProcessID,
+		Name:              converter.String("Scrum"),
+		IsDefault:         converter.Bool(false),
+		CustomizationType: &workitemtrackingprocess.CustomizationTypeValues.System,
+	},
+}
+
+func projectProcessChangeDiff(t *testing.T, oldTemplate string, newTemplate string) *terraform.InstanceDiff {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{WorkItemTrackingProcessClient: processClient, Ctx: context.Background()}
+
+	processClient.
+		EXPECT().
+		GetListOfProcesses(clients.Ctx, workitemtrackingprocess.GetListOfProcessesArgs{}).
+		Return(&projectTestProcessInfos, nil).
+		Times(1)
+
+	projectID := uuid.New().String()
+	state := &terraform.InstanceState{
+		ID: projectID,
+		Attributes: map[string]string{
+			"id":                 projectID,
+			"name":               "project",
+			"description":        "",
+			"visibility":         "private",
+			"version_control":    "Git",
+			"work_item_template": oldTemplate,
+		},
+	}
+	diff, err := ResourceProject().Diff(clients.Ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":               "project",
+		"work_item_template": newTemplate,
+	}), clients)
+	require.Nil(t, err)
+	require.NotNil(t, diff)
+	return diff
+}
+
+// verifies that a project is migrated in place to a process derived from the same system process
+func TestProject_CustomizeDiff_MigratesBetweenProcessesOfSameSystemProcess(t *testing.T) {
+	require.False(t, projectProcessChangeDiff(t, "Agile", "Custom Agile").RequiresNew())
+	require.False(t, projectProcessChangeDiff(t, "Custom Agile", "Agile").RequiresNew())
+}
+
+// verifies that a project is recreated when the process is derived from a different system process
+func TestProject_CustomizeDiff_RecreatesForProcessOfOtherSystemProcess(t *testing.T) {
+	require.True(t, projectProcessChangeDiff(t, "Custom Agile", "Scrum").RequiresNew())
+	require.True(t, projectProcessChangeDiff(t, "Agile", "Unknown").RequiresNew())
+}
+
+// verifies that description and visibility of a project are updated in place
+func TestProject_Schema_DescriptionAndVisibilityDoNotForceNew(t *testing.T) {
+	projectSchema := ResourceProject().Schema
+	require.False(t, projectSchema["description"].ForceNew)
+	require.False(t, projectSchema["visibility"].ForceNew)
+}
+
+// verifies that the state of a project is reported while it is not yet well formed
+func TestProject_StateRefresh_ReportsProjectState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, Ctx: context.Background()}
+
+	projectID := uuid.New()
+	gomock.InOrder(
+		coreClient.
+			EXPECT().
+			GetProject(clients.Ctx, core.GetProjectArgs{
+				ProjectId:           converter.String(projectID.String()),
+				IncludeCapabilities: converter.Bool(false),
+				IncludeHistory:      converter.Bool(false),
+			}).
+			Return(&core.TeamProject{Id: &projectID, State: &core.ProjectStateValues.CreatePending}, nil),
+		coreClient.
+			EXPECT().
+			GetProject(clients.Ctx, gomock.Any()).
+			Return(&core.TeamProject{Id: &projectID, State: &core.ProjectStateValues.WellFormed}, nil),
+	)
+
+	refresh := projectStateRefreshFunc(clients, projectID.String())
+
+	_, state, err := refresh()
+	require.Nil(t, err)
+	require.Equal(t, "createPending", state)
+
+	project, state, err := refresh()
+	require.Nil(t, err)
+	require.Equal(t, "wellFormed", state)
+	require.Equal(t, projectID, *project.(*core.TeamProject).Id)
+}
+
+// verifies that a project which can not be found yet is reported as not found
+func TestProject_StateRefresh_HandlesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, Ctx: context.Background()}
+
+	coreClient.
+		EXPECT().
+		GetProject(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	project, state, err := projectStateRefreshFunc(clients, "project")()
+	require.Nil(t, err)
+	require.Nil(t, project)
+	require.Empty(t, state)
+}
+
+// verifies that the avatar of a project is decoded before it is uploaded
+func TestProject_SetAvatar_DecodesImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	image := []byte("avatar")
+	coreClient.
+		EXPECT().
+		SetProjectAvatar(clients.Ctx, core.SetProjectAvatarArgs{
+			ProjectId:  converter.String(projectID),
+			AvatarBlob: &core.ProjectAvatar{Image: &image},
+		}).
+		Return(nil).
+		Times(1)
+
+	err := setProjectAvatar(clients, projectID, "YXZhdGFy")
+	require.Nil(t, err)
+}
+
+// verifies that an error setting the avatar of a project is not swallowed
+func TestProject_SetAvatar_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{CoreClient: coreClient, Ctx: context.Background()}
+
+	coreClient.
+		EXPECT().
+		SetProjectAvatar(clients.Ctx, gomock.Any()).
+		Return(errors.New("SetProjectAvatar() Failed")).
+		Times(1)
+
+	err := setProjectAvatar(clients, uuid.New().String(), "YXZhdGFy")
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "SetProjectAvatar() Failed")
+}
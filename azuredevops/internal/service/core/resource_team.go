@@ -22,11 +22,13 @@ import (
 
 func ResourceTeam() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceTeamCreate,
-		Read:     resourceTeamRead,
-		Update:   resourceTeamUpdate,
-		Delete:   resourceTeamDelete,
-		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Create: resourceTeamCreate,
+		Read:   resourceTeamRead,
+		Update: resourceTeamUpdate,
+		Delete: resourceTeamDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTeamImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -278,7 +280,7 @@ func resourceTeamDelete(d *schema.ResourceData, m interface{}) error {
 		TeamId:    &teamID,
 	})
 
-	if err != nil {
+	if err != nil && !utils.ResponseWasNotFound(err) {
 		return err
 	}
 
@@ -286,6 +288,37 @@ func resourceTeamDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceTeamImport imports a team by an ID that looks like one of the following:
+//
+//	<project ID or name>/<team ID>
+//	<project ID or name>/<team name>
+func resourceTeamImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	projectNameOrID, teamNameOrID, err := tfhelper.ParseImportedName(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the resource ID from the Terraform resource data: %v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(projectNameOrID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := clients.CoreClient.GetTeam(clients.Ctx, core.GetTeamArgs{
+		ProjectId:      converter.String(projectID),
+		TeamId:         converter.String(teamNameOrID),
+		ExpandIdentity: converter.Bool(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(" looking up team %s in project %s: %+v", teamNameOrID, projectID, err)
+	}
+
+	d.Set("project_id", projectID)
+	d.SetId(team.Id.String())
+	return []*schema.ResourceData{d}, nil
+}
+
 func waitForTeamStateChange(d *schema.ResourceData, clients *client.AggregatedClient, projectID string, teamID string, name *string, description *string, memberSet *schema.Set, administratorSet *schema.Set) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"Waiting"},
@@ -438,23 +471,37 @@ func getIdentitiesFromSubjects(clients *client.AggregatedClient, query linq.Quer
 		return &[]identity.Identity{}, nil
 	}
 
-	discriptors := query.
-		Aggregate(func(r interface{}, i interface{}) interface{} {
-			if r.(string) == "" {
-				return i
-			}
-			return r.(string) + "," + i.(string)
-		}).(string)
+	var subjectDescriptors []string
+	query.ToSlice(&subjectDescriptors)
 
-	idlist, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
-		SubjectDescriptors: converter.String(discriptors),
+	return readIdentitiesInBatches(clients, subjectDescriptors, func(descriptors string) identity.ReadIdentitiesArgs {
+		return identity.ReadIdentitiesArgs{
+			SubjectDescriptors: converter.String(descriptors),
+		}
 	})
+}
 
-	if err != nil {
-		return nil, err
-	}
+// maximum number of descriptors resolved by a single identity lookup; the descriptors are passed
+// in the query string, so large teams would otherwise exceed the URL length limit
+var identityLookupBatchSize = 100
 
-	return idlist, err
+func readIdentitiesInBatches(clients *client.AggregatedClient, descriptors []string, lookupArgs func(descriptors string) identity.ReadIdentitiesArgs) (*[]identity.Identity, error) {
+	identities := []identity.Identity{}
+	for start := 0; start < len(descriptors); start += identityLookupBatchSize {
+		end := start + identityLookupBatchSize
+		if end > len(descriptors) {
+			end = len(descriptors)
+		}
+
+		batch, err := clients.IdentityClient.ReadIdentities(clients.Ctx, lookupArgs(strings.Join(descriptors[start:end], ",")))
+		if err != nil {
+			return nil, err
+		}
+		if batch != nil {
+			identities = append(identities, *batch...)
+		}
+	}
+	return &identities, nil
 }
 
 func removeTeamMembers(clients *client.AggregatedClient, team *core.WebApiTeam, query linq.Query) error {
@@ -626,16 +673,10 @@ func readSubjectDescriptors(clients *client.AggregatedClient, members *[]string)
 		return set, nil
 	}
 
-	descriptors := linq.From(*members).
-		Aggregate(func(r interface{}, i interface{}) interface{} {
-			if r.(string) == "" {
-				return i
-			}
-			return r.(string) + "," + i.(string)
-		}).(string)
-
-	identities, err := clients.IdentityClient.ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
-		Descriptors: &descriptors,
+	identities, err := readIdentitiesInBatches(clients, *members, func(descriptors string) identity.ReadIdentitiesArgs {
+		return identity.ReadIdentitiesArgs{
+			Descriptors: &descriptors,
+		}
 	})
 
 	if err != nil {
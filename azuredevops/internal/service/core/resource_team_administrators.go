@@ -24,6 +24,9 @@ func ResourceTeamAdministrators() *schema.Resource {
 		Read:   resourceTeamAdministratorsRead,
 		Update: resourceTeamAdministratorsUpdate,
 		Delete: resourceTeamAdministratorsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTeamAdministratorsImport,
+		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -220,3 +223,34 @@ func resourceTeamAdministratorsDelete(d *schema.ResourceData, m interface{}) err
 	d.SetId("")
 	return nil
 }
+
+// resourceTeamAdministratorsImport imports all administrators of a team by an ID that looks like
+// <project ID or name>/<team ID or name>. The administrators are managed authoritatively after the import.
+func resourceTeamAdministratorsImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	projectNameOrID, teamNameOrID, err := tfhelper.ParseImportedName(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the resource ID from the Terraform resource data: %v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(projectNameOrID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := clients.CoreClient.GetTeam(clients.Ctx, core.GetTeamArgs{
+		ProjectId:      converter.String(projectID),
+		TeamId:         converter.String(teamNameOrID),
+		ExpandIdentity: converter.Bool(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(" looking up team %s in project %s: %+v", teamNameOrID, projectID, err)
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("team_id", team.Id.String())
+	d.Set("mode", "overwrite")
+	d.SetId(fmt.Sprintf("%d", rand.Int()))
+	return []*schema.ResourceData{d}, nil
+}
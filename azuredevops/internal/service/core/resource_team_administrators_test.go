@@ -14,6 +14,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -155,3 +156,43 @@ func TestTeamAdministrators_Delete_DontSwallowError(t *testing.T) {
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), errMsg)
 }
+
+func TestTeamAdministrators_Import_ByTeamName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	testProjectID := uuid.New()
+	testTeamID := uuid.New()
+	testTeamName := "@@TEST TEAM@@"
+
+	coreClient.
+		EXPECT().
+		GetTeam(clients.Ctx, core.GetTeamArgs{
+			ProjectId:      converter.String(testProjectID.String()),
+			TeamId:         converter.String(testTeamName),
+			ExpandIdentity: converter.Bool(false),
+		}).
+		Return(&core.WebApiTeam{
+			Id:        &testTeamID,
+			Name:      converter.String(testTeamName),
+			ProjectId: &testProjectID,
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamAdministrators().Schema, nil)
+	resourceData.SetId(fmt.Sprintf("%s/%s", testProjectID.String(), testTeamName))
+
+	result, err := resourceTeamAdministratorsImport(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.NotEmpty(t, resourceData.Id())
+	require.Equal(t, testProjectID.String(), resourceData.Get("project_id"))
+	require.Equal(t, testTeamID.String(), resourceData.Get("team_id"))
+	require.Equal(t, "overwrite", resourceData.Get("mode"))
+}
@@ -0,0 +1,293 @@
+package core
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceTeamIterations schema and implementation for the iterations (sprints) of a team
+func ResourceTeamIterations() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTeamIterationsCreateOrUpdate,
+		Read:   resourceTeamIterationsRead,
+		Update: resourceTeamIterationsCreateOrUpdate,
+		Delete: resourceTeamIterationsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTeamIterationsImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"team_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"iteration_ids": {
+				Type: schema.TypeSet,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsUUID,
+				},
+				Optional: true,
+				Set:      schema.HashString,
+			},
+			"backlog_iteration_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"default_iteration_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IsUUID,
+				ConflictsWith: []string{"default_iteration_macro"},
+			},
+			"default_iteration_macro": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.StringIsNotWhiteSpace,
+				ConflictsWith: []string{"default_iteration_id"},
+			},
+		},
+	}
+}
+
+func resourceTeamIterationsCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	// team iterations must be located below the backlog iteration, hence the backlog
+	// iteration is updated before the iterations are assigned
+	if v, ok := d.GetOk("backlog_iteration_id"); ok && d.HasChange("backlog_iteration_id") {
+		backlogIterationID, err := uuid.Parse(v.(string))
+		if err != nil {
+			return fmt.Errorf(" parsing backlog iteration ID %s: %+v", v.(string), err)
+		}
+		if err := updateTeamSettings(clients, projectID, teamID, &work.TeamSettingsPatch{
+			BacklogIteration: &backlogIterationID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("iteration_ids") {
+		oldData, newData := d.GetChange("iteration_ids")
+		oldSet := oldData.(*schema.Set)
+		newSet := newData.(*schema.Set)
+
+		for _, v := range newSet.Difference(oldSet).List() {
+			iterationID, err := uuid.Parse(v.(string))
+			if err != nil {
+				return fmt.Errorf(" parsing iteration ID %s: %+v", v.(string), err)
+			}
+			_, err = clients.WorkClient.PostTeamIteration(clients.Ctx, work.PostTeamIterationArgs{
+				Project: converter.String(projectID),
+				Team:    converter.String(teamID),
+				Iteration: &work.TeamSettingsIteration{
+					Id: &iterationID,
+				},
+			})
+			if err != nil {
+				return fmt.Errorf(" adding iteration %s to team %s: %+v", iterationID, teamID, err)
+			}
+		}
+
+		if err := removeTeamIterations(clients, projectID, teamID, oldSet.Difference(newSet)); err != nil {
+			return err
+		}
+	}
+
+	// the default iteration has to be one of the team iterations
+	if d.HasChanges("default_iteration_id", "default_iteration_macro") {
+		patch := &work.TeamSettingsPatch{}
+		defaultIterationID, defaultIterationMacro := getConfiguredDefaultIteration(d)
+		if defaultIterationMacro != "" {
+			patch.DefaultIterationMacro = converter.String(defaultIterationMacro)
+		} else if defaultIterationID != "" {
+			iterationID, err := uuid.Parse(defaultIterationID)
+			if err != nil {
+				return fmt.Errorf(" parsing default iteration ID %s: %+v", defaultIterationID, err)
+			}
+			patch.DefaultIteration = &iterationID
+		}
+		if patch.DefaultIterationMacro != nil || patch.DefaultIteration != nil {
+			if err := updateTeamSettings(clients, projectID, teamID, patch); err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.Id() == "" {
+		d.SetId(fmt.Sprintf("%d", rand.Int()))
+	}
+	return resourceTeamIterationsRead(d, m)
+}
+
+func resourceTeamIterationsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	iterations, err := clients.WorkClient.GetTeamIterations(clients.Ctx, work.GetTeamIterationsArgs{
+		Project: converter.String(projectID),
+		Team:    converter.String(teamID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading iterations of team %s: %+v", teamID, err)
+	}
+
+	settings, err := clients.WorkClient.GetTeamSettings(clients.Ctx, work.GetTeamSettingsArgs{
+		Project: converter.String(projectID),
+		Team:    converter.String(teamID),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading settings of team %s: %+v", teamID, err)
+	}
+	if settings == nil {
+		settings = &work.TeamSetting{}
+	}
+
+	iterationIDs := make([]interface{}, 0)
+	if iterations != nil {
+		for _, iteration := range *iterations {
+			if iteration.Id != nil {
+				iterationIDs = append(iterationIDs, iteration.Id.String())
+			}
+		}
+	}
+	d.Set("iteration_ids", schema.NewSet(schema.HashString, iterationIDs))
+
+	backlogIterationID := ""
+	if settings.BacklogIteration != nil && settings.BacklogIteration.Id != nil {
+		backlogIterationID = settings.BacklogIteration.Id.String()
+	}
+	d.Set("backlog_iteration_id", backlogIterationID)
+
+	defaultIterationMacro := converter.ToString(settings.DefaultIterationMacro, "")
+	defaultIterationID := ""
+	if defaultIterationMacro == "" && settings.DefaultIteration != nil && settings.DefaultIteration.Id != nil {
+		defaultIterationID = settings.DefaultIteration.Id.String()
+	}
+	d.Set("default_iteration_id", defaultIterationID)
+	d.Set("default_iteration_macro", defaultIterationMacro)
+	return nil
+}
+
+func resourceTeamIterationsDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	projectID := d.Get("project_id").(string)
+	teamID := d.Get("team_id").(string)
+
+	if err := removeTeamIterations(clients, projectID, teamID, d.Get("iteration_ids").(*schema.Set)); err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceTeamIterationsImport imports the iterations of a team by an ID that looks like
+// <project ID or name>/<team ID or name>
+func resourceTeamIterationsImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	projectNameOrID, teamNameOrID, err := tfhelper.ParseImportedName(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the resource ID from the Terraform resource data: %v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(projectNameOrID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	team, err := clients.CoreClient.GetTeam(clients.Ctx, core.GetTeamArgs{
+		ProjectId:      converter.String(projectID),
+		TeamId:         converter.String(teamNameOrID),
+		ExpandIdentity: converter.Bool(false),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(" looking up team %s in project %s: %+v", teamNameOrID, projectID, err)
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("team_id", team.Id.String())
+	d.SetId(fmt.Sprintf("%d", rand.Int()))
+	return []*schema.ResourceData{d}, nil
+}
+
+// getConfiguredDefaultIteration returns the default iteration ID and macro. Both are computed, so the one which is
+// not configured keeps the value of the state when switching between them and is ignored.
+func getConfiguredDefaultIteration(d *schema.ResourceData) (string, string) {
+	defaultIterationID := d.Get("default_iteration_id").(string)
+	defaultIterationMacro := d.Get("default_iteration_macro").(string)
+
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return defaultIterationID, defaultIterationMacro
+	}
+	if rawConfig.GetAttr("default_iteration_id").IsNull() {
+		defaultIterationID = ""
+	}
+	if rawConfig.GetAttr("default_iteration_macro").IsNull() {
+		defaultIterationMacro = ""
+	}
+	return defaultIterationID, defaultIterationMacro
+}
+
+func removeTeamIterations(clients *client.AggregatedClient, projectID string, teamID string, iterationIDs *schema.Set) error {
+	for _, v := range iterationIDs.List() {
+		iterationID, err := uuid.Parse(v.(string))
+		if err != nil {
+			return fmt.Errorf(" parsing iteration ID %s: %+v", v.(string), err)
+		}
+		err = clients.WorkClient.DeleteTeamIteration(clients.Ctx, work.DeleteTeamIterationArgs{
+			Project: converter.String(projectID),
+			Team:    converter.String(teamID),
+			Id:      &iterationID,
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf(" removing iteration %s from team %s: %+v", iterationID, teamID, err)
+		}
+	}
+	return nil
+}
+
+func updateTeamSettings(clients *client.AggregatedClient, projectID string, teamID string, patch *work.TeamSettingsPatch) error {
+	_, err := clients.WorkClient.UpdateTeamSettings(clients.Ctx, work.UpdateTeamSettingsArgs{
+		Project:           converter.String(projectID),
+		Team:              converter.String(teamID),
+		TeamSettingsPatch: patch,
+	})
+	if err != nil {
+		return fmt.Errorf(" updating settings of team %s: %+v", teamID, err)
+	}
+	return nil
+}
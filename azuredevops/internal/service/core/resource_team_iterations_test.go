@@ -0,0 +1,246 @@
+//go:build (all || core || resource_team_iterations) && !exclude_resource_team_iterations
+// +build all core resource_team_iterations
+// +build !exclude_resource_team_iterations
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/work"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamIterations_Create_AssignsIterationsAndSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	projectID := uuid.New().String()
+	teamID := uuid.New().String()
+	backlogIterationID := uuid.New()
+	iterationID := uuid.New()
+
+	gomock.InOrder(
+		workClient.
+			EXPECT().
+			UpdateTeamSettings(clients.Ctx, work.UpdateTeamSettingsArgs{
+				Project: converter.String(projectID),
+				Team:    converter.String(teamID),
+				TeamSettingsPatch: &work.TeamSettingsPatch{
+					BacklogIteration: &backlogIterationID,
+				},
+			}).
+			Return(&work.TeamSetting{}, nil).
+			Times(1),
+		workClient.
+			EXPECT().
+			PostTeamIteration(clients.Ctx, work.PostTeamIterationArgs{
+				Project: converter.String(projectID),
+				Team:    converter.String(teamID),
+				Iteration: &work.TeamSettingsIteration{
+					Id: &iterationID,
+				},
+			}).
+			Return(&work.TeamSettingsIteration{Id: &iterationID}, nil).
+			Times(1),
+		workClient.
+			EXPECT().
+			UpdateTeamSettings(clients.Ctx, work.UpdateTeamSettingsArgs{
+				Project: converter.String(projectID),
+				Team:    converter.String(teamID),
+				TeamSettingsPatch: &work.TeamSettingsPatch{
+					DefaultIterationMacro: converter.String("@currentIteration"),
+				},
+			}).
+			Return(&work.TeamSetting{}, nil).
+			Times(1),
+	)
+
+	workClient.
+		EXPECT().
+		GetTeamIterations(clients.Ctx, work.GetTeamIterationsArgs{
+			Project: converter.String(projectID),
+			Team:    converter.String(teamID),
+		}).
+		Return(&[]work.TeamSettingsIteration{{Id: &iterationID}}, nil).
+		Times(1)
+
+	workClient.
+		EXPECT().
+		GetTeamSettings(clients.Ctx, work.GetTeamSettingsArgs{
+			Project: converter.String(projectID),
+			Team:    converter.String(teamID),
+		}).
+		Return(&work.TeamSetting{
+			BacklogIteration:      &work.TeamSettingsIteration{Id: &backlogIterationID},
+			DefaultIteration:      &work.TeamSettingsIteration{Id: &iterationID},
+			DefaultIterationMacro: converter.String("@currentIteration"),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamIterations().Schema, map[string]interface{}{
+		"project_id":              projectID,
+		"team_id":                 teamID,
+		"iteration_ids":           []interface{}{iterationID.String()},
+		"backlog_iteration_id":    backlogIterationID.String(),
+		"default_iteration_macro": "@currentIteration",
+	})
+
+	err := resourceTeamIterationsCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.NotEmpty(t, resourceData.Id())
+	require.ElementsMatch(t, []interface{}{iterationID.String()}, resourceData.Get("iteration_ids").(*schema.Set).List())
+	require.Equal(t, backlogIterationID.String(), resourceData.Get("backlog_iteration_id"))
+	require.Equal(t, "", resourceData.Get("default_iteration_id"))
+	require.Equal(t, "@currentIteration", resourceData.Get("default_iteration_macro"))
+}
+
+func TestTeamIterations_Update_SwitchesFromDefaultIterationMacroToID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	projectID := uuid.New().String()
+	teamID := uuid.New().String()
+	defaultIterationID := uuid.New()
+
+	// default_iteration_macro is no longer configured, but keeps the value of the state as it is computed
+	r := ResourceTeamIterations()
+	state := &terraform.InstanceState{
+		ID: "1",
+		Attributes: map[string]string{
+			"project_id":              projectID,
+			"team_id":                 teamID,
+			"default_iteration_macro": "@currentIteration",
+		},
+	}
+	diff, err := r.Diff(clients.Ctx, state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":           projectID,
+		"team_id":              teamID,
+		"default_iteration_id": defaultIterationID.String(),
+	}), clients)
+	require.Nil(t, err)
+	diff.RawConfig = cty.ObjectVal(map[string]cty.Value{
+		"project_id":              cty.StringVal(projectID),
+		"team_id":                 cty.StringVal(teamID),
+		"default_iteration_id":    cty.StringVal(defaultIterationID.String()),
+		"default_iteration_macro": cty.NullVal(cty.String),
+	})
+	resourceData, err := schema.InternalMap(r.Schema).Data(state, diff)
+	require.Nil(t, err)
+	require.Equal(t, "@currentIteration", resourceData.Get("default_iteration_macro"))
+
+	workClient.
+		EXPECT().
+		UpdateTeamSettings(clients.Ctx, work.UpdateTeamSettingsArgs{
+			Project: converter.String(projectID),
+			Team:    converter.String(teamID),
+			TeamSettingsPatch: &work.TeamSettingsPatch{
+				DefaultIteration: &defaultIterationID,
+			},
+		}).
+		Return(&work.TeamSetting{}, nil).
+		Times(1)
+	workClient.
+		EXPECT().
+		GetTeamIterations(clients.Ctx, gomock.Any()).
+		Return(&[]work.TeamSettingsIteration{{Id: &defaultIterationID}}, nil).
+		Times(1)
+	workClient.
+		EXPECT().
+		GetTeamSettings(clients.Ctx, gomock.Any()).
+		Return(&work.TeamSetting{
+			DefaultIteration: &work.TeamSettingsIteration{Id: &defaultIterationID},
+		}, nil).
+		Times(1)
+
+	err = resourceTeamIterationsCreateOrUpdate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, defaultIterationID.String(), resourceData.Get("default_iteration_id"))
+	require.Equal(t, "", resourceData.Get("default_iteration_macro"))
+}
+
+func TestTeamIterations_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	workClient.
+		EXPECT().
+		PostTeamIteration(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@PostTeamIteration@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamIterations().Schema, map[string]interface{}{
+		"project_id":    uuid.New().String(),
+		"team_id":       uuid.New().String(),
+		"iteration_ids": []interface{}{uuid.New().String()},
+	})
+
+	err := resourceTeamIterationsCreateOrUpdate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@PostTeamIteration@@failed@@")
+}
+
+func TestTeamIterations_Delete_IgnoresRemovedIterations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	workClient := azdosdkmocks.NewMockWorkClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkClient: workClient,
+		Ctx:        context.Background(),
+	}
+
+	projectID := uuid.New().String()
+	teamID := uuid.New().String()
+	iterationID := uuid.New()
+
+	workClient.
+		EXPECT().
+		DeleteTeamIteration(clients.Ctx, work.DeleteTeamIterationArgs{
+			Project: converter.String(projectID),
+			Team:    converter.String(teamID),
+			Id:      &iterationID,
+		}).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeamIterations().Schema, map[string]interface{}{
+		"project_id":    projectID,
+		"team_id":       teamID,
+		"iteration_ids": []interface{}{iterationID.String()},
+	})
+	resourceData.SetId("1")
+
+	err := resourceTeamIterationsDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
@@ -10,10 +10,13 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/ahmetb/go-linq"
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/core"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
@@ -155,3 +158,51 @@ func TestTeamMembers_Delete_DontSwallowError(t *testing.T) {
 	require.NotNil(t, err)
 	require.Contains(t, err.Error(), errMsg)
 }
+
+func TestTeamMembers_AddMembers_ReadsIdentitiesInBatches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	batchSize := identityLookupBatchSize
+	identityLookupBatchSize = 2
+	defer func() { identityLookupBatchSize = batchSize }()
+
+	testTeamID := uuid.New()
+	team := &core.WebApiTeam{
+		Id:   &testTeamID,
+		Name: converter.String("@@TEST TEAM@@"),
+	}
+	newIdentity := func(descriptor string) identity.Identity {
+		id := uuid.New()
+		return identity.Identity{Id: &id, SubjectDescriptor: converter.String(descriptor)}
+	}
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SubjectDescriptors: converter.String("aad.1,aad.2"),
+		}).
+		Return(&[]identity.Identity{newIdentity("aad.1"), newIdentity("aad.2")}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SubjectDescriptors: converter.String("aad.3"),
+		}).
+		Return(&[]identity.Identity{newIdentity("aad.3")}, nil).
+		Times(1)
+	identityClient.
+		EXPECT().
+		AddMember(clients.Ctx, gomock.Any()).
+		Return(converter.Bool(true), nil).
+		Times(3)
+
+	err := addTeamMembers(clients, team, linq.From([]string{"aad.1", "aad.2", "aad.3"}))
+	require.Nil(t, err)
+}
@@ -386,3 +386,74 @@ func TestTeam_Update_DoesNotSwallowError(t *testing.T) {
 	require.Contains(t, err.Error(), "@@GetTeam@@failed@@")
 	require.NotZero(t, resourceData.Id())
 }
+
+func TestTeam_Import_ByTeamName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	testProjectID := uuid.New()
+	testTeamName := "@@TEST TEAM@@"
+	testTeamID := uuid.New()
+
+	coreClient.
+		EXPECT().
+		GetTeam(clients.Ctx, core.GetTeamArgs{
+			ProjectId:      converter.String(testProjectID.String()),
+			TeamId:         converter.String(testTeamName),
+			ExpandIdentity: converter.Bool(false),
+		}).
+		Return(&core.WebApiTeam{
+			Id:        &testTeamID,
+			Name:      converter.String(testTeamName),
+			ProjectId: &testProjectID,
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeam().Schema, nil)
+	resourceData.SetId(fmt.Sprintf("%s/%s", testProjectID.String(), testTeamName))
+
+	result, err := resourceTeamImport(resourceData, clients)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, testTeamID.String(), resourceData.Id())
+	require.Equal(t, testProjectID.String(), resourceData.Get("project_id"))
+}
+
+func TestTeam_Delete_HandlesNotFoundCorrectly(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	coreClient := azdosdkmocks.NewMockCoreClient(ctrl)
+	clients := &client.AggregatedClient{
+		CoreClient: coreClient,
+		Ctx:        context.Background(),
+	}
+
+	testProjectID := uuid.New()
+	testTeamID := uuid.New()
+
+	coreClient.
+		EXPECT().
+		DeleteTeam(clients.Ctx, core.DeleteTeamArgs{
+			ProjectId: converter.String(testProjectID.String()),
+			TeamId:    converter.String(testTeamID.String()),
+		}).
+		Return(azuredevops.WrappedError{
+			StatusCode: converter.Int(http.StatusNotFound),
+		}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTeam().Schema, nil)
+	resourceData.SetId(testTeamID.String())
+	resourceData.Set("project_id", testProjectID.String())
+
+	err := resourceTeamDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Zero(t, resourceData.Id())
+}
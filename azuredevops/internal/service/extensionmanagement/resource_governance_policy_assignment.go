@@ -0,0 +1,282 @@
+package extensionmanagement
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/extensiondata"
+)
+
+// Properties maintained by the data storage service, which are not part of the managed document
+const (
+	documentIDProperty   = "id"
+	documentETagProperty = "__etag"
+)
+
+type extensionDocumentArgs struct {
+	PublisherName  string
+	ExtensionName  string
+	ScopeType      string
+	ScopeValue     string
+	CollectionName string
+	DocumentID     string
+}
+
+// ResourceGovernancePolicyAssignment schema and implementation for extension settings documents
+func ResourceGovernancePolicyAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGovernancePolicyAssignmentCreate,
+		Read:   resourceGovernancePolicyAssignmentRead,
+		Update: resourceGovernancePolicyAssignmentUpdate,
+		Delete: resourceGovernancePolicyAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceGovernancePolicyAssignmentImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"publisher_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"extension_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"scope_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Default",
+				ValidateFunc: validation.StringInSlice([]string{"Default", "User"}, false),
+			},
+			"scope_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Current",
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"collection_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"document_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"document": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateExtensionDocument,
+				DiffSuppressFunc: structure.SuppressJsonDiff,
+			},
+		},
+	}
+}
+
+func resourceGovernancePolicyAssignmentCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	args := getExtensionDocumentArgs(d)
+
+	document, err := expandExtensionDocument(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.ExtensionDataClient.CreateDocument(clients.Ctx, extensiondata.CreateDocumentArgs{
+		Collection: args.collection(),
+		Document:   document,
+	})
+	if err != nil {
+		return fmt.Errorf(" creating document %s in collection %s of extension %s.%s: %+v", args.DocumentID, args.CollectionName, args.PublisherName, args.ExtensionName, err)
+	}
+
+	d.SetId(args.id())
+	return resourceGovernancePolicyAssignmentRead(d, m)
+}
+
+func resourceGovernancePolicyAssignmentRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	args := getExtensionDocumentArgs(d)
+
+	document, err := clients.ExtensionDataClient.GetDocument(clients.Ctx, extensiondata.GetDocumentArgs{
+		Collection: args.collection(),
+		DocumentId: converter.String(args.DocumentID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading document %s in collection %s of extension %s.%s: %+v", args.DocumentID, args.CollectionName, args.PublisherName, args.ExtensionName, err)
+	}
+
+	return flattenExtensionDocument(d, document)
+}
+
+func resourceGovernancePolicyAssignmentUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	args := getExtensionDocumentArgs(d)
+
+	document, err := expandExtensionDocument(d)
+	if err != nil {
+		return err
+	}
+
+	// without an etag the document is replaced regardless of changes made outside of Terraform
+	_, err = clients.ExtensionDataClient.SetDocument(clients.Ctx, extensiondata.SetDocumentArgs{
+		Collection: args.collection(),
+		Document:   document,
+	})
+	if err != nil {
+		return fmt.Errorf(" updating document %s in collection %s of extension %s.%s: %+v", args.DocumentID, args.CollectionName, args.PublisherName, args.ExtensionName, err)
+	}
+
+	return resourceGovernancePolicyAssignmentRead(d, m)
+}
+
+func resourceGovernancePolicyAssignmentDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	args := getExtensionDocumentArgs(d)
+
+	err := clients.ExtensionDataClient.DeleteDocument(clients.Ctx, extensiondata.DeleteDocumentArgs{
+		Collection: args.collection(),
+		DocumentId: converter.String(args.DocumentID),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting document %s in collection %s of extension %s.%s: %+v", args.DocumentID, args.CollectionName, args.PublisherName, args.ExtensionName, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceGovernancePolicyAssignmentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	args, err := parseExtensionDocumentID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("publisher_name", args.PublisherName)
+	d.Set("extension_name", args.ExtensionName)
+	d.Set("scope_type", args.ScopeType)
+	d.Set("scope_value", args.ScopeValue)
+	d.Set("collection_name", args.CollectionName)
+	d.Set("document_id", args.DocumentID)
+	return []*schema.ResourceData{d}, nil
+}
+
+func getExtensionDocumentArgs(d *schema.ResourceData) *extensionDocumentArgs {
+	return &extensionDocumentArgs{
+		PublisherName:  d.Get("publisher_name").(string),
+		ExtensionName:  d.Get("extension_name").(string),
+		ScopeType:      d.Get("scope_type").(string),
+		ScopeValue:     d.Get("scope_value").(string),
+		CollectionName: d.Get("collection_name").(string),
+		DocumentID:     d.Get("document_id").(string),
+	}
+}
+
+// id returns the resource ID in the form <publisher>/<extension>/<scope type>/<scope value>/<collection>/<document ID>
+func (args *extensionDocumentArgs) id() string {
+	return strings.Join([]string{args.PublisherName, args.ExtensionName, args.ScopeType, args.ScopeValue, args.CollectionName, args.DocumentID}, "/")
+}
+
+// collection returns the collection of the document
+func (args *extensionDocumentArgs) collection() *extensiondata.Collection {
+	return &extensiondata.Collection{
+		PublisherName:  converter.String(args.PublisherName),
+		ExtensionName:  converter.String(args.ExtensionName),
+		ScopeType:      converter.String(args.ScopeType),
+		ScopeValue:     converter.String(args.ScopeValue),
+		CollectionName: converter.String(args.CollectionName),
+	}
+}
+
+// parseExtensionDocumentID parses a resource ID. The document ID is the last part and may contain slashes.
+func parseExtensionDocumentID(id string) (*extensionDocumentArgs, error) {
+	parts := strings.SplitN(id, "/", 6)
+	if len(parts) != 6 {
+		return nil, fmt.Errorf(" unexpected format of ID (%s), expected publisher/extension/scopeType/scopeValue/collection/documentId", id)
+	}
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			return nil, fmt.Errorf(" unexpected format of ID (%s), expected publisher/extension/scopeType/scopeValue/collection/documentId", id)
+		}
+	}
+	return &extensionDocumentArgs{
+		PublisherName:  parts[0],
+		ExtensionName:  parts[1],
+		ScopeType:      parts[2],
+		ScopeValue:     parts[3],
+		CollectionName: parts[4],
+		DocumentID:     parts[5],
+	}, nil
+}
+
+// validateExtensionDocument checks that the document is a JSON object which does not contain
+// any of the properties maintained by the service
+func validateExtensionDocument(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(v), &document); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON object: %+v", k, err)}
+	}
+	if document == nil {
+		return nil, []error{fmt.Errorf("%q must be a JSON object", k)}
+	}
+	for _, property := range []string{documentIDProperty, documentETagProperty} {
+		if _, ok := document[property]; ok {
+			return nil, []error{fmt.Errorf("%q must not contain the property %q, it is maintained by the service", k, property)}
+		}
+	}
+	return nil, nil
+}
+
+// From TF to API
+func expandExtensionDocument(d *schema.ResourceData) (map[string]interface{}, error) {
+	var document map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("document").(string)), &document); err != nil {
+		return nil, fmt.Errorf(" parsing document: %+v", err)
+	}
+	document[documentIDProperty] = d.Get("document_id").(string)
+	return document, nil
+}
+
+// API to TF
+func flattenExtensionDocument(d *schema.ResourceData, document map[string]interface{}) error {
+	if id, ok := document[documentIDProperty].(string); ok {
+		d.Set("document_id", id)
+	}
+	delete(document, documentIDProperty)
+	delete(document, documentETagProperty)
+
+	documentJSON, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf(" marshalling document: %+v", err)
+	}
+	normalized, err := structure.NormalizeJsonString(string(documentJSON))
+	if err != nil {
+		return fmt.Errorf(" normalizing document: %+v", err)
+	}
+	d.Set("document", normalized)
+	return nil
+}
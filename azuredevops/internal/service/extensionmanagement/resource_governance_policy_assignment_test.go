@@ -0,0 +1,191 @@
+//go:build (all || resource_governance_policy_assignment) && !exclude_resource_governance_policy_assignment
+// +build all resource_governance_policy_assignment
+// +build !exclude_resource_governance_policy_assignment
+
+package extensionmanagement
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/extensiondata"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGovernancePolicyAssignment_ID_Roundtrip(t *testing.T) {
+	args := &extensionDocumentArgs{
+		PublisherName:  "publisher",
+		ExtensionName:  "extension",
+		ScopeType:      "Default",
+		ScopeValue:     "Current",
+		CollectionName: "policies",
+		DocumentID:     "team/require-approvals",
+	}
+
+	parsed, err := parseExtensionDocumentID(args.id())
+	require.Nil(t, err)
+	require.Equal(t, args, parsed)
+}
+
+func TestGovernancePolicyAssignment_ID_RejectsIncompleteIDs(t *testing.T) {
+	for _, id := range []string{"", "publisher/extension/Default/Current/policies", "publisher/extension/Default/Current//doc"} {
+		_, err := parseExtensionDocumentID(id)
+		require.NotNil(t, err, "ID %q should be rejected", id)
+	}
+}
+
+func TestGovernancePolicyAssignment_Validate_Document(t *testing.T) {
+	cases := map[string]bool{
+		`{"enabled": true}`:                  true,
+		`{}`:                                 true,
+		`[1, 2]`:                             false,
+		`"text"`:                             false,
+		`null`:                               false,
+		`{"enabled": `:                       false,
+		`{"id": "doc", "enabled": true}`:     false,
+		`{"__etag": 1, "enabled": true}`:     false,
+		`{"nested": {"id": "allowed here"}}`: true,
+	}
+
+	for document, valid := range cases {
+		_, errs := validateExtensionDocument(document, "document")
+		require.Equal(t, valid, len(errs) == 0, "document %s", document)
+	}
+}
+
+func TestGovernancePolicyAssignment_ExpandFlatten_Roundtrip(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceGovernancePolicyAssignment().Schema, map[string]interface{}{
+		"publisher_name":  "publisher",
+		"extension_name":  "extension",
+		"collection_name": "policies",
+		"document_id":     "require-approvals",
+		"document":        `{"enabled": true, "approvers": 2}`,
+	})
+
+	document, err := expandExtensionDocument(d)
+	require.Nil(t, err)
+	require.Equal(t, "require-approvals", document[documentIDProperty])
+
+	// the service adds an etag to every stored document
+	document[documentETagProperty] = float64(3)
+
+	d2 := schema.TestResourceDataRaw(t, ResourceGovernancePolicyAssignment().Schema, nil)
+	require.Nil(t, flattenExtensionDocument(d2, document))
+	require.Equal(t, "require-approvals", d2.Get("document_id"))
+	require.Equal(t, `{"approvers":2,"enabled":true}`, d2.Get("document"))
+}
+
+var testExtensionDocumentCollection = &extensiondata.Collection{
+	PublisherName:  converter.String("publisher"),
+	ExtensionName:  converter.String("extension"),
+	ScopeType:      converter.String("Default"),
+	ScopeValue:     converter.String("Current"),
+	CollectionName: converter.String("policies"),
+}
+
+func getGovernancePolicyAssignmentResourceData(t *testing.T) *schema.ResourceData {
+	return schema.TestResourceDataRaw(t, ResourceGovernancePolicyAssignment().Schema, map[string]interface{}{
+		"publisher_name":  "publisher",
+		"extension_name":  "extension",
+		"collection_name": "policies",
+		"document_id":     "require-approvals",
+		"document":        `{"enabled": true}`,
+	})
+}
+
+func TestGovernancePolicyAssignment_Create_CreatesDocument(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionDataClient := azdosdkmocks.NewMockExtensiondataClient(ctrl)
+	clients := &client.AggregatedClient{ExtensionDataClient: extensionDataClient, Ctx: context.Background()}
+
+	extensionDataClient.
+		EXPECT().
+		CreateDocument(clients.Ctx, extensiondata.CreateDocumentArgs{
+			Collection: testExtensionDocumentCollection,
+			Document:   map[string]interface{}{"enabled": true, "id": "require-approvals"},
+		}).
+		Return(map[string]interface{}{"enabled": true, "id": "require-approvals", "__etag": float64(1)}, nil).
+		Times(1)
+	extensionDataClient.
+		EXPECT().
+		GetDocument(clients.Ctx, extensiondata.GetDocumentArgs{
+			Collection: testExtensionDocumentCollection,
+			DocumentId: converter.String("require-approvals"),
+		}).
+		Return(map[string]interface{}{"enabled": true, "id": "require-approvals", "__etag": float64(1)}, nil).
+		Times(1)
+
+	d := getGovernancePolicyAssignmentResourceData(t)
+	require.Nil(t, resourceGovernancePolicyAssignmentCreate(d, clients))
+	require.Equal(t, "publisher/extension/Default/Current/policies/require-approvals", d.Id())
+	require.Equal(t, `{"enabled":true}`, d.Get("document"))
+}
+
+func TestGovernancePolicyAssignment_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionDataClient := azdosdkmocks.NewMockExtensiondataClient(ctrl)
+	clients := &client.AggregatedClient{ExtensionDataClient: extensionDataClient, Ctx: context.Background()}
+
+	extensionDataClient.
+		EXPECT().
+		SetDocument(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("SetDocument() Failed")).
+		Times(1)
+
+	d := getGovernancePolicyAssignmentResourceData(t)
+	err := resourceGovernancePolicyAssignmentUpdate(d, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "SetDocument() Failed")
+}
+
+func TestGovernancePolicyAssignment_Read_HandlesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionDataClient := azdosdkmocks.NewMockExtensiondataClient(ctrl)
+	clients := &client.AggregatedClient{ExtensionDataClient: extensionDataClient, Ctx: context.Background()}
+
+	extensionDataClient.
+		EXPECT().
+		GetDocument(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	d := getGovernancePolicyAssignmentResourceData(t)
+	d.SetId("publisher/extension/Default/Current/policies/require-approvals")
+	require.Nil(t, resourceGovernancePolicyAssignmentRead(d, clients))
+	require.Empty(t, d.Id())
+}
+
+func TestGovernancePolicyAssignment_Delete_IgnoresNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	extensionDataClient := azdosdkmocks.NewMockExtensiondataClient(ctrl)
+	clients := &client.AggregatedClient{ExtensionDataClient: extensionDataClient, Ctx: context.Background()}
+
+	extensionDataClient.
+		EXPECT().
+		DeleteDocument(clients.Ctx, extensiondata.DeleteDocumentArgs{
+			Collection: testExtensionDocumentCollection,
+			DocumentId: converter.String("require-approvals"),
+		}).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	d := getGovernancePolicyAssignmentResourceData(t)
+	d.SetId("publisher/extension/Default/Current/policies/require-approvals")
+	require.Nil(t, resourceGovernancePolicyAssignmentDelete(d, clients))
+	require.Empty(t, d.Id())
+}
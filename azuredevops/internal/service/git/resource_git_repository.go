@@ -372,11 +372,13 @@ func updateGitRepository(clients *client.AggregatedClient, repository *git.GitRe
 func resourceGitRepositoryDelete(d *schema.ResourceData, m interface{}) error {
 	repoID := d.Id()
 	clients := m.(*client.AggregatedClient)
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Deleting Git repository [%s]", repoID)
 	err := deleteGitRepository(clients, repoID)
 	if err != nil {
 		return err
 	}
 
+	log.Printf("[TRACE] plugin.terraform-provider-azuredevops: Deleted Git repository [%s]", repoID)
 	d.SetId("")
 	return nil
 }
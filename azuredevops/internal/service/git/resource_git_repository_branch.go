@@ -25,6 +25,7 @@ func ResourceGitRepositoryBranch() *schema.Resource {
 	return &schema.Resource{
 		CreateContext: resourceGitRepositoryBranchCreate,
 		ReadContext:   resourceGitRepositoryBranchRead,
+		UpdateContext: resourceGitRepositoryBranchUpdate,
 		DeleteContext: resourceGitRepositoryBranchDelete,
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -60,6 +61,14 @@ func ResourceGitRepositoryBranch() *schema.Resource {
 				ValidateFunc:  validation.StringIsNotEmpty,
 				ConflictsWith: []string{"ref_branch", "ref_tag"},
 			},
+			"adopt_existing": {
+				// only evaluated on create, hence changes to existing branches are ignored
+				Type:     schema.TypeBool,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
 			"last_commit_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -125,11 +134,30 @@ func resourceGitRepositoryBranchCreate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
+	oldObjectId := "0000000000000000000000000000000000000000"
+	if d.Get("adopt_existing").(bool) {
+		gotBranch, err := clients.GitReposClient.GetBranch(clients.Ctx, git.GetBranchArgs{
+			RepositoryId: converter.String(repoId),
+			Name:         converter.String(shortBranchName),
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return diag.FromErr(fmt.Errorf("Error reading branch %q: %w", shortBranchName, err))
+		}
+		if err == nil && gotBranch != nil && gotBranch.Commit != nil && gotBranch.Commit.CommitId != nil {
+			if *gotBranch.Commit.CommitId == newObjectId {
+				d.SetId(fmt.Sprintf("%s:%s", repoId, shortBranchName))
+				return resourceGitRepositoryBranchRead(ctx, d, m)
+			}
+			// the existing branch is moved to the configured ref
+			oldObjectId = *gotBranch.Commit.CommitId
+		}
+	}
+
 	_, err := updateRefs(clients, git.UpdateRefsArgs{
 		RefUpdates: &[]git.GitRefUpdate{{
 			Name:        &longBranchName,
 			NewObjectId: &newObjectId,
-			OldObjectId: converter.String("0000000000000000000000000000000000000000"),
+			OldObjectId: &oldObjectId,
 		}},
 		RepositoryId: converter.String(repoId),
 	})
@@ -175,6 +203,12 @@ func resourceGitRepositoryBranchRead(ctx context.Context, d *schema.ResourceData
 	return nil
 }
 
+// resourceGitRepositoryBranchUpdate has nothing to update, as adopt_existing is only evaluated on create and all
+// other attributes replace the branch.
+func resourceGitRepositoryBranchUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return resourceGitRepositoryBranchRead(ctx, d, m)
+}
+
 func resourceGitRepositoryBranchDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
 	clients := m.(*client.AggregatedClient)
 
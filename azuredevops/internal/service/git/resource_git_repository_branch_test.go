@@ -10,6 +10,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -161,6 +162,78 @@ func TestGitRepositoryBranch_Create(t *testing.T) {
 			},
 			diag.FromErr(fmt.Errorf("Error creating branch \"a-branch\": Error got invalid GitRefUpdate.UpdateStatus: invalidRefName")),
 		},
+		{
+			"When adopt_existing is set and the branch points to the ref, the branch is adopted without update",
+			func(g *azdosdkmocks.MockGitClient) args {
+				clients := &client.AggregatedClient{
+					GitReposClient: g,
+					Ctx:            context.Background(),
+				}
+				d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, nil)
+				fakeCommitId := "a-commit"
+				d.Set("ref_commit_id", fakeCommitId)
+				d.Set("name", "a-branch")
+				d.Set("repository_id", "a-repo")
+				d.Set("adopt_existing", true)
+
+				g.EXPECT().
+					GetBranch(clients.Ctx, git.GetBranchArgs{
+						RepositoryId: converter.String("a-repo"),
+						Name:         converter.String("a-branch"),
+					}).
+					Return(&git.GitBranchStats{
+						Commit: &git.GitCommitRef{CommitId: &fakeCommitId},
+					}, nil).
+					Times(2)
+				return args{
+					context.Background(),
+					d,
+					clients,
+				}
+			},
+			nil,
+		},
+		{
+			"When adopt_existing is set and the branch points elsewhere, the branch is moved to the ref",
+			func(g *azdosdkmocks.MockGitClient) args {
+				clients := &client.AggregatedClient{
+					GitReposClient: g,
+					Ctx:            context.Background(),
+				}
+				d := schema.TestResourceDataRaw(t, ResourceGitRepositoryBranch().Schema, nil)
+				fakeCommitId := "a-commit"
+				d.Set("ref_commit_id", fakeCommitId)
+				d.Set("name", "a-branch")
+				d.Set("repository_id", "a-repo")
+				d.Set("adopt_existing", true)
+
+				g.EXPECT().
+					GetBranch(clients.Ctx, git.GetBranchArgs{
+						RepositoryId: converter.String("a-repo"),
+						Name:         converter.String("a-branch"),
+					}).
+					Return(&git.GitBranchStats{
+						Commit: &git.GitCommitRef{CommitId: converter.String("another-commit")},
+					}, nil)
+
+				g.EXPECT().
+					UpdateRefs(clients.Ctx, git.UpdateRefsArgs{
+						RefUpdates: &[]git.GitRefUpdate{{
+							Name:        converter.String(withPrefix("refs/heads/", "a-branch")),
+							NewObjectId: &fakeCommitId,
+							OldObjectId: converter.String("another-commit"),
+						}},
+						RepositoryId: converter.String("a-repo"),
+					}).
+					Return(nil, fmt.Errorf("an-error"))
+				return args{
+					context.Background(),
+					d,
+					clients,
+				}
+			},
+			diag.FromErr(fmt.Errorf("Error creating branch \"a-branch\": an-error")),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -303,3 +376,51 @@ func TestGitRepositoryBranch_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestGitRepositoryBranch_AdoptExisting_ExistingStateHasEmptyPlan(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "a-repo:a-branch",
+		Attributes: map[string]string{
+			"id":             "a-repo:a-branch",
+			"name":           "a-branch",
+			"repository_id":  "5f5b6b24-2b4e-4a6b-9c4b-6e7b8f1b2c3d",
+			"ref_branch":     "main",
+			"last_commit_id": "a-commit",
+		},
+	}
+
+	for _, config := range []map[string]interface{}{
+		{
+			"name":          "a-branch",
+			"repository_id": "5f5b6b24-2b4e-4a6b-9c4b-6e7b8f1b2c3d",
+			"ref_branch":    "main",
+		},
+		{
+			"name":           "a-branch",
+			"repository_id":  "5f5b6b24-2b4e-4a6b-9c4b-6e7b8f1b2c3d",
+			"ref_branch":     "main",
+			"adopt_existing": false,
+		},
+	} {
+		diff, err := ResourceGitRepositoryBranch().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		if err != nil {
+			t.Fatalf("SimpleDiff() error = %v", err)
+		}
+		if diff != nil && (diff.RequiresNew() || len(diff.Attributes) > 0) {
+			t.Errorf("SimpleDiff() = %v, want an empty plan", diff.Attributes)
+		}
+	}
+
+	diff, err := ResourceGitRepositoryBranch().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "a-branch",
+		"repository_id":  "5f5b6b24-2b4e-4a6b-9c4b-6e7b8f1b2c3d",
+		"ref_branch":     "main",
+		"adopt_existing": true,
+	}), nil)
+	if err != nil {
+		t.Fatalf("SimpleDiff() error = %v", err)
+	}
+	if diff != nil && (diff.RequiresNew() || len(diff.Attributes) > 0) {
+		t.Errorf("SimpleDiff() = %v, want an empty plan when adopt_existing is set on an existing branch", diff.Attributes)
+	}
+}
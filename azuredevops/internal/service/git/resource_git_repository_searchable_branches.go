@@ -0,0 +1,317 @@
+package git
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// The branches indexed by code search are stored in the repository settings policy of a repository
+var gitRepositorySettingsPolicyType = uuid.MustParse("0517f88d-4ec5-4343-9d26-9930ebd53069")
+
+// Code search indexes the default branch and up to five additional branches of a repository
+const maxSearchableBranches = 5
+
+type repositorySettingsPolicySettings struct {
+	SearchBranches []string `json:"searchBranches,omitempty"`
+	Scopes         []struct {
+		RepositoryID string `json:"repositoryId,omitempty"`
+	} `json:"scope"`
+}
+
+// ResourceGitRepositorySearchableBranches schema to manage the branches of a git repository indexed by code search
+func ResourceGitRepositorySearchableBranches() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceGitRepositorySearchableBranchesCreate,
+		ReadContext:   resourceGitRepositorySearchableBranchesRead,
+		UpdateContext: resourceGitRepositorySearchableBranchesUpdate,
+		DeleteContext: resourceGitRepositorySearchableBranchesDelete,
+		Importer:      tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"repository_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"branches": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: maxSearchableBranches,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateShortBranchName,
+				},
+			},
+		},
+	}
+}
+
+func resourceGitRepositorySearchableBranchesCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	repoID := d.Get("repository_id").(string)
+
+	// the settings of a repository are created by the service as soon as they are changed in the UI,
+	// so an existing configuration is taken over instead of creating a second one
+	existing, err := findRepositorySettingsPolicy(clients, projectID, repoID)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error looking up settings of repository %s: %w", repoID, err))
+	}
+
+	policyConfig := expandRepositorySettingsPolicy(d, existing)
+	var savedPolicy *policy.PolicyConfiguration
+	if existing != nil {
+		savedPolicy, err = clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+			ConfigurationId: existing.Id,
+			Configuration:   policyConfig,
+			Project:         converter.String(projectID),
+		})
+	} else {
+		savedPolicy, err = clients.PolicyClient.CreatePolicyConfiguration(clients.Ctx, policy.CreatePolicyConfigurationArgs{
+			Configuration: policyConfig,
+			Project:       converter.String(projectID),
+		})
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting searchable branches of repository %s: %w", repoID, err))
+	}
+	if savedPolicy == nil || savedPolicy.Id == nil {
+		return diag.Errorf("Error setting searchable branches of repository %s: no configuration ID returned", repoID)
+	}
+
+	d.SetId(strconv.Itoa(*savedPolicy.Id))
+	return resourceGitRepositorySearchableBranchesRead(ctx, d, m)
+}
+
+func resourceGitRepositorySearchableBranchesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error converting policy ID to an integer: %w", err))
+	}
+
+	policyConfig, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+		Project:         converter.String(projectID),
+		ConfigurationId: converter.Int(policyID),
+	})
+	if utils.ResponseWasNotFound(err) || (policyConfig != nil && converter.ToBool(policyConfig.IsDeleted, false)) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading repository settings policy with ID %d: %w", policyID, err))
+	}
+
+	settings, err := parseRepositorySettingsPolicy(policyConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(settings.Scopes) > 0 && settings.Scopes[0].RepositoryID != "" {
+		d.Set("repository_id", settings.Scopes[0].RepositoryID)
+	}
+
+	branches := make([]string, len(settings.SearchBranches))
+	for i, branch := range settings.SearchBranches {
+		branches[i] = withoutPrefix(REF_BRANCH_PREFIX, branch)
+	}
+	d.Set("branches", branches)
+	return nil
+}
+
+func resourceGitRepositorySearchableBranchesUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error converting policy ID to an integer: %w", err))
+	}
+
+	existing, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+		Project:         converter.String(projectID),
+		ConfigurationId: &policyID,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading repository settings policy with ID %d: %w", policyID, err))
+	}
+
+	_, err = clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &policyID,
+		Configuration:   expandRepositorySettingsPolicy(d, existing),
+		Project:         converter.String(projectID),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error updating searchable branches of repository %s: %w", d.Get("repository_id").(string), err))
+	}
+
+	return resourceGitRepositorySearchableBranchesRead(ctx, d, m)
+}
+
+func resourceGitRepositorySearchableBranchesDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	policyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error converting policy ID to an integer: %w", err))
+	}
+
+	existing, err := clients.PolicyClient.GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+		Project:         converter.String(projectID),
+		ConfigurationId: &policyID,
+	})
+	if utils.ResponseWasNotFound(err) || (existing != nil && converter.ToBool(existing.IsDeleted, false)) {
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("Error reading repository settings policy with ID %d: %w", policyID, err))
+	}
+
+	// the configuration holds other settings of the repository as well, so only the searchable branches are
+	// cleared. Without them only the default branch of the repository is indexed.
+	policyConfig := mergeRepositorySettingsPolicy(existing, nil)
+	_, err = clients.PolicyClient.UpdatePolicyConfiguration(clients.Ctx, policy.UpdatePolicyConfigurationArgs{
+		ConfigurationId: &policyID,
+		Configuration:   policyConfig,
+		Project:         converter.String(projectID),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return diag.FromErr(fmt.Errorf("Error clearing searchable branches of repository settings policy with ID %d: %w", policyID, err))
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// findRepositorySettingsPolicy returns the settings policy of the repository, or nil if there is none
+func findRepositorySettingsPolicy(clients *client.AggregatedClient, projectID string, repoID string) (*policy.PolicyConfiguration, error) {
+	policies, err := clients.PolicyClient.GetPolicyConfigurations(clients.Ctx, policy.GetPolicyConfigurationsArgs{
+		Project:    converter.String(projectID),
+		PolicyType: &gitRepositorySettingsPolicyType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if policies == nil {
+		return nil, nil
+	}
+
+	for i, policyConfig := range policies.Value {
+		if converter.ToBool(policyConfig.IsDeleted, false) {
+			continue
+		}
+		settings, err := parseRepositorySettingsPolicy(&policyConfig)
+		if err != nil {
+			return nil, err
+		}
+		for _, scope := range settings.Scopes {
+			if strings.EqualFold(scope.RepositoryID, repoID) {
+				return &policies.Value[i], nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+func parseRepositorySettingsPolicy(policyConfig *policy.PolicyConfiguration) (*repositorySettingsPolicySettings, error) {
+	settings := repositorySettingsPolicySettings{}
+	settingsJSON, err := json.Marshal(policyConfig.Settings)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to marshal policy settings into JSON: %w", err)
+	}
+	if err := json.Unmarshal(settingsJSON, &settings); err != nil {
+		return nil, fmt.Errorf("Unable to parse repository settings policy: %w", err)
+	}
+	return &settings, nil
+}
+
+// From TF to API. The searchable branches are merged into the existing settings of the repository, if there are any.
+func expandRepositorySettingsPolicy(d *schema.ResourceData, existing *policy.PolicyConfiguration) *policy.PolicyConfiguration {
+	branches := d.Get("branches").([]interface{})
+	searchBranches := make([]string, len(branches))
+	for i, branch := range branches {
+		searchBranches[i] = withPrefix(REF_BRANCH_PREFIX, branch.(string))
+	}
+
+	if existing != nil {
+		return mergeRepositorySettingsPolicy(existing, searchBranches)
+	}
+	return &policy.PolicyConfiguration{
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(false),
+		Type: &policy.PolicyTypeRef{
+			Id: &gitRepositorySettingsPolicyType,
+		},
+		Settings: map[string]interface{}{
+			"searchBranches": searchBranches,
+			"scope": []map[string]interface{}{
+				{
+					"repositoryId": d.Get("repository_id").(string),
+				},
+			},
+		},
+	}
+}
+
+// mergeRepositorySettingsPolicy returns a copy of the existing configuration with the searchable branches replaced.
+// All other settings are kept as they are. If searchBranches is nil, the searchable branches are removed.
+func mergeRepositorySettingsPolicy(existing *policy.PolicyConfiguration, searchBranches []string) *policy.PolicyConfiguration {
+	settings := map[string]interface{}{}
+	if existingSettings, ok := existing.Settings.(map[string]interface{}); ok {
+		for key, value := range existingSettings {
+			settings[key] = value
+		}
+	}
+	if searchBranches != nil {
+		settings["searchBranches"] = searchBranches
+	} else {
+		delete(settings, "searchBranches")
+	}
+
+	return &policy.PolicyConfiguration{
+		Id:         existing.Id,
+		IsEnabled:  existing.IsEnabled,
+		IsBlocking: existing.IsBlocking,
+		Type: &policy.PolicyTypeRef{
+			Id: &gitRepositorySettingsPolicyType,
+		},
+		Settings: settings,
+	}
+}
+
+func validateShortBranchName(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if strings.TrimSpace(v) == "" {
+		return nil, []error{fmt.Errorf("%q must not be empty", k)}
+	}
+	if strings.HasPrefix(v, "refs/") {
+		return nil, []error{fmt.Errorf("%q must be a branch name in short format without refs/heads/ prefix, got: %q", k, v)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,229 @@
+//go:build (all || git || resource_git_repository_searchable_branches) && (!exclude_git || !exclude_resource_git_repository_searchable_branches)
+// +build all git resource_git_repository_searchable_branches
+// +build !exclude_git !exclude_resource_git_repository_searchable_branches
+
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func getSearchableBranchesResourceData(t *testing.T, projectID string, repoID string) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceGitRepositorySearchableBranches().Schema, nil)
+	resourceData.Set("project_id", projectID)
+	resourceData.Set("repository_id", repoID)
+	resourceData.Set("branches", []string{"main", "releases/v1"})
+	return resourceData
+}
+
+func getRepositorySettingsPolicy(id int, repoID string, branches ...string) *policy.PolicyConfiguration {
+	return &policy.PolicyConfiguration{
+		Id:        converter.Int(id),
+		IsDeleted: converter.Bool(false),
+		Settings: map[string]interface{}{
+			"searchBranches": branches,
+			"scope": []interface{}{
+				map[string]interface{}{"repositoryId": repoID},
+			},
+		},
+	}
+}
+
+// verifies that the branches are sent with their full ref name and read back in short format
+func TestGitRepositorySearchableBranches_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	repoID := uuid.New().String()
+	resourceData := getSearchableBranchesResourceData(t, projectID, repoID)
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, policy.GetPolicyConfigurationsArgs{
+			Project:    &projectID,
+			PolicyType: &gitRepositorySettingsPolicyType,
+		}).
+		Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{*getRepositorySettingsPolicy(1, uuid.New().String(), "refs/heads/main")},
+		}, nil)
+
+	policyClient.
+		EXPECT().
+		CreatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args policy.CreatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, projectID, *args.Project)
+			require.Equal(t, gitRepositorySettingsPolicyType, *args.Configuration.Type.Id)
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, []string{"refs/heads/main", "refs/heads/releases/v1"}, settings["searchBranches"])
+			require.Equal(t, []map[string]interface{}{{"repositoryId": repoID}}, settings["scope"])
+			return getRepositorySettingsPolicy(2, repoID, "refs/heads/main", "refs/heads/releases/v1"), nil
+		})
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, policy.GetPolicyConfigurationArgs{
+			Project:         &projectID,
+			ConfigurationId: converter.Int(2),
+		}).
+		Return(getRepositorySettingsPolicy(2, repoID, "refs/heads/main", "refs/heads/releases/v1"), nil)
+
+	require.Nil(t, resourceGitRepositorySearchableBranchesCreate(clients.Ctx, resourceData, clients))
+	require.Equal(t, "2", resourceData.Id())
+	require.Equal(t, []interface{}{"main", "releases/v1"}, resourceData.Get("branches"))
+}
+
+// verifies that existing settings of the repository are taken over instead of creating a second configuration
+func TestGitRepositorySearchableBranches_Create_UpdatesExistingSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	repoID := uuid.New().String()
+	resourceData := getSearchableBranchesResourceData(t, projectID, repoID)
+
+	existing := getRepositorySettingsPolicy(7, repoID, "refs/heads/develop")
+	existing.Settings.(map[string]interface{})["gvfsOnly"] = true
+	policyClient.
+		EXPECT().
+		GetPolicyConfigurations(clients.Ctx, gomock.Any()).
+		Return(&policy.GetPolicyConfigurationsResponseValue{
+			Value: []policy.PolicyConfiguration{*existing},
+		}, nil)
+
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, 7, *args.ConfigurationId)
+			require.Equal(t, 7, *args.Configuration.Id)
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.Equal(t, []string{"refs/heads/main", "refs/heads/releases/v1"}, settings["searchBranches"])
+			require.Equal(t, true, settings["gvfsOnly"])
+			require.Equal(t, existing.Settings.(map[string]interface{})["scope"], settings["scope"])
+			return getRepositorySettingsPolicy(7, repoID, "refs/heads/main", "refs/heads/releases/v1"), nil
+		})
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(getRepositorySettingsPolicy(7, repoID, "refs/heads/main", "refs/heads/releases/v1"), nil)
+
+	require.Nil(t, resourceGitRepositorySearchableBranchesCreate(clients.Ctx, resourceData, clients))
+	require.Equal(t, "7", resourceData.Id())
+}
+
+// verifies that a deleted configuration is removed from the state
+func TestGitRepositorySearchableBranches_Read_Deleted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := getSearchableBranchesResourceData(t, uuid.New().String(), uuid.New().String())
+	resourceData.SetId("3")
+
+	deletedPolicy := getRepositorySettingsPolicy(3, resourceData.Get("repository_id").(string))
+	deletedPolicy.IsDeleted = converter.Bool(true)
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(deletedPolicy, nil)
+
+	require.Nil(t, resourceGitRepositorySearchableBranchesRead(clients.Ctx, resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that a delete only clears the searchable branches and keeps the other settings of the repository
+func TestGitRepositorySearchableBranches_Delete_ClearsOnlySearchBranches(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := getSearchableBranchesResourceData(t, uuid.New().String(), uuid.New().String())
+	resourceData.SetId("3")
+
+	existing := getRepositorySettingsPolicy(3, resourceData.Get("repository_id").(string), "refs/heads/main")
+	existing.Settings.(map[string]interface{})["gvfsOnly"] = true
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(existing, nil)
+
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args policy.UpdatePolicyConfigurationArgs) (*policy.PolicyConfiguration, error) {
+			require.Equal(t, 3, *args.ConfigurationId)
+			settings := args.Configuration.Settings.(map[string]interface{})
+			require.NotContains(t, settings, "searchBranches")
+			require.Equal(t, true, settings["gvfsOnly"])
+			require.Equal(t, existing.Settings.(map[string]interface{})["scope"], settings["scope"])
+			return args.Configuration, nil
+		})
+
+	policyClient.
+		EXPECT().
+		DeletePolicyConfiguration(clients.Ctx, gomock.Any()).
+		Times(0)
+
+	require.Nil(t, resourceGitRepositorySearchableBranchesDelete(clients.Ctx, resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+// verifies that errors of the delete call are not swallowed
+func TestGitRepositorySearchableBranches_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	policyClient := azdosdkmocks.NewMockPolicyClient(ctrl)
+	clients := &client.AggregatedClient{PolicyClient: policyClient, Ctx: context.Background()}
+
+	resourceData := getSearchableBranchesResourceData(t, uuid.New().String(), uuid.New().String())
+	resourceData.SetId("3")
+
+	policyClient.
+		EXPECT().
+		GetPolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(getRepositorySettingsPolicy(3, resourceData.Get("repository_id").(string), "refs/heads/main"), nil)
+
+	policyClient.
+		EXPECT().
+		UpdatePolicyConfiguration(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("UpdatePolicyConfiguration() Failed"))
+
+	diags := resourceGitRepositorySearchableBranchesDelete(clients.Ctx, resourceData, clients)
+	require.True(t, diags.HasError())
+	require.Contains(t, diags[0].Summary, "UpdatePolicyConfiguration() Failed")
+}
+
+func TestGitRepositorySearchableBranches_ValidateBranchName(t *testing.T) {
+	_, errs := validateShortBranchName("releases/v1", "branches.0")
+	require.Empty(t, errs)
+
+	_, errs = validateShortBranchName("refs/heads/main", "branches.0")
+	require.Len(t, errs, 1)
+
+	_, errs = validateShortBranchName(" ", "branches.0")
+	require.Len(t, errs, 1)
+}
@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+)
+
+// The names of the groups Azure DevOps creates for every organization, keyed by attribute name
+var wellKnownCollectionGroups = map[string]string{
+	"project_collection_administrators":         "Project Collection Administrators",
+	"project_collection_valid_users":            "Project Collection Valid Users",
+	"project_collection_build_service_accounts": "Project Collection Build Service Accounts",
+}
+
+// The names of the groups Azure DevOps creates for every project, keyed by attribute name
+var wellKnownProjectGroups = map[string]string{
+	"project_administrators": "Project Administrators",
+	"project_valid_users":    "Project Valid Users",
+	"contributors":           "Contributors",
+	"readers":                "Readers",
+	"build_administrators":   "Build Administrators",
+}
+
+// buildServiceDomain is the domain of the service identities used by pipelines
+const buildServiceDomain = "Build"
+
+// DataWellKnownGroupDescriptors schema and implementation for well-known group descriptors data source
+func DataWellKnownGroupDescriptors() *schema.Resource {
+	resource := &schema.Resource{
+		Read: dataSourceWellKnownGroupDescriptorsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"build_service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+
+	for _, groups := range []map[string]string{wellKnownCollectionGroups, wellKnownProjectGroups} {
+		for attribute := range groups {
+			resource.Schema[attribute] = &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			}
+		}
+	}
+	return resource
+}
+
+func dataSourceWellKnownGroupDescriptorsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	collectionGroups, err := getGroupsForDescriptor(clients, "")
+	if err != nil {
+		return fmt.Errorf(" finding groups of the organization: %v", err)
+	}
+	if err := setWellKnownGroupDescriptors(d, collectionGroups, wellKnownCollectionGroups); err != nil {
+		return err
+	}
+
+	if projectID == "" {
+		for attribute := range wellKnownProjectGroups {
+			d.Set(attribute, "")
+		}
+		d.Set("build_service", "")
+		d.SetId("well-known-groups#")
+		return nil
+	}
+
+	projectDescriptor, err := getProjectDescriptor(clients, projectID)
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			return fmt.Errorf(" project with ID %s was not found: %v", projectID, err)
+		}
+		return fmt.Errorf(" finding descriptor for project with ID %s: %v", projectID, err)
+	}
+
+	projectGroups, err := getGroupsForDescriptor(clients, projectDescriptor)
+	if err != nil {
+		return fmt.Errorf(" finding groups for project with ID %s: %v", projectID, err)
+	}
+	if err := setWellKnownGroupDescriptors(d, projectGroups, wellKnownProjectGroups); err != nil {
+		return err
+	}
+
+	buildService, err := getBuildServiceDescriptor(clients, projectID)
+	if err != nil {
+		return fmt.Errorf(" finding build service identity for project with ID %s: %v", projectID, err)
+	}
+	if buildService == "" {
+		log.Printf("[WARN] Build service identity for project with ID %s not found", projectID)
+	}
+	d.Set("build_service", buildService)
+
+	d.SetId("well-known-groups#" + projectID)
+	return nil
+}
+
+func setWellKnownGroupDescriptors(d *schema.ResourceData, groups *[]graph.GraphGroup, wellKnownGroups map[string]string) error {
+	for attribute, name := range wellKnownGroups {
+		group := selectGroup(groups, name)
+		if group == nil || group.Descriptor == nil {
+			return fmt.Errorf(" could not find well-known group with name %s", name)
+		}
+		d.Set(attribute, *group.Descriptor)
+	}
+	return nil
+}
+
+// getBuildServiceDescriptor returns the descriptor of the "<project name> Build Service (<organization name>)"
+// identity. The service identity of a project has the ID of the project as principal name.
+func getBuildServiceDescriptor(clients *client.AggregatedClient, projectID string) (string, error) {
+	subjectTypes := []string{"svc"}
+
+	var currentToken string
+	for hasMore := true; hasMore; {
+		users, latestToken, err := getUsersWithContinuationToken(clients, &subjectTypes, currentToken)
+		if err != nil {
+			return "", err
+		}
+		for _, user := range users {
+			if user.Domain != nil && strings.EqualFold(*user.Domain, buildServiceDomain) &&
+				user.PrincipalName != nil && strings.EqualFold(*user.PrincipalName, projectID) &&
+				user.Descriptor != nil {
+				return *user.Descriptor, nil
+			}
+		}
+		currentToken = latestToken
+		hasMore = currentToken != ""
+	}
+	return "", nil
+}
@@ -0,0 +1,160 @@
+//go:build (all || core || data_sources || data_well_known_group_descriptors) && (!exclude_data_sources || !exclude_data_well_known_group_descriptors)
+// +build all core data_sources data_well_known_group_descriptors
+// +build !exclude_data_sources !exclude_data_well_known_group_descriptors
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/graph"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+const collectionGroupDomain = "vstfs:///Framework/IdentityDomain/00000000-0000-0000-0000-000000000000"
+
+func getWellKnownGroups(domain string, groups map[string]string) *graph.PagedGraphGroups {
+	var graphGroups []graph.GraphGroup
+	for attribute, name := range groups {
+		graphGroups = append(graphGroups, graph.GraphGroup{
+			Descriptor:  converter.String("vssgp." + attribute),
+			DisplayName: converter.String(name),
+			Domain:      converter.String(domain),
+		})
+	}
+	return &graph.PagedGraphGroups{
+		ContinuationToken: &[]string{""},
+		GraphGroups:       &graphGroups,
+	}
+}
+
+// verifies that the descriptors of the organization and project groups and of the build service are returned
+func TestWellKnownGroupDescriptorsDataSource_Read_Project(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	projectID := uuid.New()
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListGroups(clients.Ctx, graph.ListGroupsArgs{}).
+		Return(getWellKnownGroups(collectionGroupDomain, wellKnownCollectionGroups), nil)
+
+	projectDescriptor := converter.String("scp.project")
+	graphClient.
+		EXPECT().
+		GetDescriptor(clients.Ctx, graph.GetDescriptorArgs{StorageKey: &projectID}).
+		Return(&graph.GraphDescriptorResult{Value: projectDescriptor}, nil)
+	graphClient.
+		EXPECT().
+		ListGroups(clients.Ctx, graph.ListGroupsArgs{ScopeDescriptor: projectDescriptor}).
+		Return(getWellKnownGroups("vstfs:///Classification/TeamProject/"+projectID.String(), wellKnownProjectGroups), nil)
+
+	continuationToken := "continuation-token"
+	graphClient.
+		EXPECT().
+		ListUsers(clients.Ctx, graph.ListUsersArgs{SubjectTypes: &[]string{"svc"}}).
+		Return(&graph.PagedGraphUsers{
+			ContinuationToken: &[]string{continuationToken},
+			GraphUsers: &[]graph.GraphUser{
+				{
+					Descriptor:    converter.String("svc.collection"),
+					Domain:        converter.String("Build"),
+					PrincipalName: converter.String(uuid.New().String()),
+				},
+			},
+		}, nil)
+	graphClient.
+		EXPECT().
+		ListUsers(clients.Ctx, graph.ListUsersArgs{SubjectTypes: &[]string{"svc"}, ContinuationToken: &continuationToken}).
+		Return(&graph.PagedGraphUsers{
+			ContinuationToken: &[]string{""},
+			GraphUsers: &[]graph.GraphUser{
+				{
+					Descriptor:    converter.String("svc.project"),
+					Domain:        converter.String("Build"),
+					PrincipalName: converter.String(projectID.String()),
+				},
+			},
+		}, nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataWellKnownGroupDescriptors().Schema, nil)
+	resourceData.Set("project_id", projectID.String())
+	require.Nil(t, dataSourceWellKnownGroupDescriptorsRead(resourceData, clients))
+
+	for _, groups := range []map[string]string{wellKnownCollectionGroups, wellKnownProjectGroups} {
+		for attribute := range groups {
+			require.Equal(t, "vssgp."+attribute, resourceData.Get(attribute))
+		}
+	}
+	require.Equal(t, "svc.project", resourceData.Get("build_service"))
+}
+
+// verifies that only the organization groups are looked up if no project is specified
+func TestWellKnownGroupDescriptorsDataSource_Read_Organization(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListGroups(clients.Ctx, graph.ListGroupsArgs{}).
+		Return(getWellKnownGroups(collectionGroupDomain, wellKnownCollectionGroups), nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataWellKnownGroupDescriptors().Schema, nil)
+	require.Nil(t, dataSourceWellKnownGroupDescriptorsRead(resourceData, clients))
+	require.Equal(t, "vssgp.project_collection_administrators", resourceData.Get("project_collection_administrators"))
+	require.Equal(t, "", resourceData.Get("contributors"))
+	require.Equal(t, "", resourceData.Get("build_service"))
+}
+
+// verifies that a missing well-known group is reported
+func TestWellKnownGroupDescriptorsDataSource_Read_MissingGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListGroups(clients.Ctx, graph.ListGroupsArgs{}).
+		Return(getWellKnownGroups(collectionGroupDomain, map[string]string{
+			"project_collection_administrators": "Project Collection Administrators",
+		}), nil)
+
+	resourceData := schema.TestResourceDataRaw(t, DataWellKnownGroupDescriptors().Schema, nil)
+	err := dataSourceWellKnownGroupDescriptorsRead(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "could not find well-known group")
+}
+
+// verifies that errors of the group lookup are not swallowed
+func TestWellKnownGroupDescriptorsDataSource_DoesNotSwallowListGroupError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	graphClient := azdosdkmocks.NewMockGraphClient(ctrl)
+	clients := &client.AggregatedClient{GraphClient: graphClient, Ctx: context.Background()}
+
+	graphClient.
+		EXPECT().
+		ListGroups(clients.Ctx, graph.ListGroupsArgs{}).
+		Return(nil, errors.New("ListGroups() Failed"))
+
+	resourceData := schema.TestResourceDataRaw(t, DataWellKnownGroupDescriptors().Schema, nil)
+	err := dataSourceWellKnownGroupDescriptorsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "ListGroups() Failed")
+}
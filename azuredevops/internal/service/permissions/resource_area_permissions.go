@@ -13,10 +13,11 @@ import (
 // ResourceAreaPermissions schema and implementation for area permission resource
 func ResourceAreaPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAreaPermissionsCreateOrUpdate,
-		Read:   resourceAreaPermissionsRead,
-		Update: resourceAreaPermissionsCreateOrUpdate,
-		Delete: resourceAreaPermissionsDelete,
+		Create:        resourceAreaPermissionsCreateOrUpdate,
+		Read:          resourceAreaPermissionsRead,
+		Update:        resourceAreaPermissionsCreateOrUpdate,
+		Delete:        resourceAreaPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.CSS),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -3,6 +3,7 @@ package permissions
 import (
 	"fmt"
 	"log"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -18,10 +19,11 @@ import (
 // ResourceBuildDefinitionPermissions schema and implementation for build permission resource
 func ResourceBuildDefinitionPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceBuildDefinitionPermissionsCreateOrUpdate,
-		Read:   resourceBuildDefinitionPermissionsRead,
-		Update: resourceBuildDefinitionPermissionsCreateOrUpdate,
-		Delete: resourceBuildDefinitionPermissionsDelete,
+		Create:        resourceBuildDefinitionPermissionsCreateOrUpdate,
+		Read:          resourceBuildDefinitionPermissionsRead,
+		Update:        resourceBuildDefinitionPermissionsCreateOrUpdate,
+		Delete:        resourceBuildDefinitionPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.Build),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -30,9 +32,10 @@ func ResourceBuildDefinitionPermissions() *schema.Resource {
 				ForceNew:     true,
 			},
 			"build_definition_id": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[0-9]+$`), "must be the numeric ID of a build definition"),
 			},
 		}),
 	}
@@ -84,6 +87,11 @@ func resourceBuildDefinitionPermissionsDelete(d *schema.ResourceData, m interfac
 
 	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.Build, createBuildToken)
 	if err != nil {
+		// the permissions of a build definition are removed together with the definition
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
 		return err
 	}
 
@@ -119,7 +127,7 @@ func createBuildToken(d *schema.ResourceData, clients *client.AggregatedClient)
 	// The token format is Project_ID/Build_Definition_ID
 	// or Project_ID/Path/Build_Definition_ID
 
-	if *definition.Path != "\\" {
+	if definition.Path != nil && *definition.Path != "\\" {
 		transformedPath := transformPath(*definition.Path)
 
 		aclToken = fmt.Sprintf("%s/%s/%d", projectID.(string), transformedPath, buildDefinitionID)
@@ -14,6 +14,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -103,6 +104,43 @@ func TestBuildDefinitionPermissions_CreateBuildTokenWithPaths(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestBuildDefinitionPermissions_Delete_DefinitionNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buildClient := azdosdkmocks.NewMockBuildClient(ctrl)
+	clients := &client.AggregatedClient{
+		BuildClient:    buildClient,
+		SecurityClient: azdosdkmocks.NewMockSecurityClient(ctrl),
+		IdentityClient: azdosdkmocks.NewMockIdentityClient(ctrl),
+		Ctx:            context.Background(),
+	}
+
+	buildClient.EXPECT().
+		GetDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{
+			StatusCode: converter.Int(404),
+		}).
+		Times(1)
+
+	d := getBuildDefinitionPermissionsResource(t, buildPermissionsID, buildDefinitionID, "")
+	d.SetId("a-build-definition-permission")
+
+	err := resourceBuildDefinitionPermissionsDelete(d, clients)
+	assert.Nil(t, err)
+	assert.Empty(t, d.Id())
+}
+
+func TestBuildDefinitionPermissions_ValidateBuildDefinitionID(t *testing.T) {
+	validateFunc := ResourceBuildDefinitionPermissions().Schema["build_definition_id"].ValidateFunc
+
+	_, errs := validateFunc("42", "build_definition_id")
+	assert.Empty(t, errs)
+
+	_, errs = validateFunc("my-pipeline", "build_definition_id")
+	assert.NotEmpty(t, errs)
+}
+
 func getBuildDefinitionPermissionsResource(t *testing.T, projectID string, buildDefinitionID string, buildDefinitionPath string) *schema.ResourceData {
 	d := schema.TestResourceDataRaw(t, ResourceBuildDefinitionPermissions().Schema, nil)
 	if projectID != "" {
@@ -15,10 +15,11 @@ import (
 // ResourceBuildFolderPermissions schema and implementation for build permission resource
 func ResourceBuildFolderPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceBuildFolderPermissionsCreateOrUpdate,
-		Read:   resourceBuildFolderPermissionsRead,
-		Update: resourceBuildFolderPermissionsCreateOrUpdate,
-		Delete: resourceBuildFolderPermissionsDelete,
+		Create:        resourceBuildFolderPermissionsCreateOrUpdate,
+		Read:          resourceBuildFolderPermissionsRead,
+		Update:        resourceBuildFolderPermissionsCreateOrUpdate,
+		Delete:        resourceBuildFolderPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.Build),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -15,10 +15,11 @@ import (
 // ResourceGitPermissions schema and implementation for Git repository permission resource
 func ResourceGitPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceGitPermissionsCreateOrUpdate,
-		Read:   resourceGitPermissionsRead,
-		Update: resourceGitPermissionsCreateOrUpdate,
-		Delete: resourceGitPermissionsDelete,
+		Create:        resourceGitPermissionsCreateOrUpdate,
+		Read:          resourceGitPermissionsRead,
+		Update:        resourceGitPermissionsCreateOrUpdate,
+		Delete:        resourceGitPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.GitRepositories),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
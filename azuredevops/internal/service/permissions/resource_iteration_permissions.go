@@ -13,10 +13,11 @@ import (
 // ResourceIterationPermissions schema and implementation for iteration permission resource
 func ResourceIterationPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceIterationPermissionsCreateOrUpdate,
-		Read:   resourceIterationPermissionsRead,
-		Update: resourceIterationPermissionsCreateOrUpdate,
-		Delete: resourceIterationPermissionsDelete,
+		Create:        resourceIterationPermissionsCreateOrUpdate,
+		Read:          resourceIterationPermissionsRead,
+		Update:        resourceIterationPermissionsCreateOrUpdate,
+		Delete:        resourceIterationPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.Iteration),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
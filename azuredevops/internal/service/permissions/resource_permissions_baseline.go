@@ -0,0 +1,322 @@
+package permissions
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// baselineRule describes a single deny rule of the permissions baseline. Every rule is
+// applied to a project wide ACL token, so that it covers all existing and future
+// objects of the security namespace inside the project.
+type baselineRule struct {
+	Name        string
+	Namespace   securityhelper.SecurityNamespaceID
+	Actions     []securityhelper.ActionName
+	TokenFormat string
+}
+
+var baselineRules = []baselineRule{
+	{
+		Name:        "deny_force_push",
+		Namespace:   securityhelper.SecurityNamespaceIDValues.GitRepositories,
+		Actions:     []securityhelper.ActionName{"ForcePush"},
+		TokenFormat: "repoV2/%s",
+	},
+	{
+		Name:        "deny_policy_bypass",
+		Namespace:   securityhelper.SecurityNamespaceIDValues.GitRepositories,
+		Actions:     []securityhelper.ActionName{"PolicyExempt", "PullRequestBypassPolicy"},
+		TokenFormat: "repoV2/%s",
+	},
+	{
+		Name:        "deny_repository_deletion",
+		Namespace:   securityhelper.SecurityNamespaceIDValues.GitRepositories,
+		Actions:     []securityhelper.ActionName{"DeleteRepository"},
+		TokenFormat: "repoV2/%s",
+	},
+	{
+		Name:        "deny_build_definition_deletion",
+		Namespace:   securityhelper.SecurityNamespaceIDValues.Build,
+		Actions:     []securityhelper.ActionName{"DeleteBuildDefinition", "DestroyBuilds"},
+		TokenFormat: "%s",
+	},
+}
+
+// ResourcePermissionsBaseline schema and implementation for the deny-by-default permissions baseline resource
+func ResourcePermissionsBaseline() *schema.Resource {
+	resource := &schema.Resource{
+		Create: resourcePermissionsBaselineCreateOrUpdate,
+		Read:   resourcePermissionsBaselineRead,
+		Update: resourcePermissionsBaselineCreateOrUpdate,
+		Delete: resourcePermissionsBaselineDelete,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.IsUUID,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Required:     true,
+				ForceNew:     true,
+			},
+			"changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"original_permissions": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+
+	for _, rule := range baselineRules {
+		resource.Schema[rule.Name] = &schema.Schema{
+			Type:     schema.TypeBool,
+			Optional: true,
+			Default:  true,
+		}
+	}
+	return resource
+}
+
+func resourcePermissionsBaselineCreateOrUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	original := d.Get("original_permissions").(map[string]interface{})
+	var changes []string
+	for _, rule := range baselineRules {
+		var ruleChanges []string
+		var err error
+		if d.Get(rule.Name).(bool) {
+			var previous map[securityhelper.ActionName]securityhelper.PermissionType
+			ruleChanges, previous, err = applyBaselineRule(d, clients, rule, rule.Actions, securityhelper.PermissionTypeValues.Deny)
+			recordBaselineOriginalPermissions(original, rule, previous)
+		} else if d.HasChange(rule.Name) && !d.IsNewResource() {
+			// the rule has been switched off, so the permissions it changed are reverted
+			ruleChanges, err = restoreBaselineRule(d, clients, rule, original)
+		}
+		if err != nil {
+			d.Set("original_permissions", original)
+			return err
+		}
+		changes = append(changes, ruleChanges...)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", d.Get("project_id").(string), d.Get("principal").(string)))
+	d.Set("changes", changes)
+	d.Set("original_permissions", original)
+	return resourcePermissionsBaselineRead(d, m)
+}
+
+func resourcePermissionsBaselineRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	for _, rule := range baselineRules {
+		if !d.Get(rule.Name).(bool) {
+			continue
+		}
+
+		sn, err := newBaselineSecurityNamespace(d, clients, rule)
+		if err != nil {
+			return err
+		}
+		current, err := getBaselinePrincipalPermissions(d, sn)
+		if err != nil {
+			return err
+		}
+
+		// any action that is no longer denied is reported as drift of the whole rule
+		if len(diffBaselinePermissions(sn.GetToken(), current, rule.Actions, securityhelper.PermissionTypeValues.Deny)) > 0 {
+			log.Printf("[INFO] Permissions baseline rule %s is no longer enforced for ACL token %q", rule.Name, sn.GetToken())
+			d.Set(rule.Name, false)
+		}
+	}
+	return nil
+}
+
+func resourcePermissionsBaselineDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	// only the permissions changed by the baseline are reverted, deny entries which existed before are kept
+	original := d.Get("original_permissions").(map[string]interface{})
+	for _, rule := range baselineRules {
+		if _, err := restoreBaselineRule(d, clients, rule, original); err != nil {
+			d.Set("original_permissions", original)
+			return err
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// restoreBaselineRule sets the actions of a rule which have been changed by the baseline back to the permissions
+// they had before, and removes them from the recorded original permissions
+func restoreBaselineRule(d *schema.ResourceData, clients *client.AggregatedClient, rule baselineRule, original map[string]interface{}) ([]string, error) {
+	var changes []string
+	for _, group := range groupBaselineOriginalPermissions(original, rule) {
+		groupChanges, _, err := applyBaselineRule(d, clients, rule, group.actions, group.permission)
+		if err != nil {
+			return changes, err
+		}
+		for _, action := range group.actions {
+			delete(original, baselineOriginalPermissionKey(rule, action))
+		}
+		changes = append(changes, groupChanges...)
+	}
+	return changes, nil
+}
+
+// baselinePermissionGroup is a set of actions of a rule which are restored to the same permission
+type baselinePermissionGroup struct {
+	permission securityhelper.PermissionType
+	actions    []securityhelper.ActionName
+}
+
+// groupBaselineOriginalPermissions groups the recorded actions of a rule by their original permission
+func groupBaselineOriginalPermissions(original map[string]interface{}, rule baselineRule) []baselinePermissionGroup {
+	var groups []baselinePermissionGroup
+	for _, action := range rule.Actions {
+		value, ok := original[baselineOriginalPermissionKey(rule, action)]
+		if !ok {
+			continue
+		}
+		permission := securityhelper.PermissionType(value.(string))
+		found := false
+		for i := range groups {
+			if groups[i].permission == permission {
+				groups[i].actions = append(groups[i].actions, action)
+				found = true
+				break
+			}
+		}
+		if !found {
+			groups = append(groups, baselinePermissionGroup{permission: permission, actions: []securityhelper.ActionName{action}})
+		}
+	}
+	return groups
+}
+
+// recordBaselineOriginalPermissions records the permissions the actions of a rule had before they were changed by the
+// baseline. Actions which are already recorded keep their first recorded permission.
+func recordBaselineOriginalPermissions(original map[string]interface{}, rule baselineRule, previous map[securityhelper.ActionName]securityhelper.PermissionType) {
+	for action, permission := range previous {
+		key := baselineOriginalPermissionKey(rule, action)
+		if _, ok := original[key]; !ok {
+			original[key] = string(permission)
+		}
+	}
+}
+
+func baselineOriginalPermissionKey(rule baselineRule, action securityhelper.ActionName) string {
+	return fmt.Sprintf("%s/%s", rule.Name, action)
+}
+
+// applyBaselineRule sets the actions of a rule to the target permission in merge mode. It returns a description
+// of every action whose effective setting was changed and the permissions these actions had before.
+func applyBaselineRule(d *schema.ResourceData, clients *client.AggregatedClient, rule baselineRule, actions []securityhelper.ActionName, target securityhelper.PermissionType) ([]string, map[securityhelper.ActionName]securityhelper.PermissionType, error) {
+	sn, err := newBaselineSecurityNamespace(d, clients, rule)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := getBaselinePrincipalPermissions(d, sn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	changes := diffBaselinePermissions(sn.GetToken(), current, actions, target)
+	if len(changes) == 0 {
+		return nil, nil, nil
+	}
+
+	permissions := make(map[securityhelper.ActionName]securityhelper.PermissionType, len(actions))
+	previous := make(map[securityhelper.ActionName]securityhelper.PermissionType, len(actions))
+	for _, action := range actions {
+		permissions[action] = target
+		value, ok := current[action]
+		if !ok {
+			value = securityhelper.PermissionTypeValues.NotSet
+		}
+		if !strings.EqualFold(string(value), string(target)) {
+			previous[action] = securityhelper.PermissionType(strings.ToLower(string(value)))
+		}
+	}
+	err = sn.SetPrincipalPermissions(&[]securityhelper.SetPrincipalPermission{
+		{
+			Replace: false,
+			PrincipalPermission: securityhelper.PrincipalPermission{
+				SubjectDescriptor: d.Get("principal").(string),
+				Permissions:       permissions,
+			},
+		},
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf(" applying permissions baseline rule %s to ACL token %q: %+v", rule.Name, sn.GetToken(), err)
+	}
+
+	log.Printf("[INFO] Permissions baseline rule %s changed: %s", rule.Name, strings.Join(changes, ", "))
+	return changes, previous, nil
+}
+
+func newBaselineSecurityNamespace(d *schema.ResourceData, clients *client.AggregatedClient, rule baselineRule) (*securityhelper.SecurityNamespace, error) {
+	return securityhelper.NewSecurityNamespace(d, clients, rule.Namespace, func(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
+		projectID, ok := d.GetOk("project_id")
+		if !ok {
+			return "", fmt.Errorf("Failed to get 'project_id' from schema")
+		}
+		return fmt.Sprintf(rule.TokenFormat, projectID.(string)), nil
+	})
+}
+
+// getBaselinePrincipalPermissions returns the current permissions of the principal. An empty map is
+// returned if there is no ACL entry for the principal yet.
+func getBaselinePrincipalPermissions(d *schema.ResourceData, sn *securityhelper.SecurityNamespace) (map[securityhelper.ActionName]securityhelper.PermissionType, error) {
+	principal := d.Get("principal").(string)
+	principalPermissions, err := sn.GetPrincipalPermissions(&[]string{principal})
+	if err != nil {
+		return nil, err
+	}
+	if principalPermissions != nil {
+		for _, permission := range *principalPermissions {
+			if strings.EqualFold(permission.SubjectDescriptor, principal) {
+				return permission.Permissions, nil
+			}
+		}
+	}
+	return map[securityhelper.ActionName]securityhelper.PermissionType{}, nil
+}
+
+// diffBaselinePermissions lists the actions which do not have the target permission in the form
+// <token>:<action> <current> -> <target>
+func diffBaselinePermissions(token string, current map[securityhelper.ActionName]securityhelper.PermissionType, actions []securityhelper.ActionName, target securityhelper.PermissionType) []string {
+	var changes []string
+	for _, action := range actions {
+		value, ok := current[action]
+		if !ok {
+			value = securityhelper.PermissionTypeValues.NotSet
+		}
+		if !strings.EqualFold(string(value), string(target)) {
+			changes = append(changes, fmt.Sprintf("%s:%s %s -> %s", token, action, value, target))
+		}
+	}
+	sort.Strings(changes)
+	return changes
+}
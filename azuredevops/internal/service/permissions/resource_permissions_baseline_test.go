@@ -0,0 +1,85 @@
+//go:build (all || permissions || resource_permissions_baseline) && (!exclude_permissions || !resource_permissions_baseline)
+// +build all permissions resource_permissions_baseline
+// +build !exclude_permissions !resource_permissions_baseline
+
+package permissions
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermissionsBaseline_AllRulesEnabledByDefault(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourcePermissionsBaseline().Schema, nil)
+	for _, rule := range baselineRules {
+		assert.True(t, d.Get(rule.Name).(bool), "rule %s should be enabled by default", rule.Name)
+	}
+}
+
+func TestPermissionsBaseline_DiffReportsOnlyChangedActions(t *testing.T) {
+	current := map[securityhelper.ActionName]securityhelper.PermissionType{
+		"ForcePush":               securityhelper.PermissionTypeValues.Allow,
+		"PolicyExempt":            securityhelper.PermissionTypeValues.Deny,
+		"PullRequestBypassPolicy": securityhelper.PermissionTypeValues.NotSet,
+	}
+
+	changes := diffBaselinePermissions("repoV2/"+projectID, current,
+		[]securityhelper.ActionName{"ForcePush", "PolicyExempt", "PullRequestBypassPolicy", "DeleteRepository"},
+		securityhelper.PermissionTypeValues.Deny)
+
+	assert.Equal(t, []string{
+		"repoV2/" + projectID + ":DeleteRepository notset -> deny",
+		"repoV2/" + projectID + ":ForcePush allow -> deny",
+		"repoV2/" + projectID + ":PullRequestBypassPolicy notset -> deny",
+	}, changes)
+}
+
+func TestPermissionsBaseline_DiffIsEmptyWhenEnforced(t *testing.T) {
+	current := map[securityhelper.ActionName]securityhelper.PermissionType{
+		"DeleteBuildDefinition": "Deny",
+		"DestroyBuilds":         securityhelper.PermissionTypeValues.Deny,
+	}
+
+	changes := diffBaselinePermissions(projectID, current,
+		[]securityhelper.ActionName{"DeleteBuildDefinition", "DestroyBuilds"},
+		securityhelper.PermissionTypeValues.Deny)
+
+	assert.Empty(t, changes)
+}
+
+func TestPermissionsBaseline_RecordKeepsFirstOriginalPermission(t *testing.T) {
+	rule := baselineRules[1]
+	original := map[string]interface{}{
+		"deny_policy_bypass/PolicyExempt": "allow",
+	}
+
+	recordBaselineOriginalPermissions(original, rule, map[securityhelper.ActionName]securityhelper.PermissionType{
+		"PolicyExempt":            securityhelper.PermissionTypeValues.NotSet,
+		"PullRequestBypassPolicy": securityhelper.PermissionTypeValues.NotSet,
+	})
+
+	assert.Equal(t, map[string]interface{}{
+		"deny_policy_bypass/PolicyExempt":            "allow",
+		"deny_policy_bypass/PullRequestBypassPolicy": "notset",
+	}, original)
+}
+
+func TestPermissionsBaseline_GroupOnlyRestoresRecordedActions(t *testing.T) {
+	original := map[string]interface{}{
+		"deny_policy_bypass/PolicyExempt":                      "allow",
+		"deny_build_definition_deletion/DestroyBuilds":         "notset",
+		"deny_build_definition_deletion/DeleteBuildDefinition": "allow",
+	}
+
+	assert.Equal(t, []baselinePermissionGroup{
+		{permission: securityhelper.PermissionTypeValues.Allow, actions: []securityhelper.ActionName{"PolicyExempt"}},
+	}, groupBaselineOriginalPermissions(original, baselineRules[1]))
+	assert.Empty(t, groupBaselineOriginalPermissions(original, baselineRules[0]))
+	assert.Equal(t, []baselinePermissionGroup{
+		{permission: securityhelper.PermissionTypeValues.Allow, actions: []securityhelper.ActionName{"DeleteBuildDefinition"}},
+		{permission: securityhelper.PermissionTypeValues.NotSet, actions: []securityhelper.ActionName{"DestroyBuilds"}},
+	}, groupBaselineOriginalPermissions(original, baselineRules[3]))
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
 // ResourceProjectPermissions schema and implementation for project permission resource
@@ -17,6 +18,10 @@ func ResourceProjectPermissions() *schema.Resource {
 		Read:   resourceProjectPermissionsRead,
 		Update: resourceProjectPermissionsCreateOrUpdate,
 		Delete: resourceProjectPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceProjectPermissionsImport,
+		},
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.Project),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -80,6 +85,54 @@ func resourceProjectPermissionsDelete(d *schema.ResourceData, m interface{}) err
 	return nil
 }
 
+// resourceProjectPermissionsImport imports the explicitly allowed or denied permissions of a principal
+// by an ID that looks like <project ID or name>/<principal descriptor>
+func resourceProjectPermissionsImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	clients := m.(*client.AggregatedClient)
+
+	projectNameOrID, principal, err := tfhelper.ParseImportedName(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the resource ID from the Terraform resource data: %v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(projectNameOrID, m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("principal", principal)
+	d.Set("replace", true)
+
+	sn, err := securityhelper.NewSecurityNamespace(d, clients, securityhelper.SecurityNamespaceIDValues.Project, createProjectToken)
+	if err != nil {
+		return nil, err
+	}
+
+	principalPermissions, err := sn.GetPrincipalPermissions(&[]string{principal})
+	if err != nil {
+		return nil, fmt.Errorf(" reading permissions of principal %s in project %s: %+v", principal, projectID, err)
+	}
+
+	permissions := map[string]interface{}{}
+	if principalPermissions != nil {
+		for _, principalPermission := range *principalPermissions {
+			for action, permission := range principalPermission.Permissions {
+				if permission != securityhelper.PermissionTypeValues.NotSet {
+					permissions[string(action)] = string(permission)
+				}
+			}
+		}
+	}
+	if len(permissions) <= 0 {
+		return nil, fmt.Errorf(" no explicit permissions found for principal %s in project %s", principal, projectID)
+	}
+
+	d.Set("permissions", permissions)
+	d.SetId(fmt.Sprintf("%s/%s", sn.GetToken(), principal))
+	return []*schema.ResourceData{d}, nil
+}
+
 func createProjectToken(d *schema.ResourceData, clients *client.AggregatedClient) (string, error) {
 	projectID, ok := d.GetOk("project_id")
 	if !ok {
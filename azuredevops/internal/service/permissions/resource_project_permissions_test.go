@@ -8,10 +8,17 @@ package permissions
 // the Azure DevOps client operations.
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/identity"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -39,6 +46,89 @@ func TestProjectPermissions_CreateProjectToken(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestProjectPermissions_Import_ReadsExplicitPermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	identityClient := azdosdkmocks.NewMockIdentityClient(ctrl)
+	clients := &client.AggregatedClient{
+		SecurityClient: securityClient,
+		IdentityClient: identityClient,
+		Ctx:            context.Background(),
+	}
+
+	principal := "vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5"
+	identityDescriptor := "Microsoft.TeamFoundation.Identity;S-1-9-1551374245-1204400969"
+
+	identityClient.
+		EXPECT().
+		ReadIdentities(clients.Ctx, identity.ReadIdentitiesArgs{
+			SubjectDescriptors: converter.String(principal),
+		}).
+		Return(&[]identity.Identity{
+			{
+				Descriptor:        converter.String(identityDescriptor),
+				SubjectDescriptor: converter.String(principal),
+			},
+		}, nil).
+		Times(1)
+
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(clients.Ctx, gomock.Any()).
+		Return(&[]security.SecurityNamespaceDescription{
+			{
+				Actions: &[]security.ActionDefinition{
+					{Bit: converter.Int(1), Name: converter.String("GENERIC_READ")},
+					{Bit: converter.Int(4), Name: converter.String("DELETE")},
+					{Bit: converter.Int(8192), Name: converter.String("RENAME")},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	securityClient.
+		EXPECT().
+		QueryAccessControlLists(clients.Ctx, gomock.Any()).
+		Return(&[]security.AccessControlList{
+			{
+				Token: converter.String(projectToken),
+				AcesDictionary: &map[string]security.AccessControlEntry{
+					identityDescriptor: {
+						Descriptor: converter.String(identityDescriptor),
+						Allow:      converter.Int(1),
+						Deny:       converter.Int(4),
+					},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	d := schema.TestResourceDataRaw(t, ResourceProjectPermissions().Schema, nil)
+	d.SetId(projectID + "/" + principal)
+
+	result, err := resourceProjectPermissionsImport(d, clients)
+	assert.Nil(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, projectToken+"/"+principal, d.Id())
+	assert.Equal(t, projectID, d.Get("project_id"))
+	assert.Equal(t, principal, d.Get("principal"))
+	assert.Equal(t, map[string]interface{}{
+		"GENERIC_READ": "allow",
+		"DELETE":       "deny",
+	}, d.Get("permissions"))
+}
+
+func TestProjectPermissions_Import_InvalidID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, ResourceProjectPermissions().Schema, nil)
+	d.SetId(projectID)
+
+	result, err := resourceProjectPermissionsImport(d, &client.AggregatedClient{Ctx: context.Background()})
+	assert.Nil(t, result)
+	assert.NotNil(t, err)
+}
+
 func getProjecPermissionsResource(t *testing.T, projectID string) *schema.ResourceData {
 	d := schema.TestResourceDataRaw(t, ResourceProjectPermissions().Schema, nil)
 	if projectID != "" {
@@ -12,10 +12,11 @@ import (
 // ResourceServiceEndpointPermissions schema and implementation for serviceendpoint permission resource
 func ResourceServiceEndpointPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceServiceEndpointPermissionsCreateOrUpdate,
-		Read:   resourceServiceEndpointPermissionsRead,
-		Update: resourceServiceEndpointPermissionsCreateOrUpdate,
-		Delete: resourceServiceEndpointPermissionsDelete,
+		Create:        resourceServiceEndpointPermissionsCreateOrUpdate,
+		Read:          resourceServiceEndpointPermissionsRead,
+		Update:        resourceServiceEndpointPermissionsCreateOrUpdate,
+		Delete:        resourceServiceEndpointPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.ServiceEndpoints),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
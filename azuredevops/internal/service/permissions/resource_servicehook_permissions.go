@@ -13,10 +13,11 @@ import (
 // ResourceServiceHookPermissions schema and implementation for servicehook permission resource
 func ResourceServiceHookPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceServiceHookPermissionsCreateOrUpdate,
-		Read:   resourceServiceHookPermissionsRead,
-		Update: resourceServiceHookPermissionsCreateOrUpdate,
-		Delete: resourceServiceHookPermissionsDelete,
+		Create:        resourceServiceHookPermissionsCreateOrUpdate,
+		Read:          resourceServiceHookPermissionsRead,
+		Update:        resourceServiceHookPermissionsCreateOrUpdate,
+		Delete:        resourceServiceHookPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.ServiceHooks),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -13,10 +13,11 @@ import (
 // ResourceTaggingPermissions schema and implementation for tagging permission resource
 func ResourceTaggingPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceTaggingPermissionsCreateOrUpdate,
-		Read:   resourceTaggingPermissionsRead,
-		Update: resourceTaggingPermissionsCreateOrUpdate,
-		Delete: resourceTaggingPermissionsDelete,
+		Create:        resourceTaggingPermissionsCreateOrUpdate,
+		Read:          resourceTaggingPermissionsRead,
+		Update:        resourceTaggingPermissionsCreateOrUpdate,
+		Delete:        resourceTaggingPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.Tagging),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
@@ -0,0 +1,24 @@
+package permissions
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	securityhelper "github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/permissions/utils"
+)
+
+// Test plans and suites are secured by the area path they are assigned to,
+// so only the test related actions of the CSS namespace can be managed
+var testPlanPermissionNames = []string{
+	"MANAGE_TEST_PLANS",
+	"MANAGE_TEST_SUITES",
+}
+
+// ResourceTestPlanPermissions schema and implementation for test plan permission resource
+func ResourceTestPlanPermissions() *schema.Resource {
+	resource := ResourceAreaPermissions()
+	resource.CustomizeDiff = customdiff.All(
+		securityhelper.CreateRestrictedPermissionResourceCustomizeDiff(testPlanPermissionNames...),
+		resource.CustomizeDiff,
+	)
+	return resource
+}
@@ -0,0 +1,54 @@
+//go:build (all || permissions || resource_test_plan_permissions) && (!exclude_permissions || !resource_test_plan_permissions)
+// +build all permissions resource_test_plan_permissions
+// +build !exclude_permissions !resource_test_plan_permissions
+
+package permissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestPlanPermissionsConfig(permissions map[string]interface{}) *terraform.ResourceConfig {
+	return terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id":  uuid.New().String(),
+		"principal":   "vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5LTI0MDI5ODY0MTMtMjE3OTQwODYxNi0zLTE0NzMxMjk0NjAtMjk5NjQ2NzE3Ni0yNzY3NDkzNjA2LTMzMTM1NzA2NjQ",
+		"path":        "/",
+		"permissions": permissions,
+	})
+}
+
+func TestTestPlanPermissions_CustomizeDiff_AcceptsTestPlanPermissions(t *testing.T) {
+	config := getTestPlanPermissionsConfig(map[string]interface{}{
+		"MANAGE_TEST_PLANS":  "Allow",
+		"MANAGE_TEST_SUITES": "Deny",
+	})
+
+	_, err := ResourceTestPlanPermissions().Diff(context.Background(), nil, config, nil)
+	require.Nil(t, err)
+}
+
+func TestTestPlanPermissions_CustomizeDiff_RejectsAreaPermissions(t *testing.T) {
+	config := getTestPlanPermissionsConfig(map[string]interface{}{
+		"MANAGE_TEST_PLANS": "Allow",
+		"GENERIC_WRITE":     "Allow",
+	})
+
+	_, err := ResourceTestPlanPermissions().Diff(context.Background(), nil, config, nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), `"GENERIC_WRITE"`)
+}
+
+func TestTestPlanPermissions_CustomizeDiff_SuggestsTestPlanPermission(t *testing.T) {
+	config := getTestPlanPermissionsConfig(map[string]interface{}{
+		"MANAGE_TEST_PLAN": "Allow",
+	})
+
+	_, err := ResourceTestPlanPermissions().Diff(context.Background(), nil, config, nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), `did you mean "MANAGE_TEST_PLANS"?`)
+}
@@ -19,10 +19,11 @@ import (
 // ResourceWorkItemQueryPermissions schema and implementation for project permission resource
 func ResourceWorkItemQueryPermissions() *schema.Resource {
 	return &schema.Resource{
-		Create: ResourceWorkItemQueryPermissionsCreateOrUpdate,
-		Read:   ResourceWorkItemQueryPermissionsRead,
-		Update: ResourceWorkItemQueryPermissionsCreateOrUpdate,
-		Delete: ResourceWorkItemQueryPermissionsDelete,
+		Create:        ResourceWorkItemQueryPermissionsCreateOrUpdate,
+		Read:          ResourceWorkItemQueryPermissionsRead,
+		Update:        ResourceWorkItemQueryPermissionsCreateOrUpdate,
+		Delete:        ResourceWorkItemQueryPermissionsDelete,
+		CustomizeDiff: securityhelper.CreatePermissionResourceCustomizeDiff(securityhelper.SecurityNamespaceIDValues.WorkItemQueryFolders),
 		Schema: securityhelper.CreatePermissionResourceSchema(map[string]*schema.Schema{
 			"project_id": {
 				Type:         schema.TypeString,
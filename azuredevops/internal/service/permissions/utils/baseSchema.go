@@ -1,8 +1,18 @@
 package utils
 
 import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ahmetb/go-linq"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/suppress"
 )
 
@@ -26,7 +36,8 @@ func CreatePermissionResourceSchema(outer map[string]*schema.Schema) map[string]
 			// security client as we must load the security namespace
 			// definition and the available permission settings, and a validation
 			// function in Terraform only receives the parameter name and the
-			// current value as argument
+			// current value as argument.
+			// The keys are validated by CreatePermissionResourceCustomizeDiff instead.
 			Type:     schema.TypeMap,
 			Required: true,
 			Elem: &schema.Schema{
@@ -42,3 +53,154 @@ func CreatePermissionResourceSchema(outer map[string]*schema.Schema) map[string]
 
 	return outer
 }
+
+// namespaceActionsCache caches the action names of the security namespaces per organization, because
+// the definition of a namespace would otherwise be loaded for every permission resource during plan
+var namespaceActionsCache = struct {
+	sync.Mutex
+	actions map[string][]string
+}{actions: map[string][]string{}}
+
+// CreatePermissionResourceCustomizeDiff creates a CustomizeDiff function for a Terraform permission resource,
+// which validates the permission names against the actions of the security namespace during plan
+func CreatePermissionResourceCustomizeDiff(namespaceID SecurityNamespaceID) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown("permissions") {
+			return nil
+		}
+		permissions, ok := d.Get("permissions").(map[string]interface{})
+		if !ok || len(permissions) <= 0 {
+			return nil
+		}
+
+		clients, ok := m.(*client.AggregatedClient)
+		if !ok || clients == nil {
+			return nil
+		}
+		actions, err := getNamespaceActionNames(clients, namespaceID)
+		if err != nil {
+			// the permissions are validated again during apply
+			log.Printf("[WARN] Unable to load security namespace definition with id [%s]. Skipping validation of permission names: %+v", uuid.UUID(namespaceID), err)
+			return nil
+		}
+		return validatePermissionNames(permissions, actions)
+	}
+}
+
+// CreateRestrictedPermissionResourceCustomizeDiff creates a CustomizeDiff function for a Terraform permission resource,
+// which only accepts the given subset of the actions of a security namespace during plan
+func CreateRestrictedPermissionResourceCustomizeDiff(actions ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+		if !d.NewValueKnown("permissions") {
+			return nil
+		}
+		permissions, ok := d.Get("permissions").(map[string]interface{})
+		if !ok || len(permissions) <= 0 {
+			return nil
+		}
+		return validatePermissionNames(permissions, actions)
+	}
+}
+
+func getNamespaceActionNames(clients *client.AggregatedClient, namespaceID SecurityNamespaceID) ([]string, error) {
+	key := fmt.Sprintf("%s/%s", clients.OrganizationURL, uuid.UUID(namespaceID))
+
+	namespaceActionsCache.Lock()
+	defer namespaceActionsCache.Unlock()
+	if actions, ok := namespaceActionsCache.actions[key]; ok {
+		return actions, nil
+	}
+
+	if clients.SecurityClient == nil {
+		return nil, fmt.Errorf("securityClient is nil")
+	}
+	sn := &SecurityNamespace{
+		namespaceID:    uuid.UUID(namespaceID),
+		context:        clients.Ctx,
+		securityClient: clients.SecurityClient,
+	}
+	actionMap, err := sn.GetActionDefinitions()
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]string, 0, len(*actionMap))
+	for name := range *actionMap {
+		actions = append(actions, name)
+	}
+	sort.Strings(actions)
+	namespaceActionsCache.actions[key] = actions
+	return actions, nil
+}
+
+// validatePermissionNames returns an error listing every permission which is not an action of the
+// namespace, together with the closest action name if the permission looks like a misspelling
+func validatePermissionNames(permissions map[string]interface{}, actions []string) error {
+	var invalid []string
+	for name := range permissions {
+		if linq.From(actions).Contains(name) {
+			continue
+		}
+		if suggestion := getClosestActionName(name, actions); suggestion != "" {
+			invalid = append(invalid, fmt.Sprintf("%q (did you mean %q?)", name, suggestion))
+		} else {
+			invalid = append(invalid, fmt.Sprintf("%q", name))
+		}
+	}
+	if len(invalid) <= 0 {
+		return nil
+	}
+
+	sort.Strings(invalid)
+	return fmt.Errorf(" invalid permissions %s. Valid permissions are: %s", strings.Join(invalid, ", "), strings.Join(actions, ", "))
+}
+
+// getClosestActionName returns the action with the smallest case-insensitive edit distance to name,
+// or an empty string if no action is close enough to be a likely misspelling
+func getClosestActionName(name string, actions []string) string {
+	maxDistance := len(name) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+
+	closest := ""
+	closestDistance := maxDistance + 1
+	for _, action := range actions {
+		distance := levenshteinDistance(strings.ToLower(name), strings.ToLower(action))
+		if distance < closestDistance {
+			closest = action
+			closestDistance = distance
+		}
+	}
+	return closest
+}
+
+func levenshteinDistance(a string, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	result := values[0]
+	for _, value := range values[1:] {
+		if value < result {
+			result = value
+		}
+	}
+	return result
+}
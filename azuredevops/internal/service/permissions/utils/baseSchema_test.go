@@ -4,11 +4,18 @@
 package utils
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/security"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -49,3 +56,68 @@ func TestCreatePermissionResourceSchema(t *testing.T) {
 		assert.True(t, ok, fmt.Sprintf("Schema should contain a field [%s]", field))
 	}
 }
+
+var testGitActionNames = []string{"CreateBranch", "DeleteRepository", "ForcePush", "GenericContribute", "GenericRead"}
+
+func TestValidatePermissionNames_ValidNames(t *testing.T) {
+	err := validatePermissionNames(map[string]interface{}{
+		"ForcePush":   "deny",
+		"GenericRead": "allow",
+	}, testGitActionNames)
+	assert.Nil(t, err)
+}
+
+func TestValidatePermissionNames_SuggestsClosestName(t *testing.T) {
+	err := validatePermissionNames(map[string]interface{}{
+		"ForcePsh":    "deny",
+		"genericread": "allow",
+		"GenericRead": "allow",
+	}, testGitActionNames)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `"ForcePsh" (did you mean "ForcePush"?)`)
+	assert.Contains(t, err.Error(), `"genericread" (did you mean "GenericRead"?)`)
+	assert.NotContains(t, err.Error(), `"GenericRead" (`)
+}
+
+func TestValidatePermissionNames_NoSuggestionListsValidNames(t *testing.T) {
+	err := validatePermissionNames(map[string]interface{}{
+		"ManageNotes": "deny",
+	}, testGitActionNames)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), `"ManageNotes". Valid permissions are: CreateBranch, DeleteRepository, ForcePush, GenericContribute, GenericRead`)
+}
+
+func TestGetNamespaceActionNames_LoadsNamespaceOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	namespaceID := uuid.New()
+	securityClient := azdosdkmocks.NewMockSecurityClient(ctrl)
+	clients := &client.AggregatedClient{
+		OrganizationURL: "https://dev.azure.com/test-org",
+		SecurityClient:  securityClient,
+		Ctx:             context.Background(),
+	}
+
+	securityClient.
+		EXPECT().
+		QuerySecurityNamespaces(clients.Ctx, security.QuerySecurityNamespacesArgs{
+			SecurityNamespaceId: &namespaceID,
+		}).
+		Return(&[]security.SecurityNamespaceDescription{
+			{
+				NamespaceId: &namespaceID,
+				Actions: &[]security.ActionDefinition{
+					{Name: converter.String("GenericRead"), Bit: converter.Int(2)},
+					{Name: converter.String("ForcePush"), Bit: converter.Int(8)},
+				},
+			},
+		}, nil).
+		Times(1)
+
+	for i := 0; i < 2; i++ {
+		actions, err := getNamespaceActionNames(clients, SecurityNamespaceID(namespaceID))
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"ForcePush", "GenericRead"}, actions)
+	}
+}
@@ -65,6 +65,83 @@ func TestBranchPolicyCRUD_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, projectID, *expandedProjectID)
 }
 
+// verifies that a scope without repository protects the branch in all repositories of the project
+func TestBranchPolicyCRUD_ExpandFlatten_CrossRepositoryScope(t *testing.T) {
+	crossRepositoryPolicy := &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Type: &policy.PolicyTypeRef{
+			Id: &randomUUID,
+		},
+		Settings: map[string]interface{}{
+			"scope": []map[string]interface{}{
+				{
+					"repositoryId": nil,
+					"refName":      "refs/heads/main",
+					"matchKind":    "Exact",
+				},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	require.Nil(t, baseFlattenFunc(resourceData, crossRepositoryPolicy, &projectID))
+	require.Equal(t, "", resourceData.Get("settings.0.scope.0.repository_id"))
+
+	expandedPolicy, _, err := baseExpandFunc(resourceData, randomUUID)
+	require.Nil(t, err)
+	require.Equal(t, crossRepositoryPolicy, expandedPolicy)
+}
+
+// verifies that a default branch scope protects the default branch of all repositories of the project
+func TestBranchPolicyCRUD_ExpandFlatten_DefaultBranchScope(t *testing.T) {
+	defaultBranchPolicy := &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Type: &policy.PolicyTypeRef{
+			Id: &randomUUID,
+		},
+		Settings: map[string]interface{}{
+			"scope": []map[string]interface{}{
+				{
+					"repositoryId": nil,
+					"refName":      nil,
+					"matchKind":    "DefaultBranch",
+				},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	require.Nil(t, baseFlattenFunc(resourceData, defaultBranchPolicy, &projectID))
+	require.Equal(t, "DefaultBranch", resourceData.Get("settings.0.scope.0.match_type"))
+
+	expandedPolicy, _, err := baseExpandFunc(resourceData, randomUUID)
+	require.Nil(t, err)
+	require.Equal(t, defaultBranchPolicy, expandedPolicy)
+}
+
+// verifies that a default branch scope cannot be limited to a repository or ref
+func TestBranchPolicyCRUD_Expand_DefaultBranchScopeWithRepository(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, testResource.Schema, nil)
+	resourceData.Set("settings", []interface{}{
+		map[string]interface{}{
+			"scope": []interface{}{
+				map[string]interface{}{
+					"repository_id": "test-repo-id",
+					"match_type":    "DefaultBranch",
+				},
+			},
+		},
+	})
+
+	_, _, err := baseExpandFunc(resourceData, randomUUID)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "match_type=DefaultBranch")
+}
+
 // verifies that CREATE failures are not swallowed
 func TestBranchPolicyCRUD_CreateError_NotSwallowed(t *testing.T) {
 	ctrl := gomock.NewController(t)
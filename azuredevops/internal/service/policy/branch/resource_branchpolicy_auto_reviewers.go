@@ -40,7 +40,7 @@ func ResourceBranchPolicyAutoReviewers() *schema.Resource {
 		Required: true,
 		Elem: &schema.Schema{
 			Type:         schema.TypeString,
-			ValidateFunc: validation.StringIsNotEmpty,
+			ValidateFunc: validateAutoReviewerID,
 		},
 	}
 	settingsSchema[pathFilters] = &schema.Schema{
@@ -131,3 +131,16 @@ func autoReviewersExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.
 
 	return policyConfig, projectID, nil
 }
+
+// validateAutoReviewerID rejects empty reviewer IDs and warns about IDs which are not GUIDs, like group descriptors,
+// as Azure DevOps expects the GUID of a user or group
+func validateAutoReviewerID(i interface{}, k string) ([]string, []error) {
+	_, errs := validation.StringIsNotEmpty(i, k)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	if _, errs := validation.IsUUID(i, k); len(errs) > 0 {
+		return []string{fmt.Sprintf("%q should be the GUID of a user or group, got %q", k, i.(string))}, nil
+	}
+	return nil, nil
+}
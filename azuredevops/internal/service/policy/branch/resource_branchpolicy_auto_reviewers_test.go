@@ -51,3 +51,20 @@ func TestBranchPolicyAutoReviewers_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that reviewer IDs other than GUIDs only produce a warning during validation
+func TestBranchPolicyAutoReviewers_WarnsAboutReviewerIdsWhichAreNoUUIDs(t *testing.T) {
+	settingsSchema := ResourceBranchPolicyAutoReviewers().Schema[SchemaSettings].Elem.(*schema.Resource).Schema
+	validateFunc := settingsSchema[autoReviewerIds].Elem.(*schema.Schema).ValidateFunc
+
+	warnings, errs := validateFunc(uuid.New().String(), autoReviewerIds)
+	require.Empty(t, warnings)
+	require.Empty(t, errs)
+
+	warnings, errs = validateFunc("vssgp.Uy0xLTktMTU1MTM3NDI0NS0xMjA0NDAwOTY5", autoReviewerIds)
+	require.NotEmpty(t, warnings)
+	require.Empty(t, errs)
+
+	_, errs = validateFunc("", autoReviewerIds)
+	require.NotEmpty(t, errs)
+}
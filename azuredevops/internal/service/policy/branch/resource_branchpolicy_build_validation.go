@@ -39,13 +39,14 @@ func ResourceBranchPolicyBuildValidation() *schema.Resource {
 
 	settingsSchema := resource.Schema[SchemaSettings].Elem.(*schema.Resource).Schema
 	settingsSchema[buildDefinitionID] = &schema.Schema{
-		Type:     schema.TypeInt,
-		Required: true,
+		Type:         schema.TypeInt,
+		Required:     true,
+		ValidateFunc: validation.IntAtLeast(1),
 	}
 	settingsSchema[policyDisplayName] = &schema.Schema{
-		Type:         schema.TypeString,
-		Required:     true,
-		ValidateFunc: validation.StringIsNotEmpty,
+		Type:     schema.TypeString,
+		Optional: true,
+		Default:  "",
 	}
 	settingsSchema[manualQueueOnly] = &schema.Schema{
 		Type:     schema.TypeBool,
@@ -115,7 +116,9 @@ func buildValidationExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*polic
 	policySettings := policyConfig.Settings.(map[string]interface{})
 
 	policySettings["buildDefinitionId"] = settings[buildDefinitionID].(int)
-	policySettings["displayName"] = settings[policyDisplayName].(string)
+	if displayName := settings[policyDisplayName].(string); displayName != "" {
+		policySettings["displayName"] = displayName
+	}
 	policySettings["manualQueueOnly"] = settings[manualQueueOnly].(bool)
 	policySettings["queueOnSourceUpdateOnly"] = settings[queueOnSourceUpdateOnly].(bool)
 	policySettings["validDuration"] = settings[validDuration].(int)
@@ -52,3 +52,39 @@ func TestBranchPolicyBuildValidation_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that a policy without a display name does not send an empty one to the service
+func TestBranchPolicyBuildValidation_ExpandFlatten_RoundtripWithoutDisplayName(t *testing.T) {
+	var projectID = uuid.New().String()
+	var randomUUID = uuid.New()
+	var testPolicy = &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(false),
+		Type: &policy.PolicyTypeRef{
+			Id: &randomUUID,
+		},
+		Settings: map[string]interface{}{
+			"scope": []map[string]interface{}{
+				{
+					"repositoryId": "test-repo-id",
+					"refName":      "test-ref-name",
+					"matchKind":    "test-match-kind",
+				},
+			},
+			"buildDefinitionId":       77,
+			"manualQueueOnly":         false,
+			"queueOnSourceUpdateOnly": true,
+			"validDuration":           720,
+			"filenamePatterns":        &([]string{}),
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyBuildValidation().Schema, nil)
+	err := buildValidationFlattenFunc(resourceData, testPolicy, &projectID)
+	require.Nil(t, err)
+	expandedPolicy, _, err := buildValidationExpandFunc(resourceData, randomUUID)
+	require.Nil(t, err)
+
+	require.Equal(t, testPolicy, expandedPolicy)
+}
@@ -7,7 +7,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 )
 
-// ResourceBranchPolicyCommentResolution schema and implementation for min reviewer policy resource
+// ResourceBranchPolicyCommentResolution schema and implementation for comment resolution policy resource
 func ResourceBranchPolicyCommentResolution() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: commentResolutionFlattenFunc,
@@ -17,16 +17,9 @@ func ResourceBranchPolicyCommentResolution() *schema.Resource {
 	return resource
 }
 
+// The policy has no settings besides the common scopes
 func commentResolutionFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
-	err := baseFlattenFunc(d, policyConfig, projectID)
-	if err != nil {
-		return err
-	}
-
-	settingsList := d.Get(SchemaSettings).([]interface{})
-
-	d.Set(SchemaSettings, settingsList)
-	return nil
+	return baseFlattenFunc(d, policyConfig, projectID)
 }
 
 func commentResolutionExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.PolicyConfiguration, *string, error) {
@@ -18,7 +18,7 @@ type mergeTypePolicySettings struct {
 	AllowRebaseMerge   bool `json:"allowRebaseMerge" tf:"allow_rebase_with_merge"`
 }
 
-// ResourceBranchPolicyMinReviewers schema and implementation for min reviewer policy resource
+// ResourceBranchPolicyMergeTypes schema and implementation for merge types policy resource
 func ResourceBranchPolicyMergeTypes() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: mergeTypesFlattenFunc,
@@ -92,6 +92,7 @@ func mergeTypesExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.Pol
 
 	policySettings := policyConfig.Settings.(map[string]interface{})
 
+	anyAllowed := false
 	tipe := reflect.TypeOf(mergeTypePolicySettings{})
 	for i := 0; i < tipe.NumField(); i++ {
 		tags := tipe.Field(i).Tag
@@ -102,8 +103,13 @@ func mergeTypesExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.Pol
 		}
 		if tipe.Field(i).Type == reflect.TypeOf(true) {
 			policySettings[apiName] = settings[tfName].(bool)
+			anyAllowed = anyAllowed || settings[tfName].(bool)
 		}
 	}
 
+	if !anyAllowed && *policyConfig.IsEnabled {
+		return nil, nil, fmt.Errorf(" at least one merge type must be allowed when the policy is enabled")
+	}
+
 	return policyConfig, projectID, nil
 }
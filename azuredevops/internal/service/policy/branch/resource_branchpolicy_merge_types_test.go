@@ -50,3 +50,34 @@ func TestBranchPolicyMergeTypes_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that an enabled policy which does not allow any merge type is rejected before calling the service
+func TestBranchPolicyMergeTypes_Expand_FailsIfNoMergeTypeAllowed(t *testing.T) {
+	var projectID = uuid.New().String()
+	var randomUUID = uuid.New()
+	var testPolicy = &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Type: &policy.PolicyTypeRef{
+			Id: &randomUUID,
+		},
+		Settings: map[string]interface{}{
+			"scope": []map[string]interface{}{
+				{
+					"matchKind": "DefaultBranch",
+				},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyMergeTypes().Schema, nil)
+	err := mergeTypesFlattenFunc(resourceData, testPolicy, &projectID)
+	require.Nil(t, err)
+	_, _, err = mergeTypesExpandFunc(resourceData, randomUUID)
+	require.EqualError(t, err, " at least one merge type must be allowed when the policy is enabled")
+
+	resourceData.Set(SchemaEnabled, false)
+	_, _, err = mergeTypesExpandFunc(resourceData, randomUUID)
+	require.Nil(t, err)
+}
@@ -53,3 +53,10 @@ func TestBranchPolicyMinReviewers_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Equal(t, testPolicy, expandedPolicy)
 	require.Equal(t, projectID, *expandedProjectID)
 }
+
+// verifies that existing configurations without a reviewer count stay valid
+func TestBranchPolicyMinReviewers_ReviewerCountIsOptional(t *testing.T) {
+	settingsSchema := ResourceBranchPolicyMinReviewers().Schema[SchemaSettings].Elem.(*schema.Resource).Schema
+	require.True(t, settingsSchema["reviewer_count"].Optional)
+	require.False(t, settingsSchema["reviewer_count"].Required)
+}
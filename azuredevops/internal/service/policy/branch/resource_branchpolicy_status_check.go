@@ -17,6 +17,7 @@ var applicability = policyApplicability{
 	Conditional: "conditional",
 }
 
+// ResourceBranchPolicyStatusCheck schema and implementation for status check policy resource
 func ResourceBranchPolicyStatusCheck() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: statusCheckFlattenFunc,
@@ -114,7 +115,10 @@ func statusCheckExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.Po
 	policySettings := policyConfig.Settings.(map[string]interface{})
 	policySettings["statusName"] = settings["name"].(string)
 	policySettings["statusGenre"] = settings["genre"].(string)
-	policySettings["authorId"] = settings["author_id"].(string)
+	// without an author the status can be posted by any identity
+	if authorID := settings["author_id"].(string); authorID != "" {
+		policySettings["authorId"] = authorID
+	}
 	policySettings["invalidateOnSourceUpdate"] = settings["invalidate_on_update"].(bool)
 	policySettings["defaultDisplayName"] = settings["display_name"].(string)
 	policySettings["filenamePatterns"] = expandPatterns(settings[filenamePatterns].(*schema.Set))
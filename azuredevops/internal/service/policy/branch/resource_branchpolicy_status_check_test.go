@@ -0,0 +1,85 @@
+//go:build (all || resource_branchpolicy_status_check) && !exclude_resource_branchpolicy_status_check
+// +build all resource_branchpolicy_status_check
+// +build !exclude_resource_branchpolicy_status_check
+
+package branch
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/require"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+func getStatusCheckTestPolicy(settings map[string]interface{}) (*policy.PolicyConfiguration, uuid.UUID) {
+	var randomUUID = uuid.New()
+	settings["scope"] = []map[string]interface{}{
+		{
+			"repositoryId": "test-repo-id",
+			"refName":      "test-ref-name",
+			"matchKind":    "test-match-kind",
+		},
+	}
+	return &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Type: &policy.PolicyTypeRef{
+			Id: &randomUUID,
+		},
+		Settings: settings,
+	}, randomUUID
+}
+
+// verifies that the flatten/expand round trip path keeps all settings of an external status check
+func TestBranchPolicyStatusCheck_ExpandFlatten_Roundtrip(t *testing.T) {
+	var projectID = uuid.New().String()
+	var authorID = uuid.New().String()
+	testPolicy, typeID := getStatusCheckTestPolicy(map[string]interface{}{
+		"statusName":               "sonar",
+		"statusGenre":              "quality",
+		"authorId":                 authorID,
+		"invalidateOnSourceUpdate": true,
+		"defaultDisplayName":       "Quality gate",
+		"filenamePatterns":         []interface{}{"/src/*"},
+		"policyApplicability":      float64(1),
+	})
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyStatusCheck().Schema, nil)
+	err := statusCheckFlattenFunc(resourceData, testPolicy, &projectID)
+	require.Nil(t, err)
+	expandedPolicy, expandedProjectID, err := statusCheckExpandFunc(resourceData, typeID)
+	require.Nil(t, err)
+
+	require.Equal(t, projectID, *expandedProjectID)
+	expandedSettings := expandedPolicy.Settings.(map[string]interface{})
+	require.Equal(t, "sonar", expandedSettings["statusName"])
+	require.Equal(t, "quality", expandedSettings["statusGenre"])
+	require.Equal(t, authorID, expandedSettings["authorId"])
+	require.Equal(t, true, expandedSettings["invalidateOnSourceUpdate"])
+	require.Equal(t, "Quality gate", expandedSettings["defaultDisplayName"])
+	require.Equal(t, &[]string{"/src/*"}, expandedSettings["filenamePatterns"])
+	require.Equal(t, 1, expandedSettings["policyApplicability"])
+}
+
+// verifies that no author restriction is sent if the status can be posted by any identity
+func TestBranchPolicyStatusCheck_Expand_OmitsEmptyAuthor(t *testing.T) {
+	var projectID = uuid.New().String()
+	testPolicy, typeID := getStatusCheckTestPolicy(map[string]interface{}{
+		"statusName": "sonar",
+	})
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceBranchPolicyStatusCheck().Schema, nil)
+	err := statusCheckFlattenFunc(resourceData, testPolicy, &projectID)
+	require.Nil(t, err)
+	expandedPolicy, _, err := statusCheckExpandFunc(resourceData, typeID)
+	require.Nil(t, err)
+
+	expandedSettings := expandedPolicy.Settings.(map[string]interface{})
+	require.NotContains(t, expandedSettings, "authorId")
+	require.NotContains(t, expandedSettings, "policyApplicability")
+}
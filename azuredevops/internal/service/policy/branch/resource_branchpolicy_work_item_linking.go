@@ -7,7 +7,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 )
 
-// ResourceBranchPolicyWorkItemLinking schema and implementation for min reviewer policy resource
+// ResourceBranchPolicyWorkItemLinking schema and implementation for work item linking policy resource
 func ResourceBranchPolicyWorkItemLinking() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: workItemLinkingFlattenFunc,
@@ -17,16 +17,9 @@ func ResourceBranchPolicyWorkItemLinking() *schema.Resource {
 	return resource
 }
 
+// The policy has no settings besides the common scopes
 func workItemLinkingFlattenFunc(d *schema.ResourceData, policyConfig *policy.PolicyConfiguration, projectID *string) error {
-	err := baseFlattenFunc(d, policyConfig, projectID)
-	if err != nil {
-		return err
-	}
-
-	settingsList := d.Get(SchemaSettings).([]interface{})
-
-	d.Set(SchemaSettings, settingsList)
-	return nil
+	return baseFlattenFunc(d, policyConfig, projectID)
 }
 
 func workItemLinkingExpandFunc(d *schema.ResourceData, typeID uuid.UUID) (*policy.PolicyConfiguration, *string, error) {
@@ -7,6 +7,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 )
 
+// ResourceRepositoryPolicyAuthorEmailPatterns schema and implementation for author email pattern repository policy resource
 func ResourceRepositoryPolicyAuthorEmailPatterns() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: authorEmailPatternFlattenFunc,
@@ -0,0 +1,46 @@
+//go:build (all || resource_repositorypolicy_author_email_patterns) && !exclude_resource_repositorypolicy_author_email_patterns
+// +build all resource_repositorypolicy_author_email_patterns
+// +build !exclude_resource_repositorypolicy_author_email_patterns
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the flatten/expand round trip path keeps the patterns and their order
+func TestRepositoryPolicyAuthorEmailPatterns_ExpandFlatten_Roundtrip(t *testing.T) {
+	projectID := uuid.New().String()
+	repositoryID := uuid.New().String()
+	patterns := []interface{}{"*@example.com", "build@example.org"}
+	testPolicy := &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(false),
+		Settings: map[string]interface{}{
+			"authorEmailPatterns": patterns,
+			"scope": []interface{}{
+				map[string]interface{}{"repositoryId": repositoryID},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryPolicyAuthorEmailPatterns().Schema, nil)
+	require.Nil(t, authorEmailPatternFlattenFunc(resourceData, testPolicy, &projectID))
+	require.False(t, resourceData.Get("blocking").(bool))
+
+	expandedPolicy, expandedProjectID, err := authorEmailPatternExpandFunc(resourceData, AuthorEmailPattern)
+	require.Nil(t, err)
+	require.Equal(t, projectID, *expandedProjectID)
+	require.False(t, *expandedPolicy.IsBlocking)
+
+	expandedSettings := expandedPolicy.Settings.(map[string]interface{})
+	require.Equal(t, patterns, expandedSettings["authorEmailPatterns"])
+	require.Equal(t, []map[string]interface{}{{"repositoryId": repositoryID}}, expandedSettings["scope"])
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 )
 
+// ResourceRepositoryPolicyCheckCredentials schema and implementation for the repository policy which blocks
+// pushes that contain credentials and other secrets
 func ResourceRepositoryPolicyCheckCredentials() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: checkCredentialsFlattenFunc,
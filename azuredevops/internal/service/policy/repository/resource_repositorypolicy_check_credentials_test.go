@@ -0,0 +1,47 @@
+//go:build (all || resource_policy_check_credentials) && !exclude_resource_policy_check_credentials
+// +build all resource_policy_check_credentials
+// +build !exclude_resource_policy_check_credentials
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the flatten/expand round trip path produces repeatable results
+func TestRepositoryCheckCredentials_ExpandFlatten_Roundtrip(t *testing.T) {
+	// an empty repository ID scopes the policy to all repositories of the project
+	for _, repositoryID := range []string{uuid.New().String(), ""} {
+		projectID := uuid.New().String()
+		testPolicy := &policy.PolicyConfiguration{
+			Id:         converter.Int(1),
+			IsEnabled:  converter.Bool(true),
+			IsBlocking: converter.Bool(false),
+			Settings: map[string]interface{}{
+				"scope": []interface{}{
+					map[string]interface{}{"repositoryId": repositoryID},
+				},
+			},
+		}
+
+		resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryPolicyCheckCredentials().Schema, nil)
+		require.Nil(t, checkCredentialsFlattenFunc(resourceData, testPolicy, &projectID))
+		require.Equal(t, "1", resourceData.Id())
+		require.False(t, resourceData.Get("blocking").(bool))
+
+		expandedPolicy, expandedProjectID, err := checkCredentialsExpandFunc(resourceData, CheckCredentials)
+		require.Nil(t, err)
+		require.Equal(t, projectID, *expandedProjectID)
+		require.Equal(t, CheckCredentials, *expandedPolicy.Type.Id)
+		require.False(t, *expandedPolicy.IsBlocking)
+
+		expandedSettings := expandedPolicy.Settings.(map[string]interface{})
+		require.Equal(t, []map[string]interface{}{{"repositoryId": repositoryID}}, expandedSettings["scope"])
+	}
+}
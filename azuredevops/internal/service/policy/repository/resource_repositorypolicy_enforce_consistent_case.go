@@ -6,6 +6,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 )
 
+// ResourceRepositoryEnforceConsistentCase schema and implementation for case enforcement repository policy resource
 func ResourceRepositoryEnforceConsistentCase() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: enforceConsistentCaseFlattenFunc,
@@ -0,0 +1,46 @@
+//go:build (all || resource_policy_case_enforcement) && !exclude_resource_policy_case_enforcement
+// +build all resource_policy_case_enforcement
+// +build !exclude_resource_policy_case_enforcement
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the flatten/expand round trip path produces repeatable results
+func TestRepositoryEnforceConsistentCase_ExpandFlatten_Roundtrip(t *testing.T) {
+	// an empty repository ID scopes the policy to all repositories of the project
+	for _, repositoryID := range []string{uuid.New().String(), ""} {
+		projectID := uuid.New().String()
+		testPolicy := &policy.PolicyConfiguration{
+			Id:         converter.Int(1),
+			IsEnabled:  converter.Bool(true),
+			IsBlocking: converter.Bool(true),
+			Settings: map[string]interface{}{
+				"enforceConsistentCase": true,
+				"scope": []interface{}{
+					map[string]interface{}{"repositoryId": repositoryID},
+				},
+			},
+		}
+
+		resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryEnforceConsistentCase().Schema, nil)
+		require.Nil(t, enforceConsistentCaseFlattenFunc(resourceData, testPolicy, &projectID))
+		require.True(t, resourceData.Get("enforce_consistent_case").(bool))
+
+		expandedPolicy, expandedProjectID, err := enforceConsistentCaseExpandFunc(resourceData, CaseEnforcement)
+		require.Nil(t, err)
+		require.Equal(t, projectID, *expandedProjectID)
+
+		expandedSettings := expandedPolicy.Settings.(map[string]interface{})
+		require.Equal(t, true, expandedSettings["enforceConsistentCase"])
+		require.Equal(t, []map[string]interface{}{{"repositoryId": repositoryID}}, expandedSettings["scope"])
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 )
 
+// ResourceRepositoryFilePathPatterns schema and implementation for file path pattern repository policy resource
 func ResourceRepositoryFilePathPatterns() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: filePathPatternFlattenFunc,
@@ -33,7 +34,11 @@ func filePathPatternFlattenFunc(d *schema.ResourceData, policyConfig *policy.Pol
 	}
 
 	policySettings := policyConfig.Settings.(map[string]interface{})
-	_ = d.Set("filepath_patterns", policySettings["filenamePatterns"].([]interface{}))
+	if patterns, ok := policySettings["filenamePatterns"].([]interface{}); ok {
+		_ = d.Set("filepath_patterns", patterns)
+	} else {
+		_ = d.Set("filepath_patterns", nil)
+	}
 	return nil
 }
 
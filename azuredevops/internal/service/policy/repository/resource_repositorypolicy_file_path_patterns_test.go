@@ -0,0 +1,57 @@
+//go:build (all || resource_repositorypolicy_file_path_patterns) && !exclude_resource_repositorypolicy_file_path_patterns
+// +build all resource_repositorypolicy_file_path_patterns
+// +build !exclude_resource_repositorypolicy_file_path_patterns
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the flatten/expand round trip path keeps the blocked patterns
+func TestRepositoryFilePathPatterns_ExpandFlatten_Roundtrip(t *testing.T) {
+	projectID := uuid.New().String()
+	patterns := []interface{}{"*.pfx", "/secrets/*"}
+	testPolicy := &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Settings: map[string]interface{}{
+			"filenamePatterns": patterns,
+			"scope": []interface{}{
+				map[string]interface{}{},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryFilePathPatterns().Schema, nil)
+	require.Nil(t, filePathPatternFlattenFunc(resourceData, testPolicy, &projectID))
+
+	expandedPolicy, expandedProjectID, err := filePathPatternExpandFunc(resourceData, FilePathPattern)
+	require.Nil(t, err)
+	require.Equal(t, projectID, *expandedProjectID)
+	require.Equal(t, patterns, expandedPolicy.Settings.(map[string]interface{})["filenamePatterns"])
+}
+
+// verifies that a policy without patterns does not fail the read
+func TestRepositoryFilePathPatterns_Flatten_WithoutPatterns(t *testing.T) {
+	projectID := uuid.New().String()
+	testPolicy := &policy.PolicyConfiguration{
+		Id: converter.Int(1),
+		Settings: map[string]interface{}{
+			"scope": []interface{}{
+				map[string]interface{}{},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryFilePathPatterns().Schema, nil)
+	require.Nil(t, filePathPatternFlattenFunc(resourceData, testPolicy, &projectID))
+	require.Empty(t, resourceData.Get("filepath_patterns"))
+}
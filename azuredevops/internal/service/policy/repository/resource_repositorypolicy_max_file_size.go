@@ -7,8 +7,10 @@ import (
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
 )
 
+// UNIT number of bytes of one megabyte, the unit of max_file_size
 const UNIT = 1024 * 1024
 
+// ResourceRepositoryMaxFileSize schema and implementation for max file size repository policy resource
 func ResourceRepositoryMaxFileSize() *schema.Resource {
 	resource := genBasePolicyResource(&policyCrudArgs{
 		FlattenFunc: fileSizeFlattenFunc,
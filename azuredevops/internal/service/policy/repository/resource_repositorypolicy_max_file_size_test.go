@@ -0,0 +1,63 @@
+//go:build (all || resource_policy_file_size) && !exclude_resource_policy_file_size
+// +build all resource_policy_file_size
+// +build !exclude_resource_policy_file_size
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/policy"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+// verifies that the size is converted between megabytes and bytes for a policy limited to repositories
+func TestRepositoryMaxFileSize_ExpandFlatten_Roundtrip(t *testing.T) {
+	projectID := uuid.New().String()
+	repositoryID := uuid.New().String()
+	testPolicy := &policy.PolicyConfiguration{
+		Id:         converter.Int(1),
+		IsEnabled:  converter.Bool(true),
+		IsBlocking: converter.Bool(true),
+		Settings: map[string]interface{}{
+			"maximumGitBlobSizeInBytes": float64(5 * UNIT),
+			"scope": []interface{}{
+				map[string]interface{}{
+					"repositoryId": repositoryID,
+				},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryMaxFileSize().Schema, nil)
+	require.Nil(t, fileSizeFlattenFunc(resourceData, testPolicy, &projectID))
+	require.Equal(t, 5, resourceData.Get("max_file_size"))
+	require.Equal(t, []interface{}{repositoryID}, resourceData.Get("repository_ids"))
+
+	expandedPolicy, expandedProjectID, err := fileSizeExpandFunc(resourceData, FileSize)
+	require.Nil(t, err)
+	require.Equal(t, projectID, *expandedProjectID)
+	require.Equal(t, 5*UNIT, expandedPolicy.Settings.(map[string]interface{})["maximumGitBlobSizeInBytes"])
+}
+
+// verifies that a policy without repositories applies to all repositories in the project
+func TestRepositoryMaxFileSize_Flatten_ProjectScope(t *testing.T) {
+	projectID := uuid.New().String()
+	testPolicy := &policy.PolicyConfiguration{
+		Id: converter.Int(1),
+		Settings: map[string]interface{}{
+			"maximumGitBlobSizeInBytes": float64(UNIT),
+			"scope": []interface{}{
+				map[string]interface{}{},
+			},
+		},
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceRepositoryMaxFileSize().Schema, nil)
+	require.Nil(t, fileSizeFlattenFunc(resourceData, testPolicy, &projectID))
+	require.Equal(t, 1, resourceData.Get("max_file_size"))
+	require.Empty(t, resourceData.Get("repository_ids"))
+}
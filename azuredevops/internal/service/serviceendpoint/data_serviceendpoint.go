@@ -0,0 +1,46 @@
+package serviceendpoint
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataServiceEndpoint schema and implementation for looking up a service endpoint of any type
+func DataServiceEndpoint() *schema.Resource {
+	r := dataSourceGenBaseServiceEndpointResource(dataSourceServiceEndpointRead)
+	dataSourceMakeUnprotectedComputedSchema(r, "type")
+	dataSourceMakeUnprotectedComputedSchema(r, "url")
+	dataSourceMakeUnprotectedComputedSchema(r, "authorization_scheme")
+	r.Schema["is_ready"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	r.Schema["is_shared"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Computed: true,
+	}
+	return r
+}
+
+func dataSourceServiceEndpointRead(d *schema.ResourceData, m interface{}) error {
+	serviceEndpoint, projectID, err := dataSourceGetBaseServiceEndpoint(d, m)
+	if err != nil {
+		return err
+	}
+	if serviceEndpoint == nil {
+		return fmt.Errorf("Error looking up service endpoint!")
+	}
+
+	d.Set("service_endpoint_id", serviceEndpoint.Id.String())
+	doBaseFlattening(d, serviceEndpoint, projectID)
+	d.Set("type", converter.ToString(serviceEndpoint.Type, ""))
+	d.Set("url", converter.ToString(serviceEndpoint.Url, ""))
+	d.Set("is_ready", converter.ToBool(serviceEndpoint.IsReady, false))
+	d.Set("is_shared", converter.ToBool(serviceEndpoint.IsShared, false))
+	if serviceEndpoint.Authorization != nil {
+		d.Set("authorization_scheme", converter.ToString(serviceEndpoint.Authorization.Scheme, ""))
+	}
+	return nil
+}
@@ -15,7 +15,12 @@ import (
 // ResourceServiceEndpointArtifactory schema and implementation for Artifactory service endpoint resource
 func ResourceServiceEndpointArtifactory() *schema.Resource {
 	r := genBaseServiceEndpointResource(flattenServiceEndpointArtifactory, expandServiceEndpointArtifactory)
+	makeSchemaJFrogAuthentication(r, "Artifactory")
+	return r
+}
 
+// makeSchemaJFrogAuthentication adds the server URL and the credentials shared by the service endpoints of the JFrog products
+func makeSchemaJFrogAuthentication(r *schema.Resource, product string) {
 	r.Schema["url"] = &schema.Schema{
 		Type:     schema.TypeString,
 		Required: true,
@@ -31,14 +36,14 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 			}
 			return validation.IsURLWithHTTPorHTTPS(url, key)
 		},
-		Description: "Url for the Artifactory Server",
+		Description: fmt.Sprintf("Url for the %s Server", product),
 	}
 
 	patHashKey, patHashSchema := tfhelper.GenerateSecreteMemoSchema("token")
 	at := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"token": {
-				Description:      "The Artifactory access token.",
+				Description:      fmt.Sprintf("The %s access token.", product),
 				Type:             schema.TypeString,
 				Required:         true,
 				Sensitive:        true,
@@ -53,7 +58,7 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 	aup := &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"username": {
-				Description:      "The Artifactory user name.",
+				Description:      fmt.Sprintf("The %s user name.", product),
 				Type:             schema.TypeString,
 				Required:         true,
 				Sensitive:        true,
@@ -61,7 +66,7 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 			},
 			patHashKeyU: patHashSchemaU,
 			"password": {
-				Description:      "The Artifactory password.",
+				Description:      fmt.Sprintf("The %s password.", product),
 				Type:             schema.TypeString,
 				Required:         true,
 				Sensitive:        true,
@@ -87,14 +92,16 @@ func ResourceServiceEndpointArtifactory() *schema.Resource {
 		MaxItems: 1,
 		Elem:     aup,
 	}
-
-	return r
 }
 
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointArtifactory(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	return expandServiceEndpointJFrog(d, "artifactoryService")
+}
+
+func expandServiceEndpointJFrog(d *schema.ResourceData, endpointType string) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
-	serviceEndpoint.Type = converter.String("artifactoryService")
+	serviceEndpoint.Type = converter.String(endpointType)
 	serviceEndpoint.Url = converter.String(d.Get("url").(string))
 	authScheme := "Token"
 
@@ -102,11 +109,11 @@ func expandServiceEndpointArtifactory(d *schema.ResourceData) (*serviceendpoint.
 
 	if x, ok := d.GetOk("authentication_token"); ok {
 		authScheme = "Token"
-		msi := x.([]interface{})[0].(map[string]interface{})
+		msi, _ := x.([]interface{})[0].(map[string]interface{})
 		authParams["apitoken"] = expandSecret(msi, "token")
 	} else if x, ok := d.GetOk("authentication_basic"); ok {
 		authScheme = "UsernamePassword"
-		msi := x.([]interface{})[0].(map[string]interface{})
+		msi, _ := x.([]interface{})[0].(map[string]interface{})
 		authParams["username"] = expandSecret(msi, "username")
 		authParams["password"] = expandSecret(msi, "password")
 	}
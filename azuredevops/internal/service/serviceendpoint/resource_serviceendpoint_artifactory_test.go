@@ -79,6 +79,7 @@ func testServiceEndpointArtifactory_ExpandFlatten_Roundtrip(t *testing.T, ep *se
 	for _, ep := range []*serviceendpoint.ServiceEndpoint{ep, ep} {
 
 		resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointArtifactory().Schema, nil)
+		configureArtifactoryAuthentication(resourceData, ep)
 		flattenServiceEndpointArtifactory(resourceData, ep, id)
 
 		serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointArtifactory(resourceData)
@@ -103,6 +104,7 @@ func testServiceEndpointArtifactory_Create_DoesNotSwallowError(t *testing.T, ep
 
 	r := ResourceServiceEndpointArtifactory()
 	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	configureArtifactoryAuthentication(resourceData, ep)
 	flattenServiceEndpointArtifactory(resourceData, ep, id)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
@@ -132,6 +134,7 @@ func testServiceEndpointArtifactory_Read_DoesNotSwallowError(t *testing.T, ep *s
 
 	r := ResourceServiceEndpointArtifactory()
 	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	configureArtifactoryAuthentication(resourceData, ep)
 	flattenServiceEndpointArtifactory(resourceData, ep, id)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
@@ -164,6 +167,7 @@ func testServiceEndpointArtifactory_Delete_DoesNotSwallowError(t *testing.T, ep
 
 	r := ResourceServiceEndpointArtifactory()
 	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	configureArtifactoryAuthentication(resourceData, ep)
 	flattenServiceEndpointArtifactory(resourceData, ep, id)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
@@ -198,6 +202,7 @@ func testServiceEndpointArtifactory_Update_DoesNotSwallowError(t *testing.T, ep
 
 	r := ResourceServiceEndpointArtifactory()
 	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	configureArtifactoryAuthentication(resourceData, ep)
 	flattenServiceEndpointArtifactory(resourceData, ep, id)
 
 	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
@@ -223,3 +228,109 @@ func TestServiceEndpointArtifactory_Update_DoesNotSwallowErrorToken(t *testing.T
 func TestServiceEndpointArtifactory_Update_DoesNotSwallowErrorPassword(t *testing.T) {
 	testServiceEndpointArtifactory_Delete_DoesNotSwallowError(t, &artifactoryTestServiceEndpointPassword, artifactoryTestServiceEndpointProjectIDpassword)
 }
+
+// configureArtifactoryAuthentication sets the credentials of the service endpoint as they are configured,
+// the service does not return them on a read
+func configureArtifactoryAuthentication(d *schema.ResourceData, ep *serviceendpoint.ServiceEndpoint) {
+	params := *ep.Authorization.Parameters
+	if *ep.Authorization.Scheme == "Token" {
+		d.Set("authentication_token", []interface{}{map[string]interface{}{
+			"token": params["apitoken"],
+		}})
+		return
+	}
+	d.Set("authentication_basic", []interface{}{map[string]interface{}{
+		"username": params["username"],
+		"password": params["password"],
+	}})
+}
+
+var jfrogTestServiceEndpointID = uuid.New()
+var jfrogRandomServiceEndpointProjectID = uuid.New()
+var jfrogTestServiceEndpointProjectID = &jfrogRandomServiceEndpointProjectID
+
+func jfrogTestServiceEndpoint(endpointType string, authorization *serviceendpoint.EndpointAuthorization) *serviceendpoint.ServiceEndpoint {
+	return &serviceendpoint.ServiceEndpoint{
+		Authorization: authorization,
+		Id:            &jfrogTestServiceEndpointID,
+		Name:          converter.String("UNIT_TEST_CONN_NAME"),
+		Owner:         converter.String("library"),
+		Type:          converter.String(endpointType),
+		Url:           converter.String("https://jfrog.example.com/xray"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: jfrogTestServiceEndpointProjectID,
+				},
+				Name:        converter.String("UNIT_TEST_CONN_NAME"),
+				Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	}
+}
+
+var jfrogTestAuthorizationToken = &serviceendpoint.EndpointAuthorization{
+	Parameters: &map[string]string{
+		"apitoken": "JFROG_TEST_token",
+	},
+	Scheme: converter.String("Token"),
+}
+
+var jfrogTestAuthorizationPassword = &serviceendpoint.EndpointAuthorization{
+	Parameters: &map[string]string{
+		"username": "JFROG_TEST_username",
+		"password": "JFROG_TEST_password",
+	},
+	Scheme: converter.String("UsernamePassword"),
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint for all JFrog products and credentials
+func TestServiceEndpointJFrog_ExpandFlatten_Roundtrip(t *testing.T) {
+	resources := []struct {
+		endpointType string
+		resource     *schema.Resource
+		expand       func(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error)
+	}{
+		{"jfrogXrayService", ResourceServiceEndpointJFrogXray(), expandServiceEndpointJFrogXray},
+		{"jfrogDistributionService", ResourceServiceEndpointJFrogDistribution(), expandServiceEndpointJFrogDistribution},
+	}
+	for _, r := range resources {
+		for _, authorization := range []*serviceendpoint.EndpointAuthorization{jfrogTestAuthorizationToken, jfrogTestAuthorizationPassword} {
+			ep := jfrogTestServiceEndpoint(r.endpointType, authorization)
+			resourceData := schema.TestResourceDataRaw(t, r.resource.Schema, nil)
+			configureArtifactoryAuthentication(resourceData, ep)
+			flattenServiceEndpointArtifactory(resourceData, ep, jfrogTestServiceEndpointProjectID)
+
+			serviceEndpointAfterRoundTrip, projectID, err := r.expand(resourceData)
+
+			require.Nil(t, err)
+			require.Equal(t, *ep, *serviceEndpointAfterRoundTrip)
+			require.Equal(t, jfrogTestServiceEndpointProjectID, projectID)
+		}
+	}
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointJFrogXray_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointJFrogXray()
+	ep := jfrogTestServiceEndpoint("jfrogXrayService", jfrogTestAuthorizationToken)
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	configureArtifactoryAuthentication(resourceData, ep)
+	flattenServiceEndpointArtifactory(resourceData, ep, jfrogTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: ep}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
@@ -6,8 +6,15 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	azureCRAuthenticationSchemeServicePrincipal           = "ServicePrincipal"
+	azureCRAuthenticationSchemeWorkloadIdentityFederation = "WorkloadIdentityFederation"
 )
 
 // ResourceServiceEndpointAzureCR schema and implementation for ACR service endpoint resource
@@ -54,6 +61,50 @@ func ResourceServiceEndpointAzureCR() *schema.Resource {
 		Computed: true,
 	}
 
+	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("serviceprincipalkey")
+	r.Schema["credentials"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"serviceprincipalid": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.IsUUID,
+					Description:  "The client id of the existing service principal which should be used.",
+				},
+				"serviceprincipalkey": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "The service principal secret which should be used.",
+					Sensitive:        true,
+					DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+				},
+				secretHashKey: secretHashSchema,
+			},
+		},
+	}
+
+	r.Schema["service_endpoint_authentication_scheme"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The authentication scheme of the service endpoint",
+		Default:      azureCRAuthenticationSchemeServicePrincipal,
+		ValidateFunc: validation.StringInSlice([]string{azureCRAuthenticationSchemeServicePrincipal, azureCRAuthenticationSchemeWorkloadIdentityFederation}, false),
+	}
+
+	r.Schema["workload_identity_federation_issuer"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	r.Schema["workload_identity_federation_subject"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+
 	return r
 }
 
@@ -86,6 +137,38 @@ func expandServiceEndpointAzureCR(d *schema.ResourceData) (*serviceendpoint.Serv
 		"azureSpnPermissions":      d.Get("az_spn_role_permissions").(string),
 		"azureSpnRoleAssignmentId": d.Get("az_spn_role_assignment_id").(string),
 	}
+
+	authenticationScheme := d.Get("service_endpoint_authentication_scheme").(string)
+	if authenticationScheme == azureCRAuthenticationSchemeWorkloadIdentityFederation {
+		// the federated credential is configured on the service principal, so no secret is exchanged
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"tenantId":           d.Get("azurecr_spn_tenantid").(string),
+				"loginServer":        loginServer,
+				"scope":              scope,
+				"serviceprincipalid": d.Get("service_principal_id").(string),
+			},
+			Scheme: converter.String(azureCRAuthenticationSchemeWorkloadIdentityFederation),
+		}
+	}
+
+	// an existing service principal is used instead of letting Azure DevOps create one
+	if _, ok := d.GetOk("credentials"); ok {
+		credentials := d.Get("credentials").([]interface{})[0].(map[string]interface{})
+		serviceprincipalkey := credentials["serviceprincipalkey"].(string)
+		if authenticationScheme == azureCRAuthenticationSchemeServicePrincipal && serviceprincipalkey == "" {
+			return nil, nil, fmt.Errorf("credentials.serviceprincipalkey is required for the %s authentication scheme", authenticationScheme)
+		}
+		if authenticationScheme == azureCRAuthenticationSchemeWorkloadIdentityFederation && serviceprincipalkey != "" {
+			return nil, nil, fmt.Errorf("credentials.serviceprincipalkey cannot be used with the %s authentication scheme", authenticationScheme)
+		}
+		(*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"] = credentials["serviceprincipalid"].(string)
+		if serviceprincipalkey != "" {
+			(*serviceEndpoint.Authorization.Parameters)["serviceprincipalkey"] = serviceprincipalkey
+		}
+		(*serviceEndpoint.Data)["creationMode"] = "Manual"
+	}
+
 	serviceEndpoint.Type = converter.String("dockerregistry")
 	azureContainerRegistryURL := fmt.Sprintf("https://%s", loginServer)
 	serviceEndpoint.Url = converter.String(azureContainerRegistryURL)
@@ -106,6 +189,25 @@ func flattenServiceEndpointAzureCR(d *schema.ResourceData, serviceEndpoint *serv
 	d.Set("az_spn_role_assignment_id", (*serviceEndpoint.Data)["azureSpnRoleAssignmentId"])
 	d.Set("service_principal_id", (*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"])
 
+	authenticationScheme := azureCRAuthenticationSchemeServicePrincipal
+	if serviceEndpoint.Authorization.Scheme != nil && strings.EqualFold(*serviceEndpoint.Authorization.Scheme, azureCRAuthenticationSchemeWorkloadIdentityFederation) {
+		authenticationScheme = azureCRAuthenticationSchemeWorkloadIdentityFederation
+	}
+	d.Set("service_endpoint_authentication_scheme", authenticationScheme)
+	d.Set("workload_identity_federation_issuer", (*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationIssuer"])
+	d.Set("workload_identity_federation_subject", (*serviceEndpoint.Authorization.Parameters)["workloadIdentityFederationSubject"])
+
+	if (*serviceEndpoint.Data)["creationMode"] == "Manual" {
+		if authenticationScheme == azureCRAuthenticationSchemeServicePrincipal {
+			newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "credentials", d.Get("credentials.0").(map[string]interface{}), "serviceprincipalkey")
+			d.Set("credentials", flattenCredentials(d, serviceEndpoint, hashKey, newHash))
+		} else {
+			d.Set("credentials", []map[string]interface{}{{
+				"serviceprincipalid": (*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"],
+			}})
+		}
+	}
+
 	scope := (*serviceEndpoint.Authorization.Parameters)["scope"]
 	s := strings.SplitN(scope, "/", -1)
 	d.Set("resource_group", s[4])
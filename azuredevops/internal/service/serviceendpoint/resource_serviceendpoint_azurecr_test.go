@@ -78,6 +78,160 @@ func TestServiceEndpointAzureCR_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Nil(t, err)
 }
 
+var azureCRTestManualServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"authenticationType":  "spnKey",
+			"tenantId":            "aba07645-051c-44b4-b806-c34d33f3dcd1", //fake value
+			"loginServer":         "testacr.azurecr.io",
+			"scope":               scope,
+			"serviceprincipalid":  "e31eaaac-47da-4156-b433-9b0538c94b7e", //fake value
+			"serviceprincipalkey": "d96d8515-20b2-4413-8879-27c5d040cbc2", //fake value
+		},
+		Scheme: converter.String("ServicePrincipal"),
+	},
+	Data: &map[string]string{
+		"registryId":               scope,
+		"subscriptionId":           subscription_id,
+		"subscriptionName":         "testS",
+		"registrytype":             "ACR",
+		"appObjectId":              "",
+		"spnObjectId":              "",
+		"azureSpnPermissions":      "",
+		"azureSpnRoleAssignmentId": "",
+		"creationMode":             "Manual",
+	},
+	Id:    &azureCRTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("dockerregistry"),
+	Url:   converter.String("https://testacr.azurecr.io"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: azureCRTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+var azureCRTestWorkloadIdentityServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"tenantId":           "aba07645-051c-44b4-b806-c34d33f3dcd1", //fake value
+			"loginServer":        "testacr.azurecr.io",
+			"scope":              scope,
+			"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e", //fake value
+		},
+		Scheme: converter.String("WorkloadIdentityFederation"),
+	},
+	Data: &map[string]string{
+		"registryId":               scope,
+		"subscriptionId":           subscription_id,
+		"subscriptionName":         "testS",
+		"registrytype":             "ACR",
+		"appObjectId":              "",
+		"spnObjectId":              "",
+		"azureSpnPermissions":      "",
+		"azureSpnRoleAssignmentId": "",
+		"creationMode":             "Manual",
+	},
+	Id:    &azureCRTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("dockerregistry"),
+	Url:   converter.String("https://testacr.azurecr.io"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: azureCRTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint for an existing service principal
+func TestServiceEndpointAzureCR_ExpandFlatten_ExistingServicePrincipal(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureCR().Schema, nil)
+	resourceData.Set("credentials", []map[string]interface{}{{
+		"serviceprincipalid":  "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		"serviceprincipalkey": "d96d8515-20b2-4413-8879-27c5d040cbc2",
+	}})
+	flattenServiceEndpointAzureCR(resourceData, &azureCRTestManualServiceEndpoint, azureCRTestServiceEndpointProjectID)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointAzureCR(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, azureCRTestManualServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, azureCRTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint for workload identity federation
+func TestServiceEndpointAzureCR_ExpandFlatten_WorkloadIdentityFederation(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureCR().Schema, nil)
+	flattenServiceEndpointAzureCR(resourceData, &azureCRTestWorkloadIdentityServiceEndpoint, azureCRTestServiceEndpointProjectID)
+
+	require.Equal(t, "WorkloadIdentityFederation", resourceData.Get("service_endpoint_authentication_scheme"))
+	require.Equal(t, "e31eaaac-47da-4156-b433-9b0538c94b7e", resourceData.Get("credentials.0.serviceprincipalid"))
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointAzureCR(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, azureCRTestWorkloadIdentityServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, azureCRTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that the issuer and subject of the federated credential are exposed
+func TestServiceEndpointAzureCR_Flatten_WorkloadIdentityFederationIssuerAndSubject(t *testing.T) {
+	endpoint := azureCRTestWorkloadIdentityServiceEndpoint
+	endpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"tenantId":                          "aba07645-051c-44b4-b806-c34d33f3dcd1",
+			"scope":                             scope,
+			"serviceprincipalid":                "e31eaaac-47da-4156-b433-9b0538c94b7e",
+			"workloadIdentityFederationIssuer":  "https://vstoken.dev.azure.com/00000000-0000-0000-0000-000000000000",
+			"workloadIdentityFederationSubject": "sc://org/project/UNIT_TEST_CONN_NAME",
+		},
+		Scheme: converter.String("WorkloadIdentityFederation"),
+	}
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureCR().Schema, nil)
+	flattenServiceEndpointAzureCR(resourceData, &endpoint, azureCRTestServiceEndpointProjectID)
+
+	require.Equal(t, "https://vstoken.dev.azure.com/00000000-0000-0000-0000-000000000000", resourceData.Get("workload_identity_federation_issuer"))
+	require.Equal(t, "sc://org/project/UNIT_TEST_CONN_NAME", resourceData.Get("workload_identity_federation_subject"))
+}
+
+// verifies that a secret is required when an existing service principal is used with the ServicePrincipal scheme
+func TestServiceEndpointAzureCR_Expand_ExistingServicePrincipalRequiresKey(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureCR().Schema, nil)
+	flattenServiceEndpointAzureCR(resourceData, &azureCRTestServiceEndpoint, azureCRTestServiceEndpointProjectID)
+	resourceData.Set("credentials", []map[string]interface{}{{
+		"serviceprincipalid": "e31eaaac-47da-4156-b433-9b0538c94b7e",
+	}})
+
+	_, _, err := expandServiceEndpointAzureCR(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "serviceprincipalkey")
+}
+
+// verifies that a secret cannot be combined with workload identity federation
+func TestServiceEndpointAzureCR_Expand_WorkloadIdentityFederationRejectsKey(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureCR().Schema, nil)
+	flattenServiceEndpointAzureCR(resourceData, &azureCRTestWorkloadIdentityServiceEndpoint, azureCRTestServiceEndpointProjectID)
+	resourceData.Set("credentials", []map[string]interface{}{{
+		"serviceprincipalid":  "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		"serviceprincipalkey": "d96d8515-20b2-4413-8879-27c5d040cbc2",
+	}})
+
+	_, _, err := expandServiceEndpointAzureCR(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "WorkloadIdentityFederation")
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func TestServiceEndpointAzureCR_Create_DoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
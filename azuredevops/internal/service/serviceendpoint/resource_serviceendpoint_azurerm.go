@@ -12,6 +12,11 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
 )
 
+const (
+	azureRMAuthenticationSchemeServicePrincipal       = "ServicePrincipal"
+	azureRMAuthenticationSchemeManagedServiceIdentity = "ManagedServiceIdentity"
+)
+
 // ResourceServiceEndpointAzureRM schema and implementation for AzureRM service endpoint resource
 func ResourceServiceEndpointAzureRM() *schema.Resource {
 	r := genBaseServiceEndpointResource(flattenServiceEndpointAzureRM, expandServiceEndpointAzureRM)
@@ -57,6 +62,14 @@ func ResourceServiceEndpointAzureRM() *schema.Resource {
 			},
 		},
 	}
+	r.Schema["service_endpoint_authentication_scheme"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ForceNew:     true,
+		Description:  "The authentication scheme of the service endpoint",
+		Default:      azureRMAuthenticationSchemeServicePrincipal,
+		ValidateFunc: validation.StringInSlice([]string{azureRMAuthenticationSchemeServicePrincipal, azureRMAuthenticationSchemeManagedServiceIdentity}, false),
+	}
 	r.Schema["environment"] = &schema.Schema{
 		Type:         schema.TypeString,
 		Optional:     true,
@@ -89,6 +102,16 @@ func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.Serv
 		return nil, nil, err
 	}
 
+	authenticationScheme := d.Get("service_endpoint_authentication_scheme").(string)
+	if authenticationScheme == azureRMAuthenticationSchemeManagedServiceIdentity {
+		if _, ok := d.GetOk("credentials"); ok {
+			return nil, nil, fmt.Errorf("credentials cannot be used with the %s authentication scheme", authenticationScheme)
+		}
+		if _, ok := d.GetOk("resource_group"); ok {
+			return nil, nil, fmt.Errorf("resource_group cannot be used with the %s authentication scheme", authenticationScheme)
+		}
+	}
+
 	var scope string
 	var scopeLevel string
 
@@ -137,6 +160,17 @@ func expandServiceEndpointAzureRM(d *schema.ResourceData) (*serviceendpoint.Serv
 		(*serviceEndpoint.Data)["managementGroupName"] = d.Get("azurerm_management_group_name").(string)
 	}
 
+	// the managed identity of the agent is used, only the tenant of the identity is passed
+	if authenticationScheme == azureRMAuthenticationSchemeManagedServiceIdentity {
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"tenantid": d.Get("azurerm_spn_tenantid").(string),
+			},
+			Scheme: converter.String(azureRMAuthenticationSchemeManagedServiceIdentity),
+		}
+		(*serviceEndpoint.Data)["creationMode"] = "Manual"
+	}
+
 	if _, ok := d.GetOk("credentials"); ok {
 		credentials := d.Get("credentials").([]interface{})[0].(map[string]interface{})
 		(*serviceEndpoint.Authorization.Parameters)["serviceprincipalid"] = credentials["serviceprincipalid"].(string)
@@ -163,7 +197,13 @@ func flattenServiceEndpointAzureRM(d *schema.ResourceData, serviceEndpoint *serv
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	scope := (*serviceEndpoint.Authorization.Parameters)["scope"]
 
-	if (*serviceEndpoint.Data)["creationMode"] == "Manual" {
+	authenticationScheme := azureRMAuthenticationSchemeServicePrincipal
+	if serviceEndpoint.Authorization.Scheme != nil && strings.EqualFold(*serviceEndpoint.Authorization.Scheme, azureRMAuthenticationSchemeManagedServiceIdentity) {
+		authenticationScheme = azureRMAuthenticationSchemeManagedServiceIdentity
+	}
+	d.Set("service_endpoint_authentication_scheme", authenticationScheme)
+
+	if (*serviceEndpoint.Data)["creationMode"] == "Manual" && authenticationScheme == azureRMAuthenticationSchemeServicePrincipal {
 		newHash, hashKey := tfhelper.HelpFlattenSecretNested(d, "credentials", d.Get("credentials.0").(map[string]interface{}), "serviceprincipalkey")
 		credentials := flattenCredentials(d, serviceEndpoint, hashKey, newHash)
 		d.Set("credentials", credentials)
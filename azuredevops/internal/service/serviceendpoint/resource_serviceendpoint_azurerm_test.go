@@ -60,6 +60,35 @@ func getManualAuthServiceEndpoint() serviceendpoint.ServiceEndpoint {
 
 var azurermTestServiceEndpointsAzureRM = []serviceendpoint.ServiceEndpoint{
 	getManualAuthServiceEndpoint(),
+	{
+		Authorization: &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"tenantid": "aba07645-051c-44b4-b806-c34d33f3dcd1", //fake value
+			},
+			Scheme: converter.String("ManagedServiceIdentity"),
+		},
+		Data: &map[string]string{
+			"creationMode":     "Manual",
+			"environment":      "AzureCloud",
+			"scopeLevel":       "Subscription",
+			"subscriptionId":   "42125daf-72fd-417c-9ea7-080690625ad3", //fake value
+			"subscriptionName": "SUBSCRIPTION_TEST",
+		},
+		Id:    &azurermTestServiceEndpointAzureRMID,
+		Name:  converter.String("_AZURERM_UNIT_TEST_CONN_NAME"),
+		Owner: converter.String("library"), // Supported values are "library", "agentcloud"
+		Type:  converter.String("azurerm"),
+		Url:   converter.String("https://management.azure.com/"),
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{
+				ProjectReference: &serviceendpoint.ProjectReference{
+					Id: azurermTestServiceEndpointAzureRMProjectID,
+				},
+				Name:        converter.String("_AZURERM_UNIT_TEST_CONN_NAME"),
+				Description: converter.String("_AZURERM_UNIT_TEST_CONN_DESCRIPTION"),
+			},
+		},
+	},
 	{
 		Authorization: &serviceendpoint.EndpointAuthorization{
 			Parameters: &map[string]string{
@@ -280,6 +309,20 @@ func TestServiceEndpointAzureRM_Update_DoesNotSwallowError(t *testing.T) {
 //	require.Equal(t, "null", spnKeyProperty)
 //}
 
+// verifies that a managed identity cannot be combined with service principal credentials
+func TestServiceEndpointAzureRM_Expand_ManagedServiceIdentityRejectsCredentials(t *testing.T) {
+	resourceData := getResourceData(t, getManualAuthServiceEndpoint())
+	flattenServiceEndpointAzureRM(resourceData, &azurermTestServiceEndpointsAzureRM[1], azurermTestServiceEndpointAzureRMProjectID)
+	resourceData.Set("credentials", []map[string]interface{}{{
+		"serviceprincipalid":  "e31eaaac-47da-4156-b433-9b0538c94b7e",
+		"serviceprincipalkey": "d96d8515-20b2-4413-8879-27c5d040cbc2",
+	}})
+
+	_, _, err := expandServiceEndpointAzureRM(resourceData)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "ManagedServiceIdentity")
+}
+
 func getResourceData(t *testing.T, resource serviceendpoint.ServiceEndpoint) *schema.ResourceData {
 	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointAzureRM().Schema, nil)
 	if key := (*resource.Authorization.Parameters)["serviceprincipalkey"]; key != "" {
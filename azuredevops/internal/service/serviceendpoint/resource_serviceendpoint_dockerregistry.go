@@ -31,9 +31,21 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 		Description:      "The DockerRegistry password which should be used.",
 		Sensitive:        true,
 		DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+		ConflictsWith:    []string{"docker_token"},
 	}
 	secretHashKey, secretHashSchema := tfhelper.GenerateSecreteMemoSchema("docker_password")
 	r.Schema[secretHashKey] = secretHashSchema
+	r.Schema["docker_token"] = &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		DefaultFunc:      schema.EnvDefaultFunc("AZDO_DOCKERREGISTRY_SERVICE_CONNECTION_TOKEN", nil),
+		Description:      "The DockerRegistry access token which should be used instead of a password.",
+		Sensitive:        true,
+		DiffSuppressFunc: tfhelper.DiffFuncSuppressSecretChanged,
+		ConflictsWith:    []string{"docker_password"},
+	}
+	tokenHashKey, tokenHashSchema := tfhelper.GenerateSecreteMemoSchema("docker_token")
+	r.Schema[tokenHashKey] = tokenHashSchema
 	r.Schema["docker_email"] = &schema.Schema{
 		Type:        schema.TypeString,
 		Optional:    true,
@@ -56,11 +68,16 @@ func ResourceServiceEndpointDockerRegistry() *schema.Resource {
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointDockerRegistry(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
+	// access tokens are passed to the registry in place of the password
+	password := d.Get("docker_password").(string)
+	if token := d.Get("docker_token").(string); token != "" {
+		password = token
+	}
 	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
 		Parameters: &map[string]string{
 			"registry": d.Get("docker_registry").(string),
 			"username": d.Get("docker_username").(string),
-			"password": d.Get("docker_password").(string),
+			"password": password,
 			"email":    d.Get("docker_email").(string),
 		},
 		Scheme: converter.String("UsernamePassword"),
@@ -79,7 +96,11 @@ func flattenServiceEndpointDockerRegistry(d *schema.ResourceData, serviceEndpoin
 	d.Set("docker_registry", (*serviceEndpoint.Authorization.Parameters)["registry"])
 	d.Set("docker_email", (*serviceEndpoint.Authorization.Parameters)["email"])
 	d.Set("docker_username", (*serviceEndpoint.Authorization.Parameters)["username"])
-	tfhelper.HelpFlattenSecret(d, "docker_password")
-	d.Set("docker_password", (*serviceEndpoint.Authorization.Parameters)["password"])
+	if d.Get("docker_token").(string) != "" {
+		tfhelper.HelpFlattenSecret(d, "docker_token")
+	} else {
+		tfhelper.HelpFlattenSecret(d, "docker_password")
+		d.Set("docker_password", (*serviceEndpoint.Authorization.Parameters)["password"])
+	}
 	d.Set("registry_type", (*serviceEndpoint.Data)["registrytype"])
 }
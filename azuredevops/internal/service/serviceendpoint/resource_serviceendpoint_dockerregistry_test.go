@@ -64,6 +64,20 @@ func TestServiceEndpointDockerRegistry_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// verifies that an access token is sent as the password of the service endpoint
+func TestServiceEndpointDockerRegistry_ExpandFlatten_Token(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointDockerRegistry().Schema, nil)
+	resourceData.Set("docker_token", "DH_TEST_password")
+	flattenServiceEndpointDockerRegistry(resourceData, &dockerRegistryTestServiceEndpoint, dockerRegistryTestServiceEndpointProjectID)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointDockerRegistry(resourceData)
+
+	require.Equal(t, dockerRegistryTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, dockerRegistryTestServiceEndpointProjectID, projectID)
+	require.Equal(t, "", resourceData.Get("docker_password"))
+	require.Nil(t, err)
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func TestServiceEndpointDockerRegistry_Create_DoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,107 @@
+package serviceendpoint
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+const (
+	gcpSchemeJWT       = "JWT"
+	gcpDefaultTokenURI = "https://oauth2.googleapis.com/token"
+)
+
+// ResourceServiceEndpointGcp schema and implementation for Google Cloud service endpoint resource. The
+// GoogleCloudServiceEndpoint type only declares the JWT authorization scheme, so Workload Identity Federation
+// is not supported.
+func ResourceServiceEndpointGcp() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointGcp, expandServiceEndpointGcp)
+	r.Schema["gcp_project_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The ID of the Google Cloud project.",
+	}
+	r.Schema["scope"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Default:      "https://www.googleapis.com/auth/cloud-platform",
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		Description:  "The OAuth scope requested for the access token.",
+	}
+	r.Schema["credentials_json"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Sensitive:    true,
+		DefaultFunc:  schema.EnvDefaultFunc("AZDO_GCP_SERVICE_CONNECTION_CREDENTIALS_JSON", nil),
+		ValidateFunc: validation.StringIsJSON,
+		Description:  "The content of the JSON key file of the service account.",
+	}
+	return r
+}
+
+// gcpServiceAccountKey the fields of a service account JSON key file used by the service endpoint
+type gcpServiceAccountKey struct {
+	Type        string `json:"type"`
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointGcp(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	serviceEndpoint, projectID := doBaseExpansion(d)
+	serviceEndpoint.Type = converter.String("GoogleCloudServiceEndpoint")
+	serviceEndpoint.Url = converter.String("https://www.googleapis.com/")
+	serviceEndpoint.Data = &map[string]string{
+		"project": d.Get("gcp_project_id").(string),
+	}
+
+	// credentials_json is unknown after an import until it is configured
+	key := gcpServiceAccountKey{TokenURI: gcpDefaultTokenURI}
+	if credentials := d.Get("credentials_json").(string); credentials != "" {
+		if err := json.Unmarshal([]byte(credentials), &key); err != nil {
+			return nil, nil, fmt.Errorf("parsing credentials_json: %+v", err)
+		}
+		if key.Type != "service_account" || key.ClientEmail == "" || key.PrivateKey == "" {
+			return nil, nil, fmt.Errorf("credentials_json must be the JSON key of a service account")
+		}
+		if key.TokenURI == "" {
+			key.TokenURI = gcpDefaultTokenURI
+		}
+	}
+
+	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"Issuer":     key.ClientEmail,
+			"Audience":   key.TokenURI,
+			"Scope":      d.Get("scope").(string),
+			"PrivateKey": key.PrivateKey,
+		},
+		Scheme: converter.String(gcpSchemeJWT),
+	}
+	return serviceEndpoint, projectID, nil
+}
+
+// Convert AzDO data structure to internal Terraform data structure. The service does not return the private key,
+// so credentials_json keeps the configured value.
+func flattenServiceEndpointGcp(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
+	doBaseFlattening(d, serviceEndpoint, projectID)
+
+	if serviceEndpoint.Data != nil {
+		d.Set("gcp_project_id", (*serviceEndpoint.Data)["project"])
+	}
+	if serviceEndpoint.Authorization == nil || serviceEndpoint.Authorization.Parameters == nil {
+		return
+	}
+
+	parameters := *serviceEndpoint.Authorization.Parameters
+	if scope, ok := parameters["Scope"]; ok && scope != "" {
+		d.Set("scope", scope)
+	}
+}
@@ -65,8 +65,10 @@ func expandServiceEndpointGenericGit(d *schema.ResourceData) (*serviceendpoint.S
 func flattenServiceEndpointGenericGit(d *schema.ResourceData, serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) {
 	doBaseFlattening(d, serviceEndpoint, projectID)
 	d.Set("repository_url", *serviceEndpoint.Url)
-	if v, err := strconv.ParseBool((*serviceEndpoint.Data)["accessExternalGitServer"]); err != nil {
-		d.Set("enable_pipelines_access", v)
+	if serviceEndpoint.Data != nil {
+		if v, err := strconv.ParseBool((*serviceEndpoint.Data)["accessExternalGitServer"]); err == nil {
+			d.Set("enable_pipelines_access", v)
+		}
 	}
 	d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
 	tfhelper.HelpFlattenSecret(d, "password")
@@ -0,0 +1,114 @@
+//go:build (all || resource_serviceendpoint_generic) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_generic
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var genericTestServiceEndpointID = uuid.New()
+var genericRandomServiceEndpointProjectID = uuid.New()
+var genericTestServiceEndpointProjectID = &genericRandomServiceEndpointProjectID
+
+var genericTestServiceEndpoint = serviceendpoint.ServiceEndpoint{
+	Authorization: &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "GENERIC_TEST_username",
+			"password": "GENERIC_TEST_password",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	},
+	Id:    &genericTestServiceEndpointID,
+	Name:  converter.String("UNIT_TEST_CONN_NAME"),
+	Owner: converter.String("library"),
+	Type:  converter.String("generic"),
+	Url:   converter.String("https://server.example.com"),
+	ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+		{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: genericTestServiceEndpointProjectID,
+			},
+			Name:        converter.String("UNIT_TEST_CONN_NAME"),
+			Description: converter.String("UNIT_TEST_CONN_DESCRIPTION"),
+		},
+	},
+}
+
+// verifies that the flatten/expand round trip yields the same service endpoint and keeps the configured password,
+// which is never returned by the service
+func TestServiceEndpointGeneric_ExpandFlatten_Roundtrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointGeneric().Schema, nil)
+	resourceData.Set("password", "GENERIC_TEST_password")
+	flattenServiceEndpointGeneric(resourceData, &genericTestServiceEndpoint, genericTestServiceEndpointProjectID)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointGeneric(resourceData)
+
+	require.Nil(t, err)
+	require.Equal(t, genericTestServiceEndpoint, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, genericTestServiceEndpointProjectID, projectID)
+}
+
+// verifies that if an error is produced on create, the error is not swallowed
+func TestServiceEndpointGeneric_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGeneric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("password", "GENERIC_TEST_password")
+	flattenServiceEndpointGeneric(resourceData, &genericTestServiceEndpoint, genericTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.CreateServiceEndpointArgs{Endpoint: &genericTestServiceEndpoint}
+	buildClient.
+		EXPECT().
+		CreateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("CreateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Create(resourceData, clients)
+	require.Contains(t, err.Error(), "CreateServiceEndpoint() Failed")
+}
+
+// verifies that if an error is produced on an update, it is not swallowed
+func TestServiceEndpointGeneric_Update_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	r := ResourceServiceEndpointGeneric()
+	resourceData := schema.TestResourceDataRaw(t, r.Schema, nil)
+	resourceData.Set("password", "GENERIC_TEST_password")
+	flattenServiceEndpointGeneric(resourceData, &genericTestServiceEndpoint, genericTestServiceEndpointProjectID)
+
+	buildClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: buildClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.UpdateServiceEndpointArgs{
+		Endpoint:   &genericTestServiceEndpoint,
+		EndpointId: genericTestServiceEndpoint.Id,
+	}
+
+	buildClient.
+		EXPECT().
+		UpdateServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(nil, errors.New("UpdateServiceEndpoint() Failed")).
+		Times(1)
+
+	err := r.Update(resourceData, clients)
+	require.Contains(t, err.Error(), "UpdateServiceEndpoint() Failed")
+}
@@ -0,0 +1,31 @@
+package serviceendpoint
+
+import (
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+)
+
+// ResourceServiceEndpointJFrogXray schema and implementation for JFrog Xray service endpoint resource
+func ResourceServiceEndpointJFrogXray() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointArtifactory, expandServiceEndpointJFrogXray)
+	makeSchemaJFrogAuthentication(r, "Xray")
+	return r
+}
+
+// ResourceServiceEndpointJFrogDistribution schema and implementation for JFrog Distribution service endpoint resource
+func ResourceServiceEndpointJFrogDistribution() *schema.Resource {
+	r := genBaseServiceEndpointResource(flattenServiceEndpointArtifactory, expandServiceEndpointJFrogDistribution)
+	makeSchemaJFrogAuthentication(r, "Distribution")
+	return r
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointJFrogXray(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	return expandServiceEndpointJFrog(d, "jfrogXrayService")
+}
+
+// Convert internal Terraform data structure to an AzDO data structure
+func expandServiceEndpointJFrogDistribution(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
+	return expandServiceEndpointJFrog(d, "jfrogDistributionService")
+}
@@ -139,6 +139,12 @@ func makeSchemaServiceAccount(r *schema.Resource) {
 					return true
 				},
 			},
+			"accept_untrusted_certs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enable this if your authentication uses untrusted certificates",
+			},
 		},
 	}
 	makeProtectedSchema(resourceElemSchema, "ca_cert", "AZDO_KUBERNETES_SERVICE_CONNECTION_SERVICE_ACCOUNT_CERT", "Secret cert")
@@ -243,7 +249,8 @@ func expandServiceEndpointKubernetes(d *schema.ResourceData) (*serviceendpoint.S
 		}
 
 		serviceEndpoint.Data = &map[string]string{
-			"authorizationType": "ServiceAccount",
+			"authorizationType":    "ServiceAccount",
+			"acceptUntrustedCerts": strconv.FormatBool(configuration["accept_untrusted_certs"].(bool)),
 		}
 	}
 
@@ -302,25 +309,28 @@ func flattenServiceEndpointKubernetes(d *schema.ResourceData, serviceEndpoint *s
 	case "ServiceAccount":
 		var serviceAccount map[string]interface{}
 		serviceAccountSet := d.Get("service_account").([]interface{})
+		acceptUntrustedCerts, _ := strconv.ParseBool((*serviceEndpoint.Data)["acceptUntrustedCerts"])
 
 		if len(serviceAccountSet) == 0 {
 			newHashToken, hashKeyToken := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceAccount, nil, "token")
 			newHashCert, hashKeyCert := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceAccount, nil, "ca_cert")
 			serviceAccount = map[string]interface{}{
-				"token":      "",
-				"ca_cert":    "",
-				hashKeyToken: newHashToken,
-				hashKeyCert:  newHashCert,
+				"token":                  "",
+				"ca_cert":                "",
+				"accept_untrusted_certs": acceptUntrustedCerts,
+				hashKeyToken:             newHashToken,
+				hashKeyCert:              newHashCert,
 			}
 		} else {
 			configuration := serviceAccountSet[0].(map[string]interface{})
 			newHashToken, hashKeyToken := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceAccount, configuration, "token")
 			newHashCert, hashKeyCert := tfhelper.HelpFlattenSecretNested(d, resourceBlockServiceAccount, configuration, "ca_cert")
 			serviceAccount = map[string]interface{}{
-				"token":      configuration["token"].(string),
-				"ca_cert":    configuration["ca_cert"].(string),
-				hashKeyToken: newHashToken,
-				hashKeyCert:  newHashCert,
+				"token":                  configuration["token"].(string),
+				"ca_cert":                configuration["ca_cert"].(string),
+				"accept_untrusted_certs": acceptUntrustedCerts,
+				hashKeyToken:             newHashToken,
+				hashKeyCert:              newHashCert,
 			}
 		}
 
@@ -111,7 +111,8 @@ func createkubernetesTestServiceEndpointForServiceAccount() *serviceendpoint.Ser
 		"serviceAccountCertificate": "kubernetes_TEST_ca_cert",
 	}
 	serviceEndpoint.Data = &map[string]string{
-		"authorizationType": "ServiceAccount",
+		"authorizationType":    "ServiceAccount",
+		"acceptUntrustedCerts": "true",
 	}
 
 	return &serviceEndpoint
@@ -493,8 +494,9 @@ func TestServiceEndpointKubernetesForServiceAccountUpdateDoesNotSwallowError(t *
 func configureServiceAccount(d *schema.ResourceData) {
 	d.Set("service_account", &[]map[string]interface{}{
 		{
-			"token":   "kubernetes_TEST_api_token",
-			"ca_cert": "kubernetes_TEST_ca_cert",
+			"token":                  "kubernetes_TEST_api_token",
+			"ca_cert":                "kubernetes_TEST_ca_cert",
+			"accept_untrusted_certs": true,
 		},
 	})
 }
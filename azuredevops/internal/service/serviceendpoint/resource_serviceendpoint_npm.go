@@ -1,6 +1,8 @@
 package serviceendpoint
 
 import (
+	"strings"
+
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -21,22 +23,50 @@ func ResourceServiceEndpointNpm() *schema.Resource {
 
 	r.Schema["access_token"] = &schema.Schema{
 		Type:         schema.TypeString,
-		Required:     true,
+		Optional:     true,
 		Sensitive:    true,
 		ValidateFunc: validation.StringIsNotWhiteSpace,
+		ExactlyOneOf: []string{"access_token", "username"},
 		Description:  "The access token for npm registry",
 	}
+
+	r.Schema["username"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		RequiredWith: []string{"password"},
+		Description:  "The username for npm registry",
+	}
+
+	r.Schema["password"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Sensitive:    true,
+		ValidateFunc: validation.StringIsNotWhiteSpace,
+		RequiredWith: []string{"username"},
+		Description:  "The password for npm registry",
+	}
 	return r
 }
 
 // Convert internal Terraform data structure to an AzDO data structure
 func expandServiceEndpointNpm(d *schema.ResourceData) (*serviceendpoint.ServiceEndpoint, *uuid.UUID, error) {
 	serviceEndpoint, projectID := doBaseExpansion(d)
-	serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
-		Parameters: &map[string]string{
-			"apitoken": d.Get("access_token").(string),
-		},
-		Scheme: converter.String("Token"),
+	if username, ok := d.GetOk("username"); ok {
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"username": username.(string),
+				"password": d.Get("password").(string),
+			},
+			Scheme: converter.String("UsernamePassword"),
+		}
+	} else {
+		serviceEndpoint.Authorization = &serviceendpoint.EndpointAuthorization{
+			Parameters: &map[string]string{
+				"apitoken": d.Get("access_token").(string),
+			},
+			Scheme: converter.String("Token"),
+		}
 	}
 	serviceEndpoint.Type = converter.String("externalnpmregistry")
 	serviceEndpoint.Url = converter.String(d.Get("url").(string))
@@ -48,5 +78,13 @@ func flattenServiceEndpointNpm(d *schema.ResourceData, serviceEndpoint *servicee
 	doBaseFlattening(d, serviceEndpoint, projectID)
 
 	d.Set("url", *serviceEndpoint.Url)
-	d.Set("access_token", d.Get("access_token").(string))
+	if serviceEndpoint.Authorization != nil && serviceEndpoint.Authorization.Scheme != nil &&
+		strings.EqualFold(*serviceEndpoint.Authorization.Scheme, "UsernamePassword") {
+		if serviceEndpoint.Authorization.Parameters != nil {
+			d.Set("username", (*serviceEndpoint.Authorization.Parameters)["username"])
+		}
+		d.Set("password", d.Get("password").(string))
+	} else {
+		d.Set("access_token", d.Get("access_token").(string))
+	}
 }
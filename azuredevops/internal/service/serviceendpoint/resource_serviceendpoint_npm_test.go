@@ -58,6 +58,29 @@ func TestServiceEndpointNpm_ExpandFlatten_Roundtrip(t *testing.T) {
 	require.Nil(t, err)
 }
 
+// verifies that the flatten/expand round trip yields the same service endpoint for username and password authentication
+func TestServiceEndpointNpm_ExpandFlatten_UsernamePasswordRoundtrip(t *testing.T) {
+	npmTestServiceEndpointUsernamePassword := npmTestServiceEndpoint
+	npmTestServiceEndpointUsernamePassword.Authorization = &serviceendpoint.EndpointAuthorization{
+		Parameters: &map[string]string{
+			"username": "NPM_TEST_username",
+			"password": "NPM_TEST_password",
+		},
+		Scheme: converter.String("UsernamePassword"),
+	}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointNpm().Schema, nil)
+	resourceData.Set("password", "NPM_TEST_password")
+	flattenServiceEndpointNpm(resourceData, &npmTestServiceEndpointUsernamePassword, npmTestServiceEndpointProjectID)
+
+	serviceEndpointAfterRoundTrip, projectID, err := expandServiceEndpointNpm(resourceData)
+
+	require.Equal(t, npmTestServiceEndpointUsernamePassword, *serviceEndpointAfterRoundTrip)
+	require.Equal(t, npmTestServiceEndpointProjectID, projectID)
+	require.Equal(t, "NPM_TEST_username", resourceData.Get("username"))
+	require.Nil(t, err)
+}
+
 // verifies that if an error is produced on create, the error is not swallowed
 func TestServiceEndpointNpm_Create_DoesNotSwallowError(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,82 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/roleassignment"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	projectServiceEndpointRoleScope = "distributedtask.project.serviceendpointrole"
+	serviceEndpointRoleScope        = "distributedtask.serviceendpointrole"
+)
+
+// ResourceServiceEndpointRoleAssignment schema and implementation for role assignments on service endpoints
+func ResourceServiceEndpointRoleAssignment() *schema.Resource {
+	return roleassignment.Resource(map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+		"service_endpoint_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}, []string{"Reader", "User", "Administrator"}, getServiceEndpointRoleAssignmentScope, resourceServiceEndpointRoleAssignmentImport)
+}
+
+// resourceServiceEndpointRoleAssignmentImport imports a role assignment by an ID that looks like one of the following:
+//
+//	<project>/<identity ID>                          for a role assignment on all service endpoints of a project
+//	<project>/<service endpoint ID>/<identity ID>    for a role assignment on a service endpoint
+func resourceServiceEndpointRoleAssignmentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<identity ID> or <project>/<service endpoint ID>/<identity ID>", d.Id())
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<identity ID> or <project>/<service endpoint ID>/<identity ID>", d.Id())
+		}
+	}
+
+	identityID := parts[len(parts)-1]
+	if _, err := uuid.Parse(identityID); err != nil {
+		return nil, fmt.Errorf("identity ID was expected to be a UUID, but was not: %+v", err)
+	}
+
+	if len(parts) == 3 {
+		if _, err := uuid.Parse(parts[1]); err != nil {
+			return nil, fmt.Errorf("service endpoint ID was expected to be a UUID, but was not: %+v", err)
+		}
+		d.Set("service_endpoint_id", parts[1])
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("project_id", projectID)
+	d.SetId(identityID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getServiceEndpointRoleAssignmentScope returns the security role scope and the ID of the resource the role is assigned on.
+// Service endpoints are identified by the project and service endpoint ID.
+func getServiceEndpointRoleAssignmentScope(d *schema.ResourceData) (string, string) {
+	projectID := d.Get("project_id").(string)
+	if serviceEndpointID, ok := d.GetOk("service_endpoint_id"); ok {
+		return serviceEndpointRoleScope, fmt.Sprintf("%s_%s", projectID, serviceEndpointID.(string))
+	}
+	return projectServiceEndpointRoleScope, projectID
+}
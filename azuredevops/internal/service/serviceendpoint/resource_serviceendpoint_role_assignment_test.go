@@ -0,0 +1,102 @@
+//go:build (all || resource_serviceendpoint_role_assignment) && !exclude_resource_serviceendpoint_role_assignment
+// +build all resource_serviceendpoint_role_assignment
+// +build !exclude_resource_serviceendpoint_role_assignment
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles"
+	"github.com/stretchr/testify/require"
+)
+
+var testServiceEndpointRoleProjectID = uuid.New()
+var testServiceEndpointRoleEndpointID = uuid.New()
+var testServiceEndpointRoleIdentityID = uuid.New()
+
+func getServiceEndpointRoleAssignmentResourceData(t *testing.T, serviceEndpointID string) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointRoleAssignment().Schema, nil)
+	resourceData.Set("project_id", testServiceEndpointRoleProjectID.String())
+	resourceData.Set("identity_id", testServiceEndpointRoleIdentityID.String())
+	resourceData.Set("role_name", "Administrator")
+	if serviceEndpointID != "" {
+		resourceData.Set("service_endpoint_id", serviceEndpointID)
+	}
+	return resourceData
+}
+
+func TestServiceEndpointRoleAssignment_Scope(t *testing.T) {
+	scopeID, resourceID := getServiceEndpointRoleAssignmentScope(getServiceEndpointRoleAssignmentResourceData(t, ""))
+	require.Equal(t, "distributedtask.project.serviceendpointrole", scopeID)
+	require.Equal(t, testServiceEndpointRoleProjectID.String(), resourceID)
+
+	scopeID, resourceID = getServiceEndpointRoleAssignmentScope(getServiceEndpointRoleAssignmentResourceData(t, testServiceEndpointRoleEndpointID.String()))
+	require.Equal(t, "distributedtask.serviceendpointrole", scopeID)
+	require.Equal(t, testServiceEndpointRoleProjectID.String()+"_"+testServiceEndpointRoleEndpointID.String(), resourceID)
+}
+
+func TestServiceEndpointRoleAssignment_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getServiceEndpointRoleAssignmentResourceData(t, testServiceEndpointRoleEndpointID.String())
+	securityRolesClient.
+		EXPECT().
+		SetRoleAssignments(clients.Ctx, securityroles.SetRoleAssignmentsArgs{
+			RoleAssignments: &[]securityroles.UserRoleAssignmentRef{{
+				RoleName: converter.String("Administrator"),
+				UserId:   &testServiceEndpointRoleIdentityID,
+			}},
+			ScopeId:    converter.String("distributedtask.serviceendpointrole"),
+			ResourceId: converter.String(testServiceEndpointRoleProjectID.String() + "_" + testServiceEndpointRoleEndpointID.String()),
+		}).
+		Return(nil, errors.New("SetRoleAssignments() Failed")).
+		Times(1)
+
+	err := ResourceServiceEndpointRoleAssignment().Create(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "SetRoleAssignments() Failed")
+}
+
+func TestServiceEndpointRoleAssignment_Read_ClearsRemovedAssignment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getServiceEndpointRoleAssignmentResourceData(t, "")
+	resourceData.SetId(testServiceEndpointRoleIdentityID.String())
+	inherited := securityroles.RoleAccessValues.Inherited
+	securityRolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, securityroles.GetRoleAssignmentsArgs{
+			ScopeId:    converter.String("distributedtask.project.serviceendpointrole"),
+			ResourceId: converter.String(testServiceEndpointRoleProjectID.String()),
+		}).
+		Return(&[]securityroles.RoleAssignment{
+			{
+				Access:   &inherited,
+				Identity: &webapi.IdentityRef{Id: converter.String(testServiceEndpointRoleIdentityID.String())},
+				Role:     &securityroles.SecurityRole{Name: converter.String("Reader")},
+			},
+		}, nil).
+		Times(1)
+
+	err := ResourceServiceEndpointRoleAssignment().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
@@ -0,0 +1,299 @@
+package serviceendpoint
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceServiceEndpointShare schema and implementation for sharing a service endpoint with another project
+func ResourceServiceEndpointShare() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceServiceEndpointShareCreate,
+		Read:   resourceServiceEndpointShareRead,
+		Update: resourceServiceEndpointShareUpdate,
+		Delete: resourceServiceEndpointShareDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceServiceEndpointShareImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"owner_project_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+		},
+	}
+}
+
+func resourceServiceEndpointShareCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	serviceEndpointID, projectID, err := getServiceEndpointShareIDs(d)
+	if err != nil {
+		return err
+	}
+
+	ownerProjectID, err := getServiceEndpointShareOwnerProjectID(d)
+	if err != nil {
+		return err
+	}
+	if err := validateServiceEndpointShareProject(clients, serviceEndpointID, projectID, ownerProjectID); err != nil {
+		return err
+	}
+
+	err = clients.ServiceEndpointClient.ShareServiceEndpoint(clients.Ctx, serviceendpoint.ShareServiceEndpointArgs{
+		EndpointId: serviceEndpointID,
+		EndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{{
+			ProjectReference: &serviceendpoint.ProjectReference{
+				Id: projectID,
+			},
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		}},
+	})
+	if err != nil {
+		return fmt.Errorf(" sharing service endpoint %s with project %s: %+v", serviceEndpointID, projectID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", projectID, serviceEndpointID))
+	return resourceServiceEndpointShareRead(d, m)
+}
+
+func resourceServiceEndpointShareRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	serviceEndpointID, projectID, err := getServiceEndpointShareIDs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: serviceEndpointID,
+		Project:    converter.String(projectID.String()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading service endpoint %s in project %s: %+v", serviceEndpointID, projectID, err)
+	}
+
+	reference := findServiceEndpointProjectReference(serviceEndpoint, projectID)
+	if reference == nil {
+		// e.g. the service endpoint has been deleted or is no longer shared with the project
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", converter.ToString(reference.Name, ""))
+	d.Set("description", converter.ToString(reference.Description, ""))
+	return nil
+}
+
+// resourceServiceEndpointShareUpdate only updates the owner project, which is not stored by Azure DevOps
+func resourceServiceEndpointShareUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	serviceEndpointID, projectID, err := getServiceEndpointShareIDs(d)
+	if err != nil {
+		return err
+	}
+	ownerProjectID, err := getServiceEndpointShareOwnerProjectID(d)
+	if err != nil {
+		return err
+	}
+	if err := validateServiceEndpointShareProject(clients, serviceEndpointID, projectID, ownerProjectID); err != nil {
+		return err
+	}
+	return resourceServiceEndpointShareRead(d, m)
+}
+
+func resourceServiceEndpointShareDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	serviceEndpointID, projectID, err := getServiceEndpointShareIDs(d)
+	if err != nil {
+		return err
+	}
+
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: serviceEndpointID,
+		Project:    converter.String(projectID.String()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading service endpoint %s in project %s: %+v", serviceEndpointID, projectID, err)
+	}
+	if findServiceEndpointProjectReference(serviceEndpoint, projectID) == nil {
+		d.SetId("")
+		return nil
+	}
+
+	ownerProjectID, err := getServiceEndpointShareOwnerProjectID(d)
+	if err != nil {
+		return err
+	}
+
+	// removing the owner project or the last project from a service endpoint deletes the service endpoint itself
+	if isServiceEndpointOwnerProject(serviceEndpoint, projectID, ownerProjectID) {
+		log.Printf("[WARN] Project %s owns service endpoint %s, the service endpoint is not removed from it", projectID, serviceEndpointID)
+		d.SetId("")
+		return nil
+	}
+
+	err = clients.ServiceEndpointClient.DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+		EndpointId: serviceEndpointID,
+		ProjectIds: &[]string{projectID.String()},
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" removing service endpoint %s from project %s: %+v", serviceEndpointID, projectID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceServiceEndpointShareImport imports a share by an ID that looks like <project>/<service endpoint ID>
+func resourceServiceEndpointShareImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<service endpoint ID>", d.Id())
+	}
+
+	serviceEndpointID, err := uuid.Parse(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("service endpoint ID was expected to be a UUID, but was not: %+v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+	if err != nil {
+		return nil, err
+	}
+
+	projectUUID, err := uuid.Parse(projectID)
+	if err != nil {
+		return nil, fmt.Errorf(" parsing project ID %s: %+v", projectID, err)
+	}
+	clients := m.(*client.AggregatedClient)
+	if err := validateServiceEndpointShareProject(clients, &serviceEndpointID, &projectUUID, nil); err != nil {
+		return nil, err
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("service_endpoint_id", serviceEndpointID.String())
+	d.SetId(fmt.Sprintf("%s/%s", projectID, serviceEndpointID))
+	return []*schema.ResourceData{d}, nil
+}
+
+func getServiceEndpointShareIDs(d *schema.ResourceData) (*uuid.UUID, *uuid.UUID, error) {
+	serviceEndpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf(" parsing service endpoint ID %s: %+v", d.Get("service_endpoint_id").(string), err)
+	}
+	projectID, err := uuid.Parse(d.Get("project_id").(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf(" parsing project ID %s: %+v", d.Get("project_id").(string), err)
+	}
+	return &serviceEndpointID, &projectID, nil
+}
+
+// getServiceEndpointShareOwnerProjectID returns the ID of the project the service endpoint was created in, or nil if
+// it is not configured
+func getServiceEndpointShareOwnerProjectID(d *schema.ResourceData) (*uuid.UUID, error) {
+	value := d.Get("owner_project_id").(string)
+	if value == "" {
+		return nil, nil
+	}
+	ownerProjectID, err := uuid.Parse(value)
+	if err != nil {
+		return nil, fmt.Errorf(" parsing owner project ID %s: %+v", value, err)
+	}
+	return &ownerProjectID, nil
+}
+
+func findServiceEndpointProjectReference(serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID) *serviceendpoint.ServiceEndpointProjectReference {
+	if serviceEndpoint == nil || serviceEndpoint.Id == nil || serviceEndpoint.ServiceEndpointProjectReferences == nil {
+		return nil
+	}
+	for _, reference := range *serviceEndpoint.ServiceEndpointProjectReferences {
+		if reference.ProjectReference != nil && reference.ProjectReference.Id != nil && *reference.ProjectReference.Id == *projectID {
+			return &reference
+		}
+	}
+	return nil
+}
+
+// validateServiceEndpointShareProject returns an error if the project owns the service endpoint, as the owner project
+// is not a share and removing the service endpoint from it would delete the service endpoint
+func validateServiceEndpointShareProject(clients *client.AggregatedClient, serviceEndpointID *uuid.UUID, projectID *uuid.UUID, ownerProjectID *uuid.UUID) error {
+	serviceEndpoint, err := clients.ServiceEndpointClient.GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+		EndpointId: serviceEndpointID,
+		Project:    converter.String(projectID.String()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			// the service endpoint is not available in the project yet
+			return nil
+		}
+		return fmt.Errorf(" reading service endpoint %s in project %s: %+v", serviceEndpointID, projectID, err)
+	}
+	if isServiceEndpointOwnerProject(serviceEndpoint, projectID, ownerProjectID) {
+		return fmt.Errorf(" project %s owns service endpoint %s and cannot be managed as a share of it", projectID, serviceEndpointID)
+	}
+	return nil
+}
+
+// isServiceEndpointOwnerProject returns true if the project owns the service endpoint, or is the last project the
+// service endpoint is available in. The service endpoint does not report its owner, so the owner is the project
+// reference with the ID of the project the service endpoint was created in, if that is known.
+func isServiceEndpointOwnerProject(serviceEndpoint *serviceendpoint.ServiceEndpoint, projectID *uuid.UUID, ownerProjectID *uuid.UUID) bool {
+	if findServiceEndpointProjectReference(serviceEndpoint, projectID) == nil {
+		return false
+	}
+	if len(*serviceEndpoint.ServiceEndpointProjectReferences) == 1 {
+		return true
+	}
+	if ownerProjectID == nil {
+		return false
+	}
+	owner := findServiceEndpointProjectReference(serviceEndpoint, ownerProjectID)
+	return owner != nil && *owner.ProjectReference.Id == *projectID
+}
@@ -0,0 +1,254 @@
+//go:build (all || resource_serviceendpoint_share) && !exclude_serviceendpoints
+// +build all resource_serviceendpoint_share
+// +build !exclude_serviceendpoints
+
+package serviceendpoint
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/serviceendpoint"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var shareTestServiceEndpointID = uuid.New()
+var shareTestProjectID = uuid.New()
+
+// getSharedServiceEndpoint returns the service endpoint as available in the projects, in the order of the project references
+func getSharedServiceEndpoint(projectIDs ...uuid.UUID) *serviceendpoint.ServiceEndpoint {
+	references := []serviceendpoint.ServiceEndpointProjectReference{}
+	for i := range projectIDs {
+		references = append(references, serviceendpoint.ServiceEndpointProjectReference{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: &projectIDs[i]},
+			Name:             converter.String("connection"),
+		})
+	}
+	return &serviceendpoint.ServiceEndpoint{
+		Id:                               &shareTestServiceEndpointID,
+		ServiceEndpointProjectReferences: &references,
+	}
+}
+
+func getServiceEndpointShareResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceServiceEndpointShare().Schema, nil)
+	resourceData.Set("service_endpoint_id", shareTestServiceEndpointID.String())
+	resourceData.Set("project_id", shareTestProjectID.String())
+	resourceData.Set("name", "shared-connection")
+	resourceData.Set("description", "Shared by Terraform")
+	resourceData.SetId(shareTestProjectID.String() + "/" + shareTestServiceEndpointID.String())
+	return resourceData
+}
+
+// verifies that the service endpoint is shared with the project under the configured name and errors are not swallowed
+func TestServiceEndpointShare_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	expectedArgs := serviceendpoint.ShareServiceEndpointArgs{
+		EndpointId: &shareTestServiceEndpointID,
+		EndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{{
+			ProjectReference: &serviceendpoint.ProjectReference{Id: &shareTestProjectID},
+			Name:             converter.String("shared-connection"),
+			Description:      converter.String("Shared by Terraform"),
+		}},
+	}
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	serviceEndpointClient.
+		EXPECT().
+		ShareServiceEndpoint(clients.Ctx, expectedArgs).
+		Return(errors.New("ShareServiceEndpoint() Failed")).
+		Times(1)
+
+	resourceData := getServiceEndpointShareResourceData(t)
+	err := resourceServiceEndpointShareCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "ShareServiceEndpoint() Failed")
+}
+
+// verifies that the name and description of the project are read from the project reference
+func TestServiceEndpointShare_Read_UsesProjectReference(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	otherProjectID := uuid.New()
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, serviceendpoint.GetServiceEndpointDetailsArgs{
+			EndpointId: &shareTestServiceEndpointID,
+			Project:    converter.String(shareTestProjectID.String()),
+		}).
+		Return(&serviceendpoint.ServiceEndpoint{
+			Id: &shareTestServiceEndpointID,
+			ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+				{
+					ProjectReference: &serviceendpoint.ProjectReference{Id: &otherProjectID},
+					Name:             converter.String("connection"),
+				},
+				{
+					ProjectReference: &serviceendpoint.ProjectReference{Id: &shareTestProjectID},
+					Name:             converter.String("renamed-connection"),
+					Description:      converter.String(""),
+				},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := getServiceEndpointShareResourceData(t)
+	err := resourceServiceEndpointShareRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "renamed-connection", resourceData.Get("name"))
+	require.Equal(t, "", resourceData.Get("description"))
+	require.NotEmpty(t, resourceData.Id())
+}
+
+// verifies that the share is removed from the state if the project no longer references the service endpoint
+func TestServiceEndpointShare_Read_NotSharedClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(&serviceendpoint.ServiceEndpoint{}, nil).
+		Times(1)
+
+	resourceData := getServiceEndpointShareResourceData(t)
+	err := resourceServiceEndpointShareRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
+
+// verifies that only the project of the share is removed from the service endpoint, even if it is the first project
+// reference of the service endpoint
+func TestServiceEndpointShare_Delete_RemovesProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	ownerProjectID := uuid.New()
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(getSharedServiceEndpoint(shareTestProjectID, ownerProjectID), nil).
+		Times(1)
+
+	serviceEndpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(clients.Ctx, serviceendpoint.DeleteServiceEndpointArgs{
+			EndpointId: &shareTestServiceEndpointID,
+			ProjectIds: &[]string{shareTestProjectID.String()},
+		}).
+		Return(errors.New("DeleteServiceEndpoint() Failed")).
+		Times(1)
+
+	resourceData := getServiceEndpointShareResourceData(t)
+	resourceData.Set("owner_project_id", ownerProjectID.String())
+	err := resourceServiceEndpointShareDelete(resourceData, clients)
+	require.Contains(t, err.Error(), "DeleteServiceEndpoint() Failed")
+}
+
+// verifies that the project owning the service endpoint cannot be managed as a share, even if it is not the first
+// project reference of the service endpoint
+func TestServiceEndpointShare_Create_RejectsOwnerProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(getSharedServiceEndpoint(uuid.New(), shareTestProjectID), nil).
+		Times(1)
+
+	serviceEndpointClient.
+		EXPECT().
+		ShareServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	resourceData := getServiceEndpointShareResourceData(t)
+	resourceData.Set("owner_project_id", shareTestProjectID.String())
+	err := resourceServiceEndpointShareCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "owns service endpoint")
+}
+
+// verifies that the service endpoint is never removed from its owner or its last project, as that would delete it
+func TestServiceEndpointShare_Delete_SkipsOwnerAndLastProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	lastProject := &serviceendpoint.ServiceEndpoint{
+		Id: &shareTestServiceEndpointID,
+		ServiceEndpointProjectReferences: &[]serviceendpoint.ServiceEndpointProjectReference{
+			{ProjectReference: &serviceendpoint.ProjectReference{Id: &shareTestProjectID}},
+		},
+	}
+	for _, serviceEndpoint := range []*serviceendpoint.ServiceEndpoint{getSharedServiceEndpoint(uuid.New(), shareTestProjectID), lastProject} {
+		serviceEndpointClient.
+			EXPECT().
+			GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+			Return(serviceEndpoint, nil).
+			Times(1)
+
+		resourceData := getServiceEndpointShareResourceData(t)
+		resourceData.Set("owner_project_id", shareTestProjectID.String())
+		err := resourceServiceEndpointShareDelete(resourceData, clients)
+		require.Nil(t, err)
+		require.Empty(t, resourceData.Id())
+	}
+
+	serviceEndpointClient.
+		EXPECT().
+		DeleteServiceEndpoint(gomock.Any(), gomock.Any()).
+		Times(0)
+}
+
+// verifies that setting the owner project, e.g. after an import, rejects a share of the owner project
+func TestServiceEndpointShare_Update_RejectsOwnerProject(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	serviceEndpointClient := azdosdkmocks.NewMockServiceendpointClient(ctrl)
+	clients := &client.AggregatedClient{ServiceEndpointClient: serviceEndpointClient, Ctx: context.Background()}
+
+	serviceEndpointClient.
+		EXPECT().
+		GetServiceEndpointDetails(clients.Ctx, gomock.Any()).
+		Return(getSharedServiceEndpoint(uuid.New(), shareTestProjectID), nil).
+		Times(1)
+
+	resourceData := getServiceEndpointShareResourceData(t)
+	resourceData.Set("owner_project_id", shareTestProjectID.String())
+	err := resourceServiceEndpointShareUpdate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "owns service endpoint")
+}
@@ -0,0 +1,128 @@
+package taskagent
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// DataAgents schema and implementation for the agents of an agent pool
+func DataAgents() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAgentsRead,
+		Schema: map[string]*schema.Schema{
+			"agent_pool_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"agents": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"os_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"system_capabilities": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"user_capabilities": {
+							Type:     schema.TypeMap,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAgentsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	poolID := d.Get("agent_pool_id").(int)
+
+	agents, err := clients.TaskAgentClient.GetAgents(clients.Ctx, taskagent.GetAgentsArgs{
+		PoolId:              converter.Int(poolID),
+		IncludeCapabilities: converter.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading agents of agent pool %d: %+v", poolID, err)
+	}
+
+	d.SetId(strconv.Itoa(poolID))
+	if err := d.Set("agents", flattenAgents(agents)); err != nil {
+		return fmt.Errorf("Error setting agents field in state. Error: %v", err)
+	}
+	return nil
+}
+
+func flattenAgents(input *[]taskagent.TaskAgent) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	results := make([]interface{}, 0, len(*input))
+	for _, element := range *input {
+		output := make(map[string]interface{})
+		if element.Id != nil {
+			output["id"] = *element.Id
+		}
+		if element.Name != nil {
+			output["name"] = *element.Name
+		}
+		if element.Version != nil {
+			output["version"] = *element.Version
+		}
+		if element.OsDescription != nil {
+			output["os_description"] = *element.OsDescription
+		}
+		if element.Status != nil {
+			output["status"] = string(*element.Status)
+		}
+		if element.Enabled != nil {
+			output["enabled"] = *element.Enabled
+		}
+		if element.SystemCapabilities != nil {
+			output["system_capabilities"] = *element.SystemCapabilities
+		}
+		if element.UserCapabilities != nil {
+			output["user_capabilities"] = *element.UserCapabilities
+		}
+		results = append(results, output)
+	}
+	return results
+}
@@ -0,0 +1,81 @@
+//go:build (all || data_sources || data_agents) && (!exclude_data_sources || !exclude_data_agents)
+// +build all data_sources data_agents
+// +build !exclude_data_sources !exclude_data_agents
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataSourceAgents_Read_FlattensAgents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.Ctx, taskagent.GetAgentsArgs{
+			PoolId:              converter.Int(3),
+			IncludeCapabilities: converter.Bool(true),
+		}).
+		Return(&[]taskagent.TaskAgent{{
+			Id:                 converter.Int(11),
+			Name:               converter.String("agent-1"),
+			Version:            converter.String("2.190.0"),
+			Status:             &taskagent.TaskAgentStatusValues.Online,
+			Enabled:            converter.Bool(true),
+			SystemCapabilities: &map[string]string{"Agent.OS": "Linux"},
+			UserCapabilities:   &map[string]string{"docker": "true"},
+		}}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgents().Schema, nil)
+	resourceData.Set("agent_pool_id", 3)
+
+	require.Nil(t, dataSourceAgentsRead(resourceData, clients))
+	require.Equal(t, "3", resourceData.Id())
+	require.Equal(t, 1, resourceData.Get("agents.#"))
+	require.Equal(t, "agent-1", resourceData.Get("agents.0.name"))
+	require.Equal(t, "online", resourceData.Get("agents.0.status"))
+	require.Equal(t, map[string]interface{}{"Agent.OS": "Linux"}, resourceData.Get("agents.0.system_capabilities"))
+	require.Equal(t, "true", resourceData.Get("agents.0.user_capabilities.docker"))
+}
+
+func TestDataSourceAgents_Read_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgents(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("GetAgents() Failed")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, DataAgents().Schema, nil)
+	resourceData.Set("agent_pool_id", 3)
+
+	err := dataSourceAgentsRead(resourceData, clients)
+	require.Contains(t, err.Error(), "GetAgents() Failed")
+}
@@ -0,0 +1,152 @@
+package taskagent
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// DataDeploymentGroupTargets schema and implementation for the targets of a deployment group
+func DataDeploymentGroupTargets() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDeploymentGroupTargetsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"deployment_group_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"targets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"agent_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"agent_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDeploymentGroupTargetsRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+	deploymentGroupID := d.Get("deployment_group_id").(int)
+
+	var tags *[]string
+	if v, ok := d.GetOk("tags"); ok {
+		expanded := tfhelper.ExpandStringSet(v.(*schema.Set))
+		tags = &expanded
+	}
+
+	targets := []taskagent.DeploymentMachine{}
+	continuationToken := ""
+	for {
+		args := taskagent.GetDeploymentTargetsArgs{
+			Project:           converter.String(projectID),
+			DeploymentGroupId: converter.Int(deploymentGroupID),
+			Tags:              tags,
+		}
+		if continuationToken != "" {
+			args.ContinuationToken = converter.String(continuationToken)
+		}
+		response, err := clients.TaskAgentClient.GetDeploymentTargets(clients.Ctx, args)
+		if err != nil {
+			return fmt.Errorf(" reading targets of deployment group %d: %+v", deploymentGroupID, err)
+		}
+		targets = append(targets, response.Value...)
+
+		continuationToken = response.ContinuationToken
+		if continuationToken == "" {
+			break
+		}
+	}
+
+	d.SetId(strconv.Itoa(deploymentGroupID))
+	if err := d.Set("targets", flattenDeploymentTargets(targets)); err != nil {
+		return fmt.Errorf("Error setting targets field in state. Error: %v", err)
+	}
+	return nil
+}
+
+func flattenDeploymentTargets(targets []taskagent.DeploymentMachine) []interface{} {
+	results := make([]interface{}, 0, len(targets))
+	for _, target := range targets {
+		output := make(map[string]interface{})
+		if target.Id != nil {
+			output["id"] = *target.Id
+		}
+		if target.Tags != nil {
+			output["tags"] = *target.Tags
+		}
+		if agent := target.Agent; agent != nil {
+			if agent.Name != nil {
+				output["name"] = *agent.Name
+			}
+			if agent.Id != nil {
+				output["agent_id"] = *agent.Id
+			}
+			if agent.Version != nil {
+				output["agent_version"] = *agent.Version
+			}
+			if agent.Status != nil {
+				output["agent_status"] = string(*agent.Status)
+			}
+			if agent.Enabled != nil {
+				output["agent_enabled"] = *agent.Enabled
+			}
+		}
+		results = append(results, output)
+	}
+	return results
+}
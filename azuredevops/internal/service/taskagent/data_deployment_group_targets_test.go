@@ -0,0 +1,92 @@
+//go:build (all || data_sources || data_deployment_group_targets) && (!exclude_data_sources || !exclude_data_deployment_group_targets)
+// +build all data_sources data_deployment_group_targets
+// +build !exclude_data_sources !exclude_data_deployment_group_targets
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataDeploymentGroupTargets_Read_FollowsContinuationToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	projectID := uuid.New().String()
+	resourceData := schema.TestResourceDataRaw(t, DataDeploymentGroupTargets().Schema, nil)
+	resourceData.Set("project_id", projectID)
+	resourceData.Set("deployment_group_id", 21)
+	resourceData.Set("tags", []interface{}{"web"})
+
+	online := taskagent.TaskAgentStatusValues.Online
+	offline := taskagent.TaskAgentStatusValues.Offline
+	firstPage := taskagent.GetDeploymentTargetsResponseValue{
+		Value: []taskagent.DeploymentMachine{{
+			Id:   converter.Int(1),
+			Tags: &[]string{"web", "prod"},
+			Agent: &taskagent.TaskAgent{
+				Id:      converter.Int(101),
+				Name:    converter.String("web-01"),
+				Status:  &online,
+				Enabled: converter.Bool(true),
+			},
+		}},
+		ContinuationToken: "web-01",
+	}
+	secondPage := taskagent.GetDeploymentTargetsResponseValue{
+		Value: []taskagent.DeploymentMachine{{
+			Id:   converter.Int(2),
+			Tags: &[]string{"web"},
+			Agent: &taskagent.TaskAgent{
+				Id:      converter.Int(102),
+				Name:    converter.String("web-02"),
+				Status:  &offline,
+				Enabled: converter.Bool(false),
+			},
+		}},
+	}
+
+	gomock.InOrder(
+		taskAgentClient.
+			EXPECT().
+			GetDeploymentTargets(clients.Ctx, taskagent.GetDeploymentTargetsArgs{
+				Project:           converter.String(projectID),
+				DeploymentGroupId: converter.Int(21),
+				Tags:              &[]string{"web"},
+			}).
+			Return(&firstPage, nil).
+			Times(1),
+		taskAgentClient.
+			EXPECT().
+			GetDeploymentTargets(clients.Ctx, taskagent.GetDeploymentTargetsArgs{
+				Project:           converter.String(projectID),
+				DeploymentGroupId: converter.Int(21),
+				Tags:              &[]string{"web"},
+				ContinuationToken: converter.String("web-01"),
+			}).
+			Return(&secondPage, nil).
+			Times(1),
+	)
+
+	require.Nil(t, dataSourceDeploymentGroupTargetsRead(resourceData, clients))
+	require.Equal(t, "21", resourceData.Id())
+	require.Equal(t, 2, resourceData.Get("targets.#"))
+	require.Equal(t, "web-01", resourceData.Get("targets.0.name"))
+	require.Equal(t, "online", resourceData.Get("targets.0.agent_status"))
+	require.Equal(t, 2, resourceData.Get("targets.0.tags").(*schema.Set).Len())
+	require.Equal(t, "web-02", resourceData.Get("targets.1.name"))
+	require.Equal(t, false, resourceData.Get("targets.1.agent_enabled"))
+}
@@ -0,0 +1,97 @@
+package taskagent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/roleassignment"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	agentPoolRoleScope  = "distributedtask.agentpoolrole"
+	agentQueueRoleScope = "distributedtask.agentqueuerole"
+)
+
+// ResourceAgentPoolRoleAssignment schema and implementation for role assignments on agent pools and agent queues
+func ResourceAgentPoolRoleAssignment() *schema.Resource {
+	return roleassignment.Resource(map[string]*schema.Schema{
+		"pool_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			ExactlyOneOf: []string{"pool_id", "queue_id"},
+		},
+		"project_id": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+			RequiredWith: []string{"queue_id"},
+		},
+		"queue_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+			RequiredWith: []string{"project_id"},
+		},
+	}, []string{"Reader", "User", "Service Account", "Administrator"}, getAgentPoolRoleAssignmentScope, resourceAgentPoolRoleAssignmentImport)
+}
+
+// resourceAgentPoolRoleAssignmentImport imports a role assignment by an ID that looks like one of the following:
+//
+//	<pool ID>/<identity ID>                 for a role assignment on an agent pool
+//	<project>/<queue ID>/<identity ID>      for a role assignment on an agent queue
+func resourceAgentPoolRoleAssignmentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <pool ID>/<identity ID> or <project>/<queue ID>/<identity ID>", d.Id())
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected <pool ID>/<identity ID> or <project>/<queue ID>/<identity ID>", d.Id())
+		}
+	}
+
+	identityID := parts[len(parts)-1]
+	if _, err := uuid.Parse(identityID); err != nil {
+		return nil, fmt.Errorf("identity ID was expected to be a UUID, but was not: %+v", err)
+	}
+
+	if len(parts) == 2 {
+		poolID, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("pool ID was expected to be integer, but was not: %+v", err)
+		}
+		d.Set("pool_id", poolID)
+	} else {
+		queueID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("queue ID was expected to be integer, but was not: %+v", err)
+		}
+		projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+		if err != nil {
+			return nil, err
+		}
+		d.Set("project_id", projectID)
+		d.Set("queue_id", queueID)
+	}
+
+	d.SetId(identityID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getAgentPoolRoleAssignmentScope returns the security role scope and the ID of the resource the role is assigned on.
+// Agent queues are identified by the project and queue ID.
+func getAgentPoolRoleAssignmentScope(d *schema.ResourceData) (string, string) {
+	if queueID, ok := d.GetOk("queue_id"); ok {
+		return agentQueueRoleScope, fmt.Sprintf("%s_%d", d.Get("project_id").(string), queueID.(int))
+	}
+	return agentPoolRoleScope, strconv.Itoa(d.Get("pool_id").(int))
+}
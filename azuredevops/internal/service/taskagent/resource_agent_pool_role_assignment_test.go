@@ -0,0 +1,111 @@
+//go:build (all || resource_agent_pool_role_assignment) && !exclude_resource_agent_pool_role_assignment
+// +build all resource_agent_pool_role_assignment
+// +build !exclude_resource_agent_pool_role_assignment
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles"
+	"github.com/stretchr/testify/require"
+)
+
+var testAgentPoolRoleProjectID = uuid.New()
+var testAgentPoolRoleIdentityID = uuid.New()
+
+func getAgentPoolRoleAssignmentResourceData(t *testing.T, poolID int, queueID int) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentPoolRoleAssignment().Schema, nil)
+	resourceData.Set("identity_id", testAgentPoolRoleIdentityID.String())
+	resourceData.Set("role_name", "Administrator")
+	if poolID > 0 {
+		resourceData.Set("pool_id", poolID)
+	}
+	if queueID > 0 {
+		resourceData.Set("project_id", testAgentPoolRoleProjectID.String())
+		resourceData.Set("queue_id", queueID)
+	}
+	return resourceData
+}
+
+func TestAgentPoolRoleAssignment_Scope(t *testing.T) {
+	scopeID, resourceID := getAgentPoolRoleAssignmentScope(getAgentPoolRoleAssignmentResourceData(t, 7, 0))
+	require.Equal(t, "distributedtask.agentpoolrole", scopeID)
+	require.Equal(t, "7", resourceID)
+
+	scopeID, resourceID = getAgentPoolRoleAssignmentScope(getAgentPoolRoleAssignmentResourceData(t, 0, 21))
+	require.Equal(t, "distributedtask.agentqueuerole", scopeID)
+	require.Equal(t, testAgentPoolRoleProjectID.String()+"_21", resourceID)
+}
+
+func TestAgentPoolRoleAssignment_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getAgentPoolRoleAssignmentResourceData(t, 7, 0)
+	securityRolesClient.
+		EXPECT().
+		SetRoleAssignments(clients.Ctx, securityroles.SetRoleAssignmentsArgs{
+			RoleAssignments: &[]securityroles.UserRoleAssignmentRef{{
+				RoleName: converter.String("Administrator"),
+				UserId:   &testAgentPoolRoleIdentityID,
+			}},
+			ScopeId:    converter.String("distributedtask.agentpoolrole"),
+			ResourceId: converter.String("7"),
+		}).
+		Return(nil, errors.New("SetRoleAssignments() Failed")).
+		Times(1)
+
+	err := ResourceAgentPoolRoleAssignment().Create(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "SetRoleAssignments() Failed")
+}
+
+func TestAgentPoolRoleAssignment_Read_SetsAssignedRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getAgentPoolRoleAssignmentResourceData(t, 0, 21)
+	resourceData.SetId(testAgentPoolRoleIdentityID.String())
+	inherited := securityroles.RoleAccessValues.Inherited
+	assigned := securityroles.RoleAccessValues.Assigned
+	securityRolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, securityroles.GetRoleAssignmentsArgs{
+			ScopeId:    converter.String("distributedtask.agentqueuerole"),
+			ResourceId: converter.String(testAgentPoolRoleProjectID.String() + "_21"),
+		}).
+		Return(&[]securityroles.RoleAssignment{
+			{
+				Access:   &inherited,
+				Identity: &webapi.IdentityRef{Id: converter.String(testAgentPoolRoleIdentityID.String())},
+				Role:     &securityroles.SecurityRole{Name: converter.String("Reader")},
+			},
+			{
+				Access:   &assigned,
+				Identity: &webapi.IdentityRef{Id: converter.String(testAgentPoolRoleIdentityID.String())},
+				Role:     &securityroles.SecurityRole{Name: converter.String("User")},
+			},
+		}, nil).
+		Times(1)
+
+	err := ResourceAgentPoolRoleAssignment().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testAgentPoolRoleIdentityID.String(), resourceData.Id())
+	require.Equal(t, "User", resourceData.Get("role_name"))
+}
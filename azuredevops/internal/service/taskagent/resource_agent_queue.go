@@ -17,15 +17,17 @@ import (
 const (
 	agentPoolID                      = "agent_pool_id"
 	projectID                        = "project_id"
+	authorizePipelines               = "authorize_pipelines"
 	invalidQueueIDErrorMessageFormat = "Queue ID was unexpectedly not a valid integer: %+v"
 )
 
 // ResourceAgentQueue schema and implementation for agent queue resource
 func ResourceAgentQueue() *schema.Resource {
-	// Note: there is no update API, so all fields will require a new resource
+	// Note: there is no update API, so all fields except the create-only authorize_pipelines will require a new resource
 	return &schema.Resource{
 		Create:   resourceAgentQueueCreate,
 		Read:     resourceAgentQueueRead,
+		Update:   resourceAgentQueueUpdate,
 		Delete:   resourceAgentQueueDelete,
 		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
 		Schema: map[string]*schema.Schema{
@@ -41,6 +43,14 @@ func ResourceAgentQueue() *schema.Resource {
 				ValidateFunc:     validation.NoZeroValues,
 				DiffSuppressFunc: suppress.CaseDifference,
 			},
+			authorizePipelines: {
+				// only evaluated on create, hence changes to existing queues are ignored
+				Type:     schema.TypeBool,
+				Optional: true,
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
 		},
 	}
 }
@@ -63,7 +73,7 @@ func resourceAgentQueueCreate(d *schema.ResourceData, m interface{}) error {
 	createdQueue, err := clients.TaskAgentClient.AddAgentQueue(clients.Ctx, taskagent.AddAgentQueueArgs{
 		Queue:              queue,
 		Project:            &projectID,
-		AuthorizePipelines: converter.Bool(false),
+		AuthorizePipelines: converter.Bool(d.Get(authorizePipelines).(bool)),
 	})
 
 	if err != nil {
@@ -120,6 +130,11 @@ func resourceAgentQueueRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceAgentQueueUpdate has nothing to update, as authorize_pipelines is only evaluated on create
+func resourceAgentQueueUpdate(d *schema.ResourceData, m interface{}) error {
+	return resourceAgentQueueRead(d, m)
+}
+
 func resourceAgentQueueDelete(d *schema.ResourceData, m interface{}) error {
 	clients := m.(*client.AggregatedClient)
 	queueID, err := converter.ASCIIToIntPtr(d.Id())
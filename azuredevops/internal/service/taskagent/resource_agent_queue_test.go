@@ -11,6 +11,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -43,6 +44,32 @@ func TestAgentQueue_DoesNotSwallowPoolLookupErrors(t *testing.T) {
 	require.Contains(t, err.Error(), "GetAgentPool() Failed")
 }
 
+// If authorize_pipelines is set, the queue is authorized for all pipelines on creation
+func TestAgentQueue_AuthorizesPipelinesOnCreate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	resourceData := generateResourceData(t, &agentQueueProject, &agentQueuePoolID, nil)
+	resourceData.Set(authorizePipelines, true)
+	agentClient, clients := generateMocks(ctrl)
+
+	agentClient.
+		EXPECT().
+		GetAgentPool(clients.Ctx, gomock.Any()).
+		Return(&taskagent.TaskAgentPool{Id: &agentQueuePoolID, Name: &agentQueuePoolName}, nil)
+
+	agentClient.
+		EXPECT().
+		AddAgentQueue(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(_ context.Context, args taskagent.AddAgentQueueArgs) (*taskagent.TaskAgentQueue, error) {
+			require.True(t, *args.AuthorizePipelines)
+			return nil, errors.New("AddAgentQueue() Failed")
+		})
+
+	err := resourceAgentQueueCreate(resourceData, clients)
+	require.Contains(t, err.Error(), "AddAgentQueue() Failed")
+}
+
 // If the queue create fails, an error should be reported
 func TestAgentQueue_DoesNotSwallowQueueCreateErrors(t *testing.T) {
 	ctrl := gomock.NewController(t)
@@ -143,3 +170,28 @@ func generateMocks(ctrl *gomock.Controller) (*azdosdkmocks.MockTaskagentClient,
 		Ctx:             context.Background(),
 	}
 }
+
+// verifies that queues created or imported before authorize_pipelines existed are not replaced
+func TestAgentQueue_AuthorizePipelines_ExistingStateHasEmptyPlan(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: strconv.Itoa(agentQueueID),
+		Attributes: map[string]string{
+			"id":            strconv.Itoa(agentQueueID),
+			"agent_pool_id": strconv.Itoa(agentQueuePoolID),
+			"project_id":    agentQueueProject,
+		},
+	}
+
+	for _, authorize := range []bool{false, true} {
+		diff, err := ResourceAgentQueue().SimpleDiff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+			"agent_pool_id":       agentQueuePoolID,
+			"project_id":          agentQueueProject,
+			"authorize_pipelines": authorize,
+		}), nil)
+		require.Nil(t, err)
+		if diff != nil {
+			require.False(t, diff.RequiresNew())
+			require.Empty(t, diff.Attributes)
+		}
+	}
+}
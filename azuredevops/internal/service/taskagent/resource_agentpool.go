@@ -23,7 +23,7 @@ func ResourceAgentPool() *schema.Resource {
 		Update: resourceAzureAgentPoolUpdate,
 		Delete: resourceAzureAgentPoolDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			State: resourceAzureAgentPoolImport,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
@@ -202,6 +202,31 @@ func resourceAzureAgentPoolDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// resourceAzureAgentPoolImport imports an agent pool by its ID or by its name
+func resourceAzureAgentPoolImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	clients := m.(*client.AggregatedClient)
+	poolName := d.Id()
+	agentPools, err := clients.TaskAgentClient.GetAgentPools(clients.Ctx, taskagent.GetAgentPoolsArgs{
+		PoolName: converter.String(poolName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf(" looking up Agent Pool with name %s. Error: %v", poolName, err)
+	}
+	if agentPools == nil || len(*agentPools) == 0 {
+		return nil, fmt.Errorf(" Unable to find agent pool with name: %s", poolName)
+	}
+	if len(*agentPools) > 1 {
+		return nil, fmt.Errorf(" Found multiple agent pools for name: %s", poolName)
+	}
+
+	d.SetId(strconv.Itoa(*(*agentPools)[0].Id))
+	return []*schema.ResourceData{d}, nil
+}
+
 func syncStatus(params taskagent.UpdateAgentPoolArgs, client *client.AggregatedClient) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{"Waiting"},
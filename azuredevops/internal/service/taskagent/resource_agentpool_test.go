@@ -0,0 +1,81 @@
+//go:build (all || resource_agentpool) && !exclude_resource_agentpool
+// +build all resource_agentpool
+// +build !exclude_resource_agentpool
+
+package taskagent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAgentPool_Import_ByID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentPool().Schema, nil)
+	resourceData.SetId("42")
+
+	result, err := resourceAzureAgentPoolImport(resourceData, &client.AggregatedClient{Ctx: context.Background()})
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, "42", result[0].Id())
+}
+
+func TestAgentPool_Import_ByName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.Ctx, taskagent.GetAgentPoolsArgs{
+			PoolName: converter.String("Shared Pool"),
+		}).
+		Return(&[]taskagent.TaskAgentPool{{
+			Id:   converter.Int(7),
+			Name: converter.String("Shared Pool"),
+		}}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentPool().Schema, nil)
+	resourceData.SetId("Shared Pool")
+
+	result, err := resourceAzureAgentPoolImport(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "7", result[0].Id())
+}
+
+func TestAgentPool_Import_NameNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{
+		TaskAgentClient: taskAgentClient,
+		Ctx:             context.Background(),
+	}
+
+	taskAgentClient.
+		EXPECT().
+		GetAgentPools(clients.Ctx, gomock.Any()).
+		Return(&[]taskagent.TaskAgentPool{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceAgentPool().Schema, nil)
+	resourceData.SetId("Missing Pool")
+
+	_, err := resourceAzureAgentPoolImport(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "Missing Pool")
+}
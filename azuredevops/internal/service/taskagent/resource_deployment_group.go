@@ -0,0 +1,183 @@
+package taskagent
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceDeploymentGroup schema and implementation for deployment group resource
+func ResourceDeploymentGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceDeploymentGroupCreate,
+		Read:     resourceDeploymentGroupRead,
+		Update:   resourceDeploymentGroupUpdate,
+		Delete:   resourceDeploymentGroupDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"pool_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"pool_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"machine_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"registration_arguments": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDeploymentGroupCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	parameters := taskagent.DeploymentGroupCreateParameter{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+	}
+	// without a pool, the service creates a new deployment pool for the group
+	if poolID, ok := d.GetOk("pool_id"); ok {
+		parameters.PoolId = converter.Int(poolID.(int))
+	}
+
+	deploymentGroup, err := clients.TaskAgentClient.AddDeploymentGroup(clients.Ctx, taskagent.AddDeploymentGroupArgs{
+		Project:         converter.String(d.Get("project_id").(string)),
+		DeploymentGroup: &parameters,
+	})
+	if err != nil {
+		return fmt.Errorf(" creating deployment group %s: %+v", d.Get("name").(string), err)
+	}
+
+	d.SetId(strconv.Itoa(*deploymentGroup.Id))
+	return resourceDeploymentGroupRead(d, m)
+}
+
+func resourceDeploymentGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing deployment group ID: %+v", err)
+	}
+
+	deploymentGroup, err := clients.TaskAgentClient.GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: &deploymentGroupID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading deployment group %d: %+v", deploymentGroupID, err)
+	}
+
+	flattenDeploymentGroup(d, deploymentGroup, clients.OrganizationURL)
+	return nil
+}
+
+func resourceDeploymentGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing deployment group ID: %+v", err)
+	}
+
+	_, err = clients.TaskAgentClient.UpdateDeploymentGroup(clients.Ctx, taskagent.UpdateDeploymentGroupArgs{
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: &deploymentGroupID,
+		DeploymentGroup: &taskagent.DeploymentGroupUpdateParameter{
+			Name:        converter.String(d.Get("name").(string)),
+			Description: converter.String(d.Get("description").(string)),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(" updating deployment group %d: %+v", deploymentGroupID, err)
+	}
+
+	return resourceDeploymentGroupRead(d, m)
+}
+
+func resourceDeploymentGroupDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	deploymentGroupID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing deployment group ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteDeploymentGroup(clients.Ctx, taskagent.DeleteDeploymentGroupArgs{
+		Project:           converter.String(d.Get("project_id").(string)),
+		DeploymentGroupId: &deploymentGroupID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting deployment group %d: %+v", deploymentGroupID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenDeploymentGroup(d *schema.ResourceData, deploymentGroup *taskagent.DeploymentGroup, organizationURL string) {
+	d.SetId(strconv.Itoa(*deploymentGroup.Id))
+	d.Set("name", converter.ToString(deploymentGroup.Name, ""))
+	d.Set("description", converter.ToString(deploymentGroup.Description, ""))
+	if deploymentGroup.Pool != nil {
+		if deploymentGroup.Pool.Id != nil {
+			d.Set("pool_id", *deploymentGroup.Pool.Id)
+		}
+		d.Set("pool_name", converter.ToString(deploymentGroup.Pool.Name, ""))
+	}
+	machineCount := 0
+	if deploymentGroup.MachineCount != nil {
+		machineCount = *deploymentGroup.MachineCount
+	}
+	d.Set("machine_count", machineCount)
+
+	projectName := ""
+	if deploymentGroup.Project != nil {
+		projectName = converter.ToString(deploymentGroup.Project.Name, "")
+	}
+	d.Set("registration_arguments", getDeploymentGroupRegistrationArguments(organizationURL, projectName, converter.ToString(deploymentGroup.Name, "")))
+}
+
+// getDeploymentGroupRegistrationArguments returns the arguments of the agent configuration script which register
+// a machine with the deployment group. The arguments for authenticating the agent are left to the caller.
+func getDeploymentGroupRegistrationArguments(organizationURL string, projectName string, deploymentGroupName string) string {
+	return fmt.Sprintf("--deploymentgroup --url %s --projectname %s --deploymentgroupname %s",
+		strconv.Quote(organizationURL), strconv.Quote(projectName), strconv.Quote(deploymentGroupName))
+}
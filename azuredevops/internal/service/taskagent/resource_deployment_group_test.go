@@ -0,0 +1,137 @@
+//go:build (all || resource_deployment_group) && !exclude_resource_deployment_group
+// +build all resource_deployment_group
+// +build !exclude_resource_deployment_group
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testDeploymentGroupProjectID = uuid.New()
+
+var testDeploymentGroup = taskagent.DeploymentGroup{
+	Id:          converter.Int(21),
+	Name:        converter.String("web-servers"),
+	Description: converter.String("IIS farm"),
+	Pool: &taskagent.TaskAgentPoolReference{
+		Id:   converter.Int(8),
+		Name: converter.String("Example Project-web-servers"),
+	},
+	Project: &taskagent.ProjectReference{
+		Id:   &testDeploymentGroupProjectID,
+		Name: converter.String("Example Project"),
+	},
+	MachineCount: converter.Int(2),
+}
+
+func TestDeploymentGroup_Flatten_SetsRegistrationArguments(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	flattenDeploymentGroup(resourceData, &testDeploymentGroup, "https://dev.azure.com/example")
+
+	require.Equal(t, "21", resourceData.Id())
+	require.Equal(t, 8, resourceData.Get("pool_id"))
+	require.Equal(t, 2, resourceData.Get("machine_count"))
+	require.Equal(t,
+		`--deploymentgroup --url "https://dev.azure.com/example" --projectname "Example Project" --deploymentgroupname "web-servers"`,
+		resourceData.Get("registration_arguments"))
+}
+
+func TestDeploymentGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	resourceData.Set("project_id", testDeploymentGroupProjectID.String())
+	resourceData.Set("name", "web-servers")
+	resourceData.Set("pool_id", 8)
+
+	taskAgentClient.
+		EXPECT().
+		AddDeploymentGroup(clients.Ctx, taskagent.AddDeploymentGroupArgs{
+			Project: converter.String(testDeploymentGroupProjectID.String()),
+			DeploymentGroup: &taskagent.DeploymentGroupCreateParameter{
+				Name:        converter.String("web-servers"),
+				Description: converter.String(""),
+				PoolId:      converter.Int(8),
+			},
+		}).
+		Return(nil, errors.New("AddDeploymentGroup() Failed")).
+		Times(1)
+
+	err := resourceDeploymentGroupCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AddDeploymentGroup() Failed")
+}
+
+func TestDeploymentGroup_Create_WithoutPoolCreatesNewPool(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	resourceData.Set("project_id", testDeploymentGroupProjectID.String())
+	resourceData.Set("name", "web-servers")
+	resourceData.Set("description", "IIS farm")
+
+	taskAgentClient.
+		EXPECT().
+		AddDeploymentGroup(clients.Ctx, taskagent.AddDeploymentGroupArgs{
+			Project: converter.String(testDeploymentGroupProjectID.String()),
+			DeploymentGroup: &taskagent.DeploymentGroupCreateParameter{
+				Name:        converter.String("web-servers"),
+				Description: converter.String("IIS farm"),
+			},
+		}).
+		Return(&testDeploymentGroup, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetDeploymentGroup(clients.Ctx, taskagent.GetDeploymentGroupArgs{
+			Project:           converter.String(testDeploymentGroupProjectID.String()),
+			DeploymentGroupId: converter.Int(21),
+		}).
+		Return(&testDeploymentGroup, nil).
+		Times(1)
+
+	require.Nil(t, resourceDeploymentGroupCreate(resourceData, clients))
+	require.Equal(t, 8, resourceData.Get("pool_id"))
+}
+
+func TestDeploymentGroup_Delete_IgnoresAlreadyDeletedGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceDeploymentGroup().Schema, nil)
+	resourceData.Set("project_id", testDeploymentGroupProjectID.String())
+	flattenDeploymentGroup(resourceData, &testDeploymentGroup, "")
+
+	taskAgentClient.
+		EXPECT().
+		DeleteDeploymentGroup(clients.Ctx, gomock.Any()).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	require.Nil(t, resourceDeploymentGroupDelete(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
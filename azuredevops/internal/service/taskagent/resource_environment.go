@@ -117,7 +117,7 @@ func resourceEnvironmentDelete(d *schema.ResourceData, m interface{}) error {
 		EnvironmentId: &environmentId,
 	})
 
-	if err != nil {
+	if err != nil && !utils.ResponseWasNotFound(err) {
 		return fmt.Errorf("Error deleting environment: %+v", err)
 	}
 
@@ -0,0 +1,208 @@
+package taskagent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/environmentkubernetes"
+)
+
+// ResourceEnvironmentKubernetes schema and implementation for the Kubernetes resource of an environment
+func ResourceEnvironmentKubernetes() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEnvironmentKubernetesCreate,
+		Read:   resourceEnvironmentKubernetesRead,
+		Delete: resourceEnvironmentKubernetesDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceEnvironmentKubernetesImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"environment_id": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"service_endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"namespace": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"cluster_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+		},
+	}
+}
+
+func resourceEnvironmentKubernetesCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	serviceEndpointID, err := uuid.Parse(d.Get("service_endpoint_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing service endpoint ID %s: %+v", d.Get("service_endpoint_id").(string), err)
+	}
+
+	var tags *[]string
+	if v, ok := d.GetOk("tags"); ok {
+		expanded := tfhelper.ExpandStringSet(v.(*schema.Set))
+		tags = &expanded
+	}
+
+	var clusterName *string
+	if v, ok := d.GetOk("cluster_name"); ok {
+		clusterName = converter.String(v.(string))
+	}
+
+	environmentID := d.Get("environment_id").(int)
+	resource, err := clients.EnvironmentKubernetesClient.AddKubernetesResource(clients.Ctx, environmentkubernetes.AddKubernetesResourceArgs{
+		Project:       converter.String(d.Get("project_id").(string)),
+		EnvironmentId: converter.Int(environmentID),
+		CreateParameters: &taskagent.KubernetesResourceCreateParametersExistingEndpoint{
+			Name:              converter.String(d.Get("name").(string)),
+			Namespace:         converter.String(d.Get("namespace").(string)),
+			ClusterName:       clusterName,
+			ServiceEndpointId: &serviceEndpointID,
+			Tags:              tags,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(" creating Kubernetes resource in environment %d: %+v", environmentID, err)
+	}
+
+	d.SetId(strconv.Itoa(*resource.Id))
+	return resourceEnvironmentKubernetesRead(d, m)
+}
+
+func resourceEnvironmentKubernetesRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	resourceID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing Kubernetes resource ID: %+v", err)
+	}
+
+	resource, err := clients.TaskAgentClient.GetKubernetesResource(clients.Ctx, taskagent.GetKubernetesResourceArgs{
+		Project:       converter.String(d.Get("project_id").(string)),
+		EnvironmentId: converter.Int(d.Get("environment_id").(int)),
+		ResourceId:    &resourceID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading Kubernetes resource %d: %+v", resourceID, err)
+	}
+
+	flattenEnvironmentKubernetes(d, resource)
+	return nil
+}
+
+func resourceEnvironmentKubernetesDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	resourceID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing Kubernetes resource ID: %+v", err)
+	}
+
+	err = clients.TaskAgentClient.DeleteKubernetesResource(clients.Ctx, taskagent.DeleteKubernetesResourceArgs{
+		Project:       converter.String(d.Get("project_id").(string)),
+		EnvironmentId: converter.Int(d.Get("environment_id").(int)),
+		ResourceId:    &resourceID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting Kubernetes resource %d: %+v", resourceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceEnvironmentKubernetesImport imports a Kubernetes resource by an ID that looks like one of the following:
+//
+//	<project ID>/<environment ID>/<resource ID>
+//	<project name>/<environment ID>/<resource ID>
+func resourceEnvironmentKubernetesImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<environment ID>/<resource ID>", d.Id())
+	}
+
+	environmentID, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("environment ID was expected to be integer, but was not: %+v", err)
+	}
+	if _, err := strconv.Atoi(parts[2]); err != nil {
+		return nil, fmt.Errorf("resource ID was expected to be integer, but was not: %+v", err)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("project_id", projectID)
+	d.Set("environment_id", environmentID)
+	d.SetId(parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+func flattenEnvironmentKubernetes(d *schema.ResourceData, resource *taskagent.KubernetesResource) {
+	d.SetId(strconv.Itoa(*resource.Id))
+	if resource.EnvironmentReference != nil && resource.EnvironmentReference.Id != nil {
+		d.Set("environment_id", *resource.EnvironmentReference.Id)
+	}
+	d.Set("name", converter.ToString(resource.Name, ""))
+	d.Set("namespace", converter.ToString(resource.Namespace, ""))
+	d.Set("cluster_name", converter.ToString(resource.ClusterName, ""))
+	if resource.ServiceEndpointId != nil {
+		d.Set("service_endpoint_id", resource.ServiceEndpointId.String())
+	}
+	if resource.Tags != nil {
+		d.Set("tags", *resource.Tags)
+	} else {
+		d.Set("tags", nil)
+	}
+}
@@ -0,0 +1,216 @@
+//go:build (all || resource_environment_kubernetes) && !exclude_resource_environment_kubernetes
+// +build all resource_environment_kubernetes
+// +build !exclude_resource_environment_kubernetes
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/environmentkubernetes"
+	"github.com/stretchr/testify/require"
+)
+
+var testKubernetesProjectID = uuid.New().String()
+var testKubernetesServiceEndpointID = uuid.New()
+
+var testKubernetesResource = taskagent.KubernetesResource{
+	Id:   converter.Int(7),
+	Name: converter.String("app"),
+	EnvironmentReference: &taskagent.EnvironmentReference{
+		Id: converter.Int(3),
+	},
+	ClusterName:       converter.String("aks-cluster"),
+	Namespace:         converter.String("app-namespace"),
+	ServiceEndpointId: &testKubernetesServiceEndpointID,
+	Tags:              &[]string{"web"},
+}
+
+func getKubernetesResourceData(t *testing.T) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	resourceData.Set("project_id", testKubernetesProjectID)
+	flattenEnvironmentKubernetes(resourceData, &testKubernetesResource)
+	return resourceData
+}
+
+func TestEnvironmentKubernetes_Read_FlattensResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	resourceData.Set("project_id", testKubernetesProjectID)
+	resourceData.Set("environment_id", 3)
+	resourceData.SetId("7")
+
+	taskAgentClient.
+		EXPECT().
+		GetKubernetesResource(clients.Ctx, taskagent.GetKubernetesResourceArgs{
+			Project:       converter.String(testKubernetesProjectID),
+			EnvironmentId: converter.Int(3),
+			ResourceId:    converter.Int(7),
+		}).
+		Return(&testKubernetesResource, nil).
+		Times(1)
+
+	require.Nil(t, resourceEnvironmentKubernetesRead(resourceData, clients))
+	require.Equal(t, "app", resourceData.Get("name"))
+	require.Equal(t, "app-namespace", resourceData.Get("namespace"))
+	require.Equal(t, "aks-cluster", resourceData.Get("cluster_name"))
+	require.Equal(t, testKubernetesServiceEndpointID.String(), resourceData.Get("service_endpoint_id"))
+	require.Equal(t, 1, resourceData.Get("tags").(*schema.Set).Len())
+}
+
+func TestEnvironmentKubernetes_Read_RemovesDeletedResourceFromState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getKubernetesResourceData(t)
+
+	taskAgentClient.
+		EXPECT().
+		GetKubernetesResource(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	require.Nil(t, resourceEnvironmentKubernetesRead(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestEnvironmentKubernetes_Create_AddsResourceWithServiceEndpoint(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kubernetesClient := azdosdkmocks.NewMockEnvironmentkubernetesClient(ctrl)
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{EnvironmentKubernetesClient: kubernetesClient, TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getKubernetesResourceData(t)
+	resourceData.SetId("")
+
+	kubernetesClient.
+		EXPECT().
+		AddKubernetesResource(clients.Ctx, environmentkubernetes.AddKubernetesResourceArgs{
+			Project:       converter.String(testKubernetesProjectID),
+			EnvironmentId: converter.Int(3),
+			CreateParameters: &taskagent.KubernetesResourceCreateParametersExistingEndpoint{
+				Name:              converter.String("app"),
+				Namespace:         converter.String("app-namespace"),
+				ClusterName:       converter.String("aks-cluster"),
+				ServiceEndpointId: &testKubernetesServiceEndpointID,
+				Tags:              &[]string{"web"},
+			},
+		}).
+		Return(&testKubernetesResource, nil).
+		Times(1)
+	taskAgentClient.
+		EXPECT().
+		GetKubernetesResource(clients.Ctx, gomock.Any()).
+		Return(&testKubernetesResource, nil).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "7", resourceData.Id())
+}
+
+func TestEnvironmentKubernetes_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	kubernetesClient := azdosdkmocks.NewMockEnvironmentkubernetesClient(ctrl)
+	clients := &client.AggregatedClient{EnvironmentKubernetesClient: kubernetesClient, Ctx: context.Background()}
+
+	resourceData := getKubernetesResourceData(t)
+	resourceData.SetId("")
+
+	kubernetesClient.
+		EXPECT().
+		AddKubernetesResource(clients.Ctx, gomock.Any()).
+		Return(nil, errors.New("AddKubernetesResource() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AddKubernetesResource() Failed")
+}
+
+func TestEnvironmentKubernetes_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getKubernetesResourceData(t)
+
+	taskAgentClient.
+		EXPECT().
+		DeleteKubernetesResource(clients.Ctx, taskagent.DeleteKubernetesResourceArgs{
+			Project:       converter.String(testKubernetesProjectID),
+			EnvironmentId: converter.Int(3),
+			ResourceId:    converter.Int(7),
+		}).
+		Return(errors.New("DeleteKubernetesResource() Failed")).
+		Times(1)
+
+	err := resourceEnvironmentKubernetesDelete(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "DeleteKubernetesResource() Failed")
+}
+
+func TestEnvironmentKubernetes_Delete_IgnoresAlreadyDeletedResource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := getKubernetesResourceData(t)
+
+	taskAgentClient.
+		EXPECT().
+		DeleteKubernetesResource(clients.Ctx, gomock.Any()).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	require.Nil(t, resourceEnvironmentKubernetesDelete(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestEnvironmentKubernetes_Import_RejectsMalformedID(t *testing.T) {
+	for _, id := range []string{"", "project", "project/3", "project/env/7", "project/3/resource"} {
+		resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+		resourceData.SetId(id)
+
+		_, err := resourceEnvironmentKubernetesImport(resourceData, &client.AggregatedClient{})
+		require.NotNil(t, err, "expected an error importing %q", id)
+	}
+}
+
+func TestEnvironmentKubernetes_Import_SetsProjectAndEnvironment(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironmentKubernetes().Schema, nil)
+	resourceData.SetId(testKubernetesProjectID + "/3/7")
+
+	result, err := resourceEnvironmentKubernetesImport(resourceData, &client.AggregatedClient{})
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, testKubernetesProjectID, resourceData.Get("project_id"))
+	require.Equal(t, 3, resourceData.Get("environment_id"))
+	require.Equal(t, "7", resourceData.Id())
+}
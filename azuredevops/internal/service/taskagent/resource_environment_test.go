@@ -13,6 +13,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
 	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
@@ -83,6 +84,26 @@ func TestEnvironment_DeleteEnvironment_ReturnsErrorIfIdReadFails(t *testing.T) {
 	require.Equal(t, "Error getting environment id: strconv.Atoi: parsing \"\": invalid syntax", err.Error())
 }
 
+func TestEnvironment_DeleteEnvironment_IgnoresAlreadyDeletedEnvironment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceEnvironment().Schema, nil)
+	flattenEnvironment(resourceData, &testEnvironment)
+
+	taskAgentClient.
+		EXPECT().
+		DeleteEnvironment(clients.Ctx, gomock.Any()).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	require.Nil(t, resourceEnvironmentDelete(resourceData, clients))
+	require.Equal(t, "", resourceData.Id())
+}
+
 func TestEnvironment_UpdateEnvironment_ReturnsErrorIfIdReadFails(t *testing.T) {
 	client := &client.AggregatedClient{}
 
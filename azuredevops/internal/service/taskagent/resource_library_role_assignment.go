@@ -0,0 +1,84 @@
+package taskagent
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/roleassignment"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	libraryRoleScope       = "distributedtask.library"
+	variableGroupRoleScope = "distributedtask.variablegroup"
+)
+
+// ResourceLibraryRoleAssignment schema and implementation for role assignments on the Library and its variable groups
+func ResourceLibraryRoleAssignment() *schema.Resource {
+	return roleassignment.Resource(map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+		"variable_group_id": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+	}, []string{"Reader", "User", "Administrator"}, getLibraryRoleAssignmentScope, resourceLibraryRoleAssignmentImport)
+}
+
+// resourceLibraryRoleAssignmentImport imports a role assignment by an ID that looks like one of the following:
+//
+//	<project>/<identity ID>                       for a role assignment on the Library
+//	<project>/<variable group ID>/<identity ID>   for a role assignment on a variable group
+func resourceLibraryRoleAssignmentImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<identity ID> or <project>/<variable group ID>/<identity ID>", d.Id())
+	}
+	for _, part := range parts {
+		if part == "" {
+			return nil, fmt.Errorf("unexpected format of ID (%s), expected <project>/<identity ID> or <project>/<variable group ID>/<identity ID>", d.Id())
+		}
+	}
+
+	identityID := parts[len(parts)-1]
+	if _, err := uuid.Parse(identityID); err != nil {
+		return nil, fmt.Errorf("identity ID was expected to be a UUID, but was not: %+v", err)
+	}
+
+	if len(parts) == 3 {
+		variableGroupID, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("variable group ID was expected to be integer, but was not: %+v", err)
+		}
+		d.Set("variable_group_id", variableGroupID)
+	}
+
+	projectID, err := tfhelper.GetRealProjectId(parts[0], m)
+	if err != nil {
+		return nil, err
+	}
+
+	d.Set("project_id", projectID)
+	d.SetId(identityID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// getLibraryRoleAssignmentScope returns the security role scope and the ID of the resource the role is assigned on.
+// The Library itself is identified as the variable group 0 of a project.
+func getLibraryRoleAssignmentScope(d *schema.ResourceData) (string, string) {
+	projectID := d.Get("project_id").(string)
+	if variableGroupID, ok := d.GetOk("variable_group_id"); ok {
+		return variableGroupRoleScope, fmt.Sprintf("%s$%d", projectID, variableGroupID.(int))
+	}
+	return libraryRoleScope, fmt.Sprintf("%s$0", projectID)
+}
@@ -0,0 +1,152 @@
+//go:build (all || resource_library_role_assignment) && !exclude_resource_library_role_assignment
+// +build all resource_library_role_assignment
+// +build !exclude_resource_library_role_assignment
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles"
+	"github.com/stretchr/testify/require"
+)
+
+var testLibraryRoleProjectID = uuid.New()
+var testLibraryRoleIdentityID = uuid.New()
+
+func getLibraryRoleAssignmentResourceData(t *testing.T, variableGroupID int) *schema.ResourceData {
+	resourceData := schema.TestResourceDataRaw(t, ResourceLibraryRoleAssignment().Schema, nil)
+	resourceData.Set("project_id", testLibraryRoleProjectID.String())
+	resourceData.Set("identity_id", testLibraryRoleIdentityID.String())
+	resourceData.Set("role_name", "Administrator")
+	if variableGroupID > 0 {
+		resourceData.Set("variable_group_id", variableGroupID)
+	}
+	return resourceData
+}
+
+func testLibraryRoleAssignment(access securityroles.RoleAccess, roleName string) securityroles.RoleAssignment {
+	return securityroles.RoleAssignment{
+		Access:   &access,
+		Identity: &webapi.IdentityRef{Id: converter.String(testLibraryRoleIdentityID.String())},
+		Role:     &securityroles.SecurityRole{Name: converter.String(roleName)},
+	}
+}
+
+func TestLibraryRoleAssignment_Scope(t *testing.T) {
+	scopeID, resourceID := getLibraryRoleAssignmentScope(getLibraryRoleAssignmentResourceData(t, 0))
+	require.Equal(t, "distributedtask.library", scopeID)
+	require.Equal(t, testLibraryRoleProjectID.String()+"$0", resourceID)
+
+	scopeID, resourceID = getLibraryRoleAssignmentScope(getLibraryRoleAssignmentResourceData(t, 12))
+	require.Equal(t, "distributedtask.variablegroup", scopeID)
+	require.Equal(t, testLibraryRoleProjectID.String()+"$12", resourceID)
+}
+
+func TestLibraryRoleAssignment_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getLibraryRoleAssignmentResourceData(t, 12)
+	securityRolesClient.
+		EXPECT().
+		SetRoleAssignments(clients.Ctx, securityroles.SetRoleAssignmentsArgs{
+			RoleAssignments: &[]securityroles.UserRoleAssignmentRef{{
+				RoleName: converter.String("Administrator"),
+				UserId:   &testLibraryRoleIdentityID,
+			}},
+			ScopeId:    converter.String("distributedtask.variablegroup"),
+			ResourceId: converter.String(testLibraryRoleProjectID.String() + "$12"),
+		}).
+		Return(nil, errors.New("SetRoleAssignments() Failed")).
+		Times(1)
+
+	err := ResourceLibraryRoleAssignment().Create(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "SetRoleAssignments() Failed")
+}
+
+func TestLibraryRoleAssignment_Read_IgnoresInheritedRoles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getLibraryRoleAssignmentResourceData(t, 12)
+	resourceData.SetId(testLibraryRoleIdentityID.String())
+	securityRolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, gomock.Any()).
+		Return(&[]securityroles.RoleAssignment{
+			testLibraryRoleAssignment(securityroles.RoleAccessValues.Inherited, "Reader"),
+		}, nil).
+		Times(1)
+
+	err := ResourceLibraryRoleAssignment().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestLibraryRoleAssignment_Read_SetsAssignedRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getLibraryRoleAssignmentResourceData(t, 0)
+	resourceData.SetId(testLibraryRoleIdentityID.String())
+	securityRolesClient.
+		EXPECT().
+		GetRoleAssignments(clients.Ctx, securityroles.GetRoleAssignmentsArgs{
+			ScopeId:    converter.String("distributedtask.library"),
+			ResourceId: converter.String(testLibraryRoleProjectID.String() + "$0"),
+		}).
+		Return(&[]securityroles.RoleAssignment{
+			testLibraryRoleAssignment(securityroles.RoleAccessValues.Assigned, "User"),
+		}, nil).
+		Times(1)
+
+	err := ResourceLibraryRoleAssignment().Read(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testLibraryRoleIdentityID.String(), resourceData.Id())
+	require.Equal(t, "User", resourceData.Get("role_name"))
+}
+
+func TestLibraryRoleAssignment_Delete_IgnoresNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	securityRolesClient := azdosdkmocks.NewMockSecurityrolesClient(ctrl)
+	clients := &client.AggregatedClient{SecurityRolesClient: securityRolesClient, Ctx: context.Background()}
+
+	resourceData := getLibraryRoleAssignmentResourceData(t, 12)
+	resourceData.SetId(testLibraryRoleIdentityID.String())
+	securityRolesClient.
+		EXPECT().
+		RemoveRoleAssignments(clients.Ctx, securityroles.RemoveRoleAssignmentsArgs{
+			IdentityIds: &[]uuid.UUID{testLibraryRoleIdentityID},
+			ScopeId:     converter.String("distributedtask.variablegroup"),
+			ResourceId:  converter.String(testLibraryRoleProjectID.String() + "$12"),
+		}).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	err := ResourceLibraryRoleAssignment().Delete(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
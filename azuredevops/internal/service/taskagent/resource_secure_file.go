@@ -0,0 +1,269 @@
+package taskagent
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/build"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securefiles"
+)
+
+const secureFileResourceType = "securefile"
+
+// ResourceSecureFile schema and implementation for secure file resource
+func ResourceSecureFile() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceSecureFileCreate,
+		Read:          resourceSecureFileRead,
+		Update:        resourceSecureFileUpdate,
+		Delete:        resourceSecureFileDelete,
+		CustomizeDiff: customizeSecureFileDiff,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"file_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				ExactlyOneOf: []string{"file_path", "content_base64"},
+			},
+			"content_base64": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsBase64,
+				ExactlyOneOf: []string{"file_path", "content_base64"},
+			},
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"properties": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allow_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+// customizeSecureFileDiff replaces the secure file when the content of the uploaded file changed, as the content of
+// a secure file can neither be read nor updated.
+func customizeSecureFileDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("file_path") || !d.NewValueKnown("content_base64") {
+		return nil
+	}
+
+	content, err := readSecureFileContent(d.Get("file_path").(string), d.Get("content_base64").(string))
+	if err != nil {
+		return err
+	}
+
+	if hash := getSecureFileContentHash(content); hash != d.Get("content_sha256").(string) {
+		if err := d.SetNew("content_sha256", hash); err != nil {
+			return err
+		}
+		return d.ForceNew("content_sha256")
+	}
+	return nil
+}
+
+func resourceSecureFileCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	content, err := readSecureFileContent(d.Get("file_path").(string), d.Get("content_base64").(string))
+	if err != nil {
+		return err
+	}
+
+	secureFile, err := clients.SecureFilesClient.UploadSecureFile(clients.Ctx, securefiles.UploadSecureFileArgs{
+		UploadStream:       bytes.NewReader(content),
+		Project:            converter.String(projectID),
+		Name:               converter.String(d.Get("name").(string)),
+		AuthorizePipelines: converter.Bool(d.Get("allow_access").(bool)),
+	})
+	if err != nil {
+		return fmt.Errorf(" uploading secure file %s: %+v", d.Get("name").(string), err)
+	}
+
+	d.SetId(secureFile.Id.String())
+	d.Set("content_sha256", getSecureFileContentHash(content))
+
+	if properties := expandSecureFileProperties(d); len(*properties) > 0 {
+		secureFile.Properties = properties
+		_, err = clients.SecureFilesClient.UpdateSecureFile(clients.Ctx, securefiles.UpdateSecureFileArgs{
+			SecureFile:   secureFile,
+			Project:      converter.String(projectID),
+			SecureFileId: secureFile.Id,
+		})
+		if err != nil {
+			return fmt.Errorf(" updating properties of secure file %s: %+v", secureFile.Id, err)
+		}
+	}
+
+	return resourceSecureFileRead(d, m)
+}
+
+func resourceSecureFileRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing secure file ID %s: %+v", d.Id(), err)
+	}
+
+	secureFile, err := clients.SecureFilesClient.GetSecureFile(clients.Ctx, securefiles.GetSecureFileArgs{
+		Project:      converter.String(projectID),
+		SecureFileId: &secureFileID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading secure file %s: %+v", d.Id(), err)
+	}
+
+	flattenSecureFile(d, secureFile)
+
+	projectResources, err := clients.BuildClient.GetProjectResources(clients.Ctx, build.GetProjectResourcesArgs{
+		Project: converter.String(projectID),
+		Type:    converter.String(secureFileResourceType),
+		Id:      converter.String(d.Id()),
+	})
+	if err != nil {
+		return fmt.Errorf(" reading pipeline authorization of secure file %s: %+v", d.Id(), err)
+	}
+
+	flattenAllowAccess(d, projectResources)
+	return nil
+}
+
+func resourceSecureFileUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	projectID := d.Get("project_id").(string)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing secure file ID %s: %+v", d.Id(), err)
+	}
+
+	if d.HasChanges("name", "properties") {
+		_, err = clients.SecureFilesClient.UpdateSecureFile(clients.Ctx, securefiles.UpdateSecureFileArgs{
+			SecureFile: &taskagent.SecureFile{
+				Id:         &secureFileID,
+				Name:       converter.String(d.Get("name").(string)),
+				Properties: expandSecureFileProperties(d),
+			},
+			Project:      converter.String(projectID),
+			SecureFileId: &secureFileID,
+		})
+		if err != nil {
+			return fmt.Errorf(" updating secure file %s: %+v", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("allow_access") {
+		_, err = updateDefinitionResourceAuth(clients, []build.DefinitionResourceReference{{
+			Type:       converter.String(secureFileResourceType),
+			Authorized: converter.Bool(d.Get("allow_access").(bool)),
+			Name:       converter.String(d.Get("name").(string)),
+			Id:         converter.String(d.Id()),
+		}}, &projectID)
+		if err != nil {
+			return fmt.Errorf(" updating pipeline authorization of secure file %s: %+v", d.Id(), err)
+		}
+	}
+
+	return resourceSecureFileRead(d, m)
+}
+
+func resourceSecureFileDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	secureFileID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing secure file ID %s: %+v", d.Id(), err)
+	}
+
+	err = clients.SecureFilesClient.DeleteSecureFile(clients.Ctx, securefiles.DeleteSecureFileArgs{
+		Project:      converter.String(d.Get("project_id").(string)),
+		SecureFileId: &secureFileID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting secure file %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func flattenSecureFile(d *schema.ResourceData, secureFile *taskagent.SecureFile) {
+	d.Set("name", converter.ToString(secureFile.Name, ""))
+	if secureFile.Properties != nil {
+		d.Set("properties", *secureFile.Properties)
+	} else {
+		d.Set("properties", nil)
+	}
+}
+
+func expandSecureFileProperties(d *schema.ResourceData) *map[string]string {
+	properties := map[string]string{}
+	for key, value := range d.Get("properties").(map[string]interface{}) {
+		properties[key] = value.(string)
+	}
+	return &properties
+}
+
+func readSecureFileContent(filePath string, contentBase64 string) ([]byte, error) {
+	if filePath != "" {
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return nil, fmt.Errorf(" reading secure file content from %s: %+v", filePath, err)
+		}
+		return content, nil
+	}
+
+	content, err := base64.StdEncoding.DecodeString(contentBase64)
+	if err != nil {
+		return nil, fmt.Errorf(" decoding base64 secure file content: %+v", err)
+	}
+	return content, nil
+}
+
+func getSecureFileContentHash(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
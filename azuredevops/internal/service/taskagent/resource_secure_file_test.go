@@ -0,0 +1,127 @@
+//go:build (all || resource_secure_file) && !exclude_resource_secure_file
+// +build all resource_secure_file
+// +build !exclude_resource_secure_file
+
+package taskagent
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securefiles"
+	"github.com/stretchr/testify/require"
+)
+
+var testSecureFileProjectID = uuid.New().String()
+var testSecureFileID = uuid.New()
+
+func TestSecureFile_ReadContent(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "signing.p12")
+	require.Nil(t, os.WriteFile(filePath, []byte("certificate"), 0600))
+
+	content, err := readSecureFileContent(filePath, "")
+	require.Nil(t, err)
+	require.Equal(t, []byte("certificate"), content)
+
+	content, err = readSecureFileContent("", base64.StdEncoding.EncodeToString([]byte("certificate")))
+	require.Nil(t, err)
+	require.Equal(t, []byte("certificate"), content)
+
+	_, err = readSecureFileContent(filepath.Join(t.TempDir(), "missing.p12"), "")
+	require.NotNil(t, err)
+}
+
+func TestSecureFile_Create_UploadsContent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secureFilesClient := azdosdkmocks.NewMockSecurefilesClient(ctrl)
+	clients := &client.AggregatedClient{SecureFilesClient: secureFilesClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceSecureFile().Schema, nil)
+	resourceData.Set("project_id", testSecureFileProjectID)
+	resourceData.Set("name", "signing.p12")
+	resourceData.Set("content_base64", base64.StdEncoding.EncodeToString([]byte("certificate")))
+	resourceData.Set("allow_access", true)
+
+	secureFilesClient.
+		EXPECT().
+		UploadSecureFile(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args securefiles.UploadSecureFileArgs) (*taskagent.SecureFile, error) {
+			require.Equal(t, testSecureFileProjectID, *args.Project)
+			require.Equal(t, "signing.p12", *args.Name)
+			require.True(t, *args.AuthorizePipelines)
+			content, err := io.ReadAll(args.UploadStream)
+			require.Nil(t, err)
+			require.Equal(t, []byte("certificate"), content)
+			return nil, errors.New("UploadSecureFile() Failed")
+		}).
+		Times(1)
+
+	err := resourceSecureFileCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "UploadSecureFile() Failed")
+}
+
+func TestSecureFile_Read_NotFoundClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secureFilesClient := azdosdkmocks.NewMockSecurefilesClient(ctrl)
+	clients := &client.AggregatedClient{SecureFilesClient: secureFilesClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceSecureFile().Schema, nil)
+	resourceData.Set("project_id", testSecureFileProjectID)
+	resourceData.SetId(testSecureFileID.String())
+
+	secureFilesClient.
+		EXPECT().
+		GetSecureFile(clients.Ctx, securefiles.GetSecureFileArgs{
+			Project:      converter.String(testSecureFileProjectID),
+			SecureFileId: &testSecureFileID,
+		}).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	err := resourceSecureFileRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
+
+func TestSecureFile_Delete_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	secureFilesClient := azdosdkmocks.NewMockSecurefilesClient(ctrl)
+	clients := &client.AggregatedClient{SecureFilesClient: secureFilesClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceSecureFile().Schema, nil)
+	resourceData.Set("project_id", testSecureFileProjectID)
+	resourceData.SetId(testSecureFileID.String())
+
+	secureFilesClient.
+		EXPECT().
+		DeleteSecureFile(clients.Ctx, securefiles.DeleteSecureFileArgs{
+			Project:      converter.String(testSecureFileProjectID),
+			SecureFileId: &testSecureFileID,
+		}).
+		Return(errors.New("DeleteSecureFile() Failed")).
+		Times(1)
+
+	err := resourceSecureFileDelete(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "DeleteSecureFile() Failed")
+}
@@ -0,0 +1,437 @@
+package taskagent
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceTaskGroup schema and implementation for task group resource
+func ResourceTaskGroup() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceTaskGroupCreate,
+		Read:     resourceTaskGroupRead,
+		Update:   resourceTaskGroupUpdate,
+		Delete:   resourceTaskGroupDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceUUID(),
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Deploy",
+				ValidateFunc: validation.StringInSlice([]string{"Build", "Deploy", "Package", "Utility", "Test"}, false),
+			},
+			"instance_name_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"runs_on": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Agent", "DeploymentGroup", "Server"}, false),
+				},
+			},
+			"comment": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"input": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"label": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "string",
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"required": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"help_markdown": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"task": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"task_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.IsUUID,
+						},
+						"version_spec": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotWhiteSpace,
+						},
+						"definition_type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "task",
+							ValidateFunc: validation.StringInSlice([]string{"task", "metaTask"}, false),
+						},
+						"display_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+						"continue_on_error": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"always_run": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"condition": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "succeeded()",
+						},
+						"timeout_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"inputs": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"environment": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"revision": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceTaskGroupCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	tasks, err := expandTaskGroupSteps(d)
+	if err != nil {
+		return err
+	}
+
+	taskGroup, err := clients.TaskAgentClient.AddTaskGroup(clients.Ctx, taskagent.AddTaskGroupArgs{
+		TaskGroup: &taskagent.TaskGroupCreateParameter{
+			Name:               converter.String(d.Get("name").(string)),
+			Description:        converter.String(d.Get("description").(string)),
+			Category:           converter.String(d.Get("category").(string)),
+			InstanceNameFormat: expandTaskGroupInstanceNameFormat(d),
+			RunsOn:             expandTaskGroupRunsOn(d),
+			Inputs:             expandTaskGroupInputs(d),
+			Tasks:              tasks,
+		},
+		Project: converter.String(d.Get("project_id").(string)),
+	})
+	if err != nil {
+		return fmt.Errorf(" creating task group %s: %+v", d.Get("name").(string), err)
+	}
+
+	d.SetId(taskGroup.Id.String())
+	return resourceTaskGroupRead(d, m)
+}
+
+func resourceTaskGroupRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing task group ID %s: %+v", d.Id(), err)
+	}
+
+	taskGroups, err := clients.TaskAgentClient.GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+		Project:     converter.String(d.Get("project_id").(string)),
+		TaskGroupId: &taskGroupID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading task group %s: %+v", d.Id(), err)
+	}
+
+	if taskGroups == nil || len(*taskGroups) == 0 || converter.ToBool((*taskGroups)[0].Deleted, false) {
+		d.SetId("")
+		return nil
+	}
+
+	return flattenTaskGroup(d, &(*taskGroups)[0])
+}
+
+func resourceTaskGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing task group ID %s: %+v", d.Id(), err)
+	}
+
+	tasks, err := expandTaskGroupSteps(d)
+	if err != nil {
+		return err
+	}
+
+	_, err = clients.TaskAgentClient.UpdateTaskGroup(clients.Ctx, taskagent.UpdateTaskGroupArgs{
+		TaskGroup: &taskagent.TaskGroupUpdateParameter{
+			Id:                 &taskGroupID,
+			Name:               converter.String(d.Get("name").(string)),
+			Description:        converter.String(d.Get("description").(string)),
+			Category:           converter.String(d.Get("category").(string)),
+			Comment:            converter.String(d.Get("comment").(string)),
+			InstanceNameFormat: expandTaskGroupInstanceNameFormat(d),
+			RunsOn:             expandTaskGroupRunsOn(d),
+			Inputs:             expandTaskGroupInputs(d),
+			Tasks:              tasks,
+			Revision:           converter.Int(d.Get("revision").(int)),
+		},
+		Project:     converter.String(d.Get("project_id").(string)),
+		TaskGroupId: &taskGroupID,
+	})
+	if err != nil {
+		return fmt.Errorf(" updating task group %s: %+v", d.Id(), err)
+	}
+
+	return resourceTaskGroupRead(d, m)
+}
+
+func resourceTaskGroupDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	taskGroupID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing task group ID %s: %+v", d.Id(), err)
+	}
+
+	err = clients.TaskAgentClient.DeleteTaskGroup(clients.Ctx, taskagent.DeleteTaskGroupArgs{
+		Project:     converter.String(d.Get("project_id").(string)),
+		TaskGroupId: &taskGroupID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting task group %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandTaskGroupInstanceNameFormat(d *schema.ResourceData) *string {
+	if v, ok := d.GetOk("instance_name_format"); ok {
+		return converter.String(v.(string))
+	}
+	return converter.String(fmt.Sprintf("Task group: %s", d.Get("name").(string)))
+}
+
+func expandTaskGroupRunsOn(d *schema.ResourceData) *[]string {
+	if v, ok := d.GetOk("runs_on"); ok {
+		runsOn := tfhelper.ExpandStringSet(v.(*schema.Set))
+		return &runsOn
+	}
+	return &[]string{"Agent", "DeploymentGroup"}
+}
+
+func expandTaskGroupInputs(d *schema.ResourceData) *[]taskagent.TaskInputDefinition {
+	inputs := []taskagent.TaskInputDefinition{}
+	for _, raw := range d.Get("input").([]interface{}) {
+		input := raw.(map[string]interface{})
+		name := input["name"].(string)
+		label := input["label"].(string)
+		if label == "" {
+			label = name
+		}
+		inputs = append(inputs, taskagent.TaskInputDefinition{
+			Name:         converter.String(name),
+			Label:        converter.String(label),
+			Type:         converter.String(input["type"].(string)),
+			DefaultValue: converter.String(input["default_value"].(string)),
+			Required:     converter.Bool(input["required"].(bool)),
+			HelpMarkDown: converter.String(input["help_markdown"].(string)),
+		})
+	}
+	return &inputs
+}
+
+func expandTaskGroupSteps(d *schema.ResourceData) (*[]taskagent.TaskGroupStep, error) {
+	steps := []taskagent.TaskGroupStep{}
+	for _, raw := range d.Get("task").([]interface{}) {
+		task := raw.(map[string]interface{})
+		taskID, err := uuid.Parse(task["task_id"].(string))
+		if err != nil {
+			return nil, fmt.Errorf(" parsing task ID %s: %+v", task["task_id"].(string), err)
+		}
+		steps = append(steps, taskagent.TaskGroupStep{
+			DisplayName:      converter.String(task["display_name"].(string)),
+			Enabled:          converter.Bool(task["enabled"].(bool)),
+			ContinueOnError:  converter.Bool(task["continue_on_error"].(bool)),
+			AlwaysRun:        converter.Bool(task["always_run"].(bool)),
+			Condition:        converter.String(task["condition"].(string)),
+			TimeoutInMinutes: converter.Int(task["timeout_in_minutes"].(int)),
+			Inputs:           expandStringMap(task["inputs"].(map[string]interface{})),
+			Environment:      expandStringMap(task["environment"].(map[string]interface{})),
+			Task: &taskagent.TaskDefinitionReference{
+				Id:             &taskID,
+				VersionSpec:    converter.String(task["version_spec"].(string)),
+				DefinitionType: converter.String(task["definition_type"].(string)),
+			},
+		})
+	}
+	return &steps, nil
+}
+
+func expandStringMap(raw map[string]interface{}) *map[string]string {
+	values := make(map[string]string, len(raw))
+	for key, value := range raw {
+		values[key] = value.(string)
+	}
+	return &values
+}
+
+func flattenTaskGroup(d *schema.ResourceData, taskGroup *taskagent.TaskGroup) error {
+	d.Set("name", converter.ToString(taskGroup.Name, ""))
+	d.Set("description", converter.ToString(taskGroup.Description, ""))
+	d.Set("category", converter.ToString(taskGroup.Category, ""))
+	d.Set("instance_name_format", converter.ToString(taskGroup.InstanceNameFormat, ""))
+	d.Set("revision", converter.ToInt(taskGroup.Revision, 0))
+	if taskGroup.RunsOn != nil {
+		d.Set("runs_on", *taskGroup.RunsOn)
+	}
+	if taskGroup.Version != nil {
+		d.Set("version", fmt.Sprintf("%d.%d.%d",
+			converter.ToInt(taskGroup.Version.Major, 0),
+			converter.ToInt(taskGroup.Version.Minor, 0),
+			converter.ToInt(taskGroup.Version.Patch, 0)))
+	}
+
+	if err := d.Set("input", flattenTaskGroupInputs(taskGroup.Inputs)); err != nil {
+		return fmt.Errorf(" setting inputs of task group %s: %+v", d.Id(), err)
+	}
+	if err := d.Set("task", flattenTaskGroupSteps(taskGroup.Tasks)); err != nil {
+		return fmt.Errorf(" setting tasks of task group %s: %+v", d.Id(), err)
+	}
+	return nil
+}
+
+func flattenTaskGroupInputs(inputs *[]taskagent.TaskInputDefinition) []interface{} {
+	if inputs == nil {
+		return nil
+	}
+	results := make([]interface{}, 0, len(*inputs))
+	for _, input := range *inputs {
+		results = append(results, map[string]interface{}{
+			"name":          converter.ToString(input.Name, ""),
+			"label":         converter.ToString(input.Label, ""),
+			"type":          converter.ToString(input.Type, ""),
+			"default_value": converter.ToString(input.DefaultValue, ""),
+			"required":      converter.ToBool(input.Required, false),
+			"help_markdown": converter.ToString(input.HelpMarkDown, ""),
+		})
+	}
+	return results
+}
+
+func flattenTaskGroupSteps(steps *[]taskagent.TaskGroupStep) []interface{} {
+	if steps == nil {
+		return nil
+	}
+	results := make([]interface{}, 0, len(*steps))
+	for _, step := range *steps {
+		result := map[string]interface{}{
+			"display_name":       converter.ToString(step.DisplayName, ""),
+			"enabled":            converter.ToBool(step.Enabled, false),
+			"continue_on_error":  converter.ToBool(step.ContinueOnError, false),
+			"always_run":         converter.ToBool(step.AlwaysRun, false),
+			"condition":          converter.ToString(step.Condition, ""),
+			"timeout_in_minutes": converter.ToInt(step.TimeoutInMinutes, 0),
+		}
+		if step.Inputs != nil {
+			result["inputs"] = *step.Inputs
+		}
+		if step.Environment != nil {
+			result["environment"] = *step.Environment
+		}
+		if step.Task != nil {
+			if step.Task.Id != nil {
+				result["task_id"] = step.Task.Id.String()
+			}
+			result["version_spec"] = converter.ToString(step.Task.VersionSpec, "")
+			result["definition_type"] = converter.ToString(step.Task.DefinitionType, "")
+		}
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,130 @@
+//go:build (all || resource_task_group) && !exclude_resource_task_group
+// +build all resource_task_group
+// +build !exclude_resource_task_group
+
+package taskagent
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testTaskGroupProjectID = uuid.New().String()
+var testTaskGroupID = uuid.New()
+var testTaskGroupTaskID = uuid.New()
+
+var testTaskGroup = taskagent.TaskGroup{
+	Id:                 &testTaskGroupID,
+	Name:               converter.String("deploy-web"),
+	Description:        converter.String("Deploys the web application"),
+	Category:           converter.String("Deploy"),
+	InstanceNameFormat: converter.String("Deploy $(environment)"),
+	RunsOn:             &[]string{"Agent"},
+	Revision:           converter.Int(3),
+	Version:            &taskagent.TaskVersion{Major: converter.Int(1), Minor: converter.Int(0), Patch: converter.Int(0)},
+	Inputs: &[]taskagent.TaskInputDefinition{{
+		Name:         converter.String("environment"),
+		Label:        converter.String("environment"),
+		Type:         converter.String("string"),
+		DefaultValue: converter.String("test"),
+		Required:     converter.Bool(true),
+		HelpMarkDown: converter.String(""),
+	}},
+	Tasks: &[]taskagent.TaskGroupStep{{
+		DisplayName:      converter.String("Run deployment"),
+		Enabled:          converter.Bool(true),
+		ContinueOnError:  converter.Bool(false),
+		AlwaysRun:        converter.Bool(false),
+		Condition:        converter.String("succeeded()"),
+		TimeoutInMinutes: converter.Int(0),
+		Inputs:           &map[string]string{"script": "deploy.sh $(environment)"},
+		Environment:      &map[string]string{},
+		Task: &taskagent.TaskDefinitionReference{
+			Id:             &testTaskGroupTaskID,
+			VersionSpec:    converter.String("3.*"),
+			DefinitionType: converter.String("task"),
+		},
+	}},
+}
+
+func TestTaskGroup_FlattenExpand_RoundTrip(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	resourceData.SetId(testTaskGroupID.String())
+	require.Nil(t, flattenTaskGroup(resourceData, &testTaskGroup))
+
+	require.Equal(t, 3, resourceData.Get("revision"))
+	require.Equal(t, "1.0.0", resourceData.Get("version"))
+
+	tasks, err := expandTaskGroupSteps(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, *testTaskGroup.Tasks, *tasks)
+	require.Equal(t, *testTaskGroup.Inputs, *expandTaskGroupInputs(resourceData))
+	require.Equal(t, *testTaskGroup.RunsOn, *expandTaskGroupRunsOn(resourceData))
+	require.Equal(t, *testTaskGroup.InstanceNameFormat, *expandTaskGroupInstanceNameFormat(resourceData))
+}
+
+func TestTaskGroup_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	resourceData.Set("project_id", testTaskGroupProjectID)
+	resourceData.Set("name", "deploy-web")
+	resourceData.Set("task", flattenTaskGroupSteps(testTaskGroup.Tasks))
+
+	taskAgentClient.
+		EXPECT().
+		AddTaskGroup(clients.Ctx, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, args taskagent.AddTaskGroupArgs) (*taskagent.TaskGroup, error) {
+			require.Equal(t, testTaskGroupProjectID, *args.Project)
+			require.Equal(t, "Task group: deploy-web", *args.TaskGroup.InstanceNameFormat)
+			require.Equal(t, []string{"Agent", "DeploymentGroup"}, *args.TaskGroup.RunsOn)
+			require.Equal(t, *testTaskGroup.Tasks, *args.TaskGroup.Tasks)
+			return nil, errors.New("AddTaskGroup() Failed")
+		}).
+		Times(1)
+
+	err := resourceTaskGroupCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "AddTaskGroup() Failed")
+}
+
+func TestTaskGroup_Read_DeletedClearsID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	taskAgentClient := azdosdkmocks.NewMockTaskagentClient(ctrl)
+	clients := &client.AggregatedClient{TaskAgentClient: taskAgentClient, Ctx: context.Background()}
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceTaskGroup().Schema, nil)
+	resourceData.Set("project_id", testTaskGroupProjectID)
+	resourceData.SetId(testTaskGroupID.String())
+
+	deleted := testTaskGroup
+	deleted.Deleted = converter.Bool(true)
+	taskAgentClient.
+		EXPECT().
+		GetTaskGroups(clients.Ctx, taskagent.GetTaskGroupsArgs{
+			Project:     converter.String(testTaskGroupProjectID),
+			TaskGroupId: &testTaskGroupID,
+		}).
+		Return(&[]taskagent.TaskGroup{deleted}, nil).
+		Times(1)
+
+	err := resourceTaskGroupRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Id())
+}
@@ -158,6 +158,10 @@ func ResourceVariableGroup() *schema.Resource {
 							Optional: true,
 							Default:  20,
 						},
+						"last_refreshed": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -381,6 +385,7 @@ func expandVariableGroupParameters(clients *client.AggregatedClient, d *schema.R
 			return nil, nil, err
 		}
 
+		// the time of the last refresh is maintained by the service and read back into last_refreshed
 		variableGroup.ProviderData = v5taskagent.AzureKeyVaultVariableGroupProviderData{
 			ServiceEndpointId: &serviceEndpointUUID,
 			Vault:             &kvName,
@@ -529,6 +534,9 @@ func flattenKeyVault(d *schema.ResourceData, variableGroup *v5taskagent.Variable
 		vgName:              providerData.Vault,
 		vgServiceEndpointID: providerData.ServiceEndpointId.String(),
 	}}
+	if providerData.LastRefreshedOn != nil {
+		keyVault[0]["last_refreshed"] = providerData.LastRefreshedOn.String()
+	}
 
 	keyVaultRaw := d.Get("key_vault").([]interface{})
 	if len(keyVaultRaw) == 1 {
@@ -4,9 +4,52 @@
 
 package taskagent
 
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	v5azuredevops "github.com/microsoft/azure-devops-go-api/azuredevops"
+	v5taskagent "github.com/microsoft/azure-devops-go-api/azuredevops/taskagent"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
 // The tests in this file use the mock clients in mock_client.go to mock out
 // the Azure DevOps client operations.
 
+// verifies that the refresh time of a Key Vault linked variable group is surfaced
+func TestVariableGroupKeyVault_Flatten_LastRefreshed(t *testing.T) {
+	serviceEndpointID := uuid.New()
+	refreshed := time.Date(2021, 6, 1, 10, 30, 0, 0, time.UTC)
+	testVariableGroup := v5taskagent.VariableGroup{
+		Id:   converter.Int(100),
+		Name: converter.String("Name"),
+		Type: converter.String(azureKeyVaultType),
+		ProviderData: v5taskagent.AzureKeyVaultVariableGroupProviderData{
+			ServiceEndpointId: &serviceEndpointID,
+			Vault:             converter.String("vault"),
+			LastRefreshedOn:   &v5azuredevops.Time{Time: refreshed},
+		},
+		Variables: &map[string]interface{}{
+			"var1": v5taskagent.AzureKeyVaultVariableValue{
+				IsSecret: converter.Bool(true),
+				Enabled:  converter.Bool(true),
+			},
+		},
+	}
+	resourceData := schema.TestResourceDataRaw(t, ResourceVariableGroup().Schema, nil)
+	projectID := uuid.New().String()
+
+	err := flattenVariableGroup(resourceData, &testVariableGroup, &projectID)
+	require.Nil(t, err)
+
+	require.Equal(t, "vault", resourceData.Get("key_vault.0.name"))
+	require.Equal(t, serviceEndpointID.String(), resourceData.Get("key_vault.0.service_endpoint_id"))
+	require.Equal(t, (&v5azuredevops.Time{Time: refreshed}).String(), resourceData.Get("key_vault.0.last_refreshed"))
+}
+
 //var serviceEndpointResult = &serviceendpoint.ServiceEndpointRequestResult{
 //	ErrorMessage: converter.String(""),
 //	Result: []interface{}{
@@ -0,0 +1,48 @@
+package workitemtracking
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourceArea schema and implementation for area path resource
+func ResourceArea() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceAreaCreate,
+		Read:     resourceAreaRead,
+		Update:   resourceAreaUpdate,
+		Delete:   resourceAreaDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema:   utils.CreateClassificationNodeResourceSchema(map[string]*schema.Schema{}),
+	}
+}
+
+func resourceAreaCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	if err := utils.CreateClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Areas, nil); err != nil {
+		return err
+	}
+	return resourceAreaRead(d, m)
+}
+
+func resourceAreaRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	_, err := utils.ReadClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Areas)
+	return err
+}
+
+func resourceAreaUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	if err := utils.UpdateClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Areas, nil); err != nil {
+		return err
+	}
+	return resourceAreaRead(d, m)
+}
+
+func resourceAreaDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return utils.DeleteClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Areas)
+}
@@ -0,0 +1,162 @@
+package workitemtracking
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+const (
+	iterationDateFormat          = "2006-01-02"
+	iterationAttributeDateFormat = "2006-01-02T15:04:05Z"
+	iterationAttributeStart      = "startDate"
+	iterationAttributeFinish     = "finishDate"
+)
+
+// ResourceIteration schema and implementation for iteration path resource
+func ResourceIteration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIterationCreate,
+		Read:     resourceIterationRead,
+		Update:   resourceIterationUpdate,
+		Delete:   resourceIterationDelete,
+		Importer: tfhelper.ImportProjectQualifiedResourceInteger(),
+		Schema: utils.CreateClassificationNodeResourceSchema(map[string]*schema.Schema{
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"finish_date"},
+				ValidateFunc: validateIterationDate,
+			},
+			"finish_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"start_date"},
+				ValidateFunc: validateIterationDate,
+			},
+		}),
+	}
+}
+
+func resourceIterationCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	var attributes *map[string]interface{}
+	if _, ok := d.GetOk("start_date"); ok {
+		var err error
+		attributes, err = expandIterationAttributes(d)
+		if err != nil {
+			return err
+		}
+	}
+	if err := utils.CreateClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Iterations, attributes); err != nil {
+		return err
+	}
+	return resourceIterationRead(d, m)
+}
+
+func resourceIterationRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	node, err := utils.ReadClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Iterations)
+	if err != nil || node == nil {
+		return err
+	}
+	return flattenIterationAttributes(d, node.Attributes)
+}
+
+func resourceIterationUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	var attributes *map[string]interface{}
+	if d.HasChanges("start_date", "finish_date") {
+		var err error
+		attributes, err = expandIterationAttributes(d)
+		if err != nil {
+			return err
+		}
+	}
+	if err := utils.UpdateClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Iterations, attributes); err != nil {
+		return err
+	}
+	return resourceIterationRead(d, m)
+}
+
+func resourceIterationDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+	return utils.DeleteClassificationNodeResource(clients, d, workitemtracking.TreeStructureGroupValues.Iterations)
+}
+
+func expandIterationAttributes(d *schema.ResourceData) (*map[string]interface{}, error) {
+	startDate := d.Get("start_date").(string)
+	finishDate := d.Get("finish_date").(string)
+	if startDate == "" && finishDate == "" {
+		// clears the dates of an existing iteration
+		return &map[string]interface{}{
+			iterationAttributeStart:  nil,
+			iterationAttributeFinish: nil,
+		}, nil
+	}
+
+	start, err := time.Parse(iterationDateFormat, startDate)
+	if err != nil {
+		return nil, fmt.Errorf(" parsing start_date %q: %+v", startDate, err)
+	}
+	finish, err := time.Parse(iterationDateFormat, finishDate)
+	if err != nil {
+		return nil, fmt.Errorf(" parsing finish_date %q: %+v", finishDate, err)
+	}
+	if finish.Before(start) {
+		return nil, fmt.Errorf(" finish_date %q must not be before start_date %q", finishDate, startDate)
+	}
+
+	return &map[string]interface{}{
+		iterationAttributeStart:  start.Format(iterationAttributeDateFormat),
+		iterationAttributeFinish: finish.Format(iterationAttributeDateFormat),
+	}, nil
+}
+
+func flattenIterationAttributes(d *schema.ResourceData, attributes *map[string]interface{}) error {
+	startDate, err := flattenIterationDate(attributes, iterationAttributeStart)
+	if err != nil {
+		return err
+	}
+	finishDate, err := flattenIterationDate(attributes, iterationAttributeFinish)
+	if err != nil {
+		return err
+	}
+	d.Set("start_date", startDate)
+	d.Set("finish_date", finishDate)
+	return nil
+}
+
+func flattenIterationDate(attributes *map[string]interface{}, key string) (string, error) {
+	if attributes == nil {
+		return "", nil
+	}
+	value, ok := (*attributes)[key].(string)
+	if !ok || value == "" {
+		return "", nil
+	}
+	date, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return "", fmt.Errorf(" parsing iteration attribute %s %q: %+v", key, value, err)
+	}
+	return date.UTC().Format(iterationDateFormat), nil
+}
+
+func validateIterationDate(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+	if _, err := time.Parse(iterationDateFormat, v); err != nil {
+		return nil, []error{fmt.Errorf("expected %q to be a date in the format YYYY-MM-DD, got %q", k, v)}
+	}
+	return nil, nil
+}
@@ -0,0 +1,115 @@
+//go:build all || workitemtracking || resource_iteration
+// +build all workitemtracking resource_iteration
+
+package workitemtracking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testIterationProjectID = "9c3a5552-268c-423c-a9cd-7de0b36b7035"
+
+func TestIteration_Create_WithDates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	structureType := workitemtracking.TreeStructureGroupValues.Iterations
+	witClient.EXPECT().
+		CreateOrUpdateClassificationNode(clients.Ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			Project:        converter.String(testIterationProjectID),
+			StructureGroup: &structureType,
+			Path:           converter.String("Release 1"),
+			PostedNode: &workitemtracking.WorkItemClassificationNode{
+				Name: converter.String("Sprint 1"),
+				Attributes: &map[string]interface{}{
+					"startDate":  "2023-01-02T00:00:00Z",
+					"finishDate": "2023-01-13T00:00:00Z",
+				},
+			},
+		}).
+		Return(&workitemtracking.WorkItemClassificationNode{Id: converter.Int(42)}, nil).
+		Times(1)
+
+	witClient.EXPECT().
+		GetClassificationNodes(clients.Ctx, gomock.Any()).
+		Return(&[]workitemtracking.WorkItemClassificationNode{
+			{
+				Id:   converter.Int(42),
+				Name: converter.String("Sprint 1"),
+				Path: converter.String("\\project\\Iteration\\Release 1\\Sprint 1"),
+				Attributes: &map[string]interface{}{
+					"startDate":  "2023-01-02T00:00:00Z",
+					"finishDate": "2023-01-13T00:00:00Z",
+				},
+			},
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceIteration().Schema, nil)
+	resourceData.Set("project_id", testIterationProjectID)
+	resourceData.Set("name", "Sprint 1")
+	resourceData.Set("parent_path", "/Release 1")
+	resourceData.Set("start_date", "2023-01-02")
+	resourceData.Set("finish_date", "2023-01-13")
+
+	err := resourceIterationCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "42", resourceData.Id())
+	require.Equal(t, "/Release 1/Sprint 1", resourceData.Get("path"))
+	require.Equal(t, "2023-01-02", resourceData.Get("start_date"))
+	require.Equal(t, "2023-01-13", resourceData.Get("finish_date"))
+}
+
+func TestIteration_ExpandAttributes_RejectsFinishBeforeStart(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceIteration().Schema, nil)
+	resourceData.Set("start_date", "2023-01-13")
+	resourceData.Set("finish_date", "2023-01-02")
+
+	attributes, err := expandIterationAttributes(resourceData)
+	require.Nil(t, attributes)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "must not be before")
+}
+
+func TestIteration_ExpandAttributes_ClearsDates(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceIteration().Schema, nil)
+
+	attributes, err := expandIterationAttributes(resourceData)
+	require.Nil(t, err)
+	require.Equal(t, &map[string]interface{}{
+		"startDate":  nil,
+		"finishDate": nil,
+	}, attributes)
+}
+
+func TestIteration_FlattenAttributes_WithoutDates(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceIteration().Schema, nil)
+
+	err := flattenIterationAttributes(resourceData, nil)
+	require.Nil(t, err)
+	require.Equal(t, "", resourceData.Get("start_date"))
+	require.Equal(t, "", resourceData.Get("finish_date"))
+}
+
+func TestIteration_ValidateDate(t *testing.T) {
+	_, errs := validateIterationDate("2023-01-02", "start_date")
+	require.Empty(t, errs)
+
+	_, errs = validateIterationDate("02.01.2023", "start_date")
+	require.Len(t, errs, 1)
+}
@@ -0,0 +1,160 @@
+package workitemtracking
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceWorkItemField schema and implementation for an organization level custom work item field
+func ResourceWorkItemField() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkItemFieldCreate,
+		Read:   resourceWorkItemFieldRead,
+		Delete: resourceWorkItemFieldDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"reference_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(workitemtracking.FieldTypeValues.String),
+					string(workitemtracking.FieldTypeValues.Integer),
+					string(workitemtracking.FieldTypeValues.DateTime),
+					string(workitemtracking.FieldTypeValues.PlainText),
+					string(workitemtracking.FieldTypeValues.Html),
+					string(workitemtracking.FieldTypeValues.Double),
+					string(workitemtracking.FieldTypeValues.Boolean),
+					string(workitemtracking.FieldTypeValues.Identity),
+				}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "",
+			},
+			"picklist_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"is_picklist_suggested": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{"picklist_id"},
+			},
+		},
+	}
+}
+
+func resourceWorkItemFieldCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	fieldType := workitemtracking.FieldType(d.Get("type").(string))
+	field := workitemtracking.WorkItemField{
+		Name:        converter.String(d.Get("name").(string)),
+		Type:        &fieldType,
+		Description: converter.String(d.Get("description").(string)),
+		Usage:       &workitemtracking.FieldUsageValues.WorkItem,
+		ReadOnly:    converter.Bool(false),
+		CanSortBy:   converter.Bool(true),
+		IsQueryable: converter.Bool(true),
+		IsPicklist:  converter.Bool(false),
+	}
+	if v, ok := d.GetOk("reference_name"); ok {
+		field.ReferenceName = converter.String(v.(string))
+	}
+	if v, ok := d.GetOk("picklist_id"); ok {
+		picklistID, err := uuid.Parse(v.(string))
+		if err != nil {
+			return fmt.Errorf(" parsing picklist ID %s: %+v", v.(string), err)
+		}
+		field.PicklistId = &picklistID
+		field.IsPicklist = converter.Bool(true)
+		field.IsPicklistSuggested = converter.Bool(d.Get("is_picklist_suggested").(bool))
+	}
+
+	createdField, err := clients.WorkItemTrackingClient.CreateField(clients.Ctx, workitemtracking.CreateFieldArgs{
+		WorkItemField: &field,
+	})
+	if err != nil {
+		return fmt.Errorf(" creating work item field %s: %+v", *field.Name, err)
+	}
+	if createdField == nil || createdField.ReferenceName == nil {
+		return fmt.Errorf(" creating work item field %s: no reference name returned", *field.Name)
+	}
+
+	d.SetId(*createdField.ReferenceName)
+	return resourceWorkItemFieldRead(d, m)
+}
+
+func resourceWorkItemFieldRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	field, err := clients.WorkItemTrackingClient.GetField(clients.Ctx, workitemtracking.GetFieldArgs{
+		FieldNameOrRefName: converter.String(d.Id()),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading work item field %s: %+v", d.Id(), err)
+	}
+	if field == nil || converter.ToBool(field.IsDeleted, false) {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", converter.ToString(field.Name, ""))
+	d.Set("reference_name", converter.ToString(field.ReferenceName, ""))
+	d.Set("description", converter.ToString(field.Description, ""))
+	if field.Type != nil {
+		d.Set("type", string(*field.Type))
+	}
+	if field.PicklistId != nil {
+		d.Set("picklist_id", field.PicklistId.String())
+		d.Set("is_picklist_suggested", converter.ToBool(field.IsPicklistSuggested, false))
+	}
+	return nil
+}
+
+func resourceWorkItemFieldDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	err := clients.WorkItemTrackingClient.DeleteField(clients.Ctx, workitemtracking.DeleteFieldArgs{
+		FieldNameOrRefName: converter.String(d.Id()),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting work item field %s: %+v", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
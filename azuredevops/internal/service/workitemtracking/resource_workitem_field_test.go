@@ -0,0 +1,164 @@
+//go:build all || workitemtracking || resource_workitem_field
+// +build all workitemtracking resource_workitem_field
+
+package workitemtracking
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWorkItemField_Create_WithPicklist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	picklistID := uuid.New()
+	fieldType := workitemtracking.FieldTypeValues.String
+	createdField := &workitemtracking.WorkItemField{
+		Name:                converter.String("Customer"),
+		ReferenceName:       converter.String("Custom.Customer"),
+		Description:         converter.String("The customer"),
+		Type:                &fieldType,
+		IsPicklist:          converter.Bool(true),
+		IsPicklistSuggested: converter.Bool(true),
+		PicklistId:          &picklistID,
+	}
+
+	witClient.
+		EXPECT().
+		CreateField(clients.Ctx, workitemtracking.CreateFieldArgs{
+			WorkItemField: &workitemtracking.WorkItemField{
+				Name:                converter.String("Customer"),
+				Type:                &fieldType,
+				Description:         converter.String("The customer"),
+				Usage:               &workitemtracking.FieldUsageValues.WorkItem,
+				ReadOnly:            converter.Bool(false),
+				CanSortBy:           converter.Bool(true),
+				IsQueryable:         converter.Bool(true),
+				IsPicklist:          converter.Bool(true),
+				IsPicklistSuggested: converter.Bool(true),
+				PicklistId:          &picklistID,
+			},
+		}).
+		Return(createdField, nil).
+		Times(1)
+
+	witClient.
+		EXPECT().
+		GetField(clients.Ctx, workitemtracking.GetFieldArgs{
+			FieldNameOrRefName: converter.String("Custom.Customer"),
+		}).
+		Return(createdField, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemField().Schema, map[string]interface{}{
+		"name":                  "Customer",
+		"type":                  "string",
+		"description":           "The customer",
+		"picklist_id":           picklistID.String(),
+		"is_picklist_suggested": true,
+	})
+
+	err := resourceWorkItemFieldCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, "Custom.Customer", resourceData.Id())
+	require.Equal(t, "Custom.Customer", resourceData.Get("reference_name"))
+	require.Equal(t, picklistID.String(), resourceData.Get("picklist_id"))
+}
+
+func TestWorkItemField_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	witClient.
+		EXPECT().
+		CreateField(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@CreateField@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemField().Schema, map[string]interface{}{
+		"name": "Customer",
+		"type": "string",
+	})
+
+	err := resourceWorkItemFieldCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@CreateField@@failed@@")
+	require.Empty(t, resourceData.Id())
+}
+
+func TestWorkItemField_Read_HandlesDeletedField(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	witClient.
+		EXPECT().
+		GetField(clients.Ctx, gomock.Any()).
+		Return(&workitemtracking.WorkItemField{
+			ReferenceName: converter.String("Custom.Customer"),
+			IsDeleted:     converter.Bool(true),
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemField().Schema, nil)
+	resourceData.SetId("Custom.Customer")
+
+	err := resourceWorkItemFieldRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
+
+func TestWorkItemField_Delete_IgnoresNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	witClient.
+		EXPECT().
+		DeleteField(clients.Ctx, workitemtracking.DeleteFieldArgs{
+			FieldNameOrRefName: converter.String("Custom.Customer"),
+		}).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemField().Schema, nil)
+	resourceData.SetId("Custom.Customer")
+
+	err := resourceWorkItemFieldDelete(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
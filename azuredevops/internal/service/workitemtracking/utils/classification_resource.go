@@ -0,0 +1,204 @@
+package utils
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// CreateClassificationNodeResourceSchema schema for a managed classification node
+func CreateClassificationNodeResourceSchema(outer map[string]*schema.Schema) map[string]*schema.Schema {
+	baseSchema := map[string]*schema.Schema{
+		"project_id": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+		},
+		"parent_path": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "/",
+			ValidateFunc: validation.StringIsNotWhiteSpace,
+			DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+				return strings.EqualFold(strings.Trim(old, "/"), strings.Trim(new, "/"))
+			},
+		},
+		"path": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"identifier": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"has_children": {
+			Type:     schema.TypeBool,
+			Computed: true,
+		},
+	}
+
+	for key, elem := range baseSchema {
+		outer[key] = elem
+	}
+
+	return outer
+}
+
+// CreateClassificationNodeResource creates a classification node below the configured parent path
+func CreateClassificationNodeResource(clients *client.AggregatedClient, d *schema.ResourceData, structureType workitemtracking.TreeStructureGroup, attributes *map[string]interface{}) error {
+	projectID := d.Get("project_id").(string)
+	parentPath := d.Get("parent_path").(string)
+
+	node, err := clients.WorkItemTrackingClient.CreateOrUpdateClassificationNode(clients.Ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+		Project:        converter.String(projectID),
+		StructureGroup: &structureType,
+		Path:           toClassificationNodeArgPath(parentPath),
+		PostedNode: &workitemtracking.WorkItemClassificationNode{
+			Name:       converter.String(d.Get("name").(string)),
+			Attributes: attributes,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf(" creating %s node %q below %q: %+v", structureType, d.Get("name").(string), parentPath, err)
+	}
+	if node == nil || node.Id == nil {
+		return fmt.Errorf(" creating %s node %q below %q: no node ID returned", structureType, d.Get("name").(string), parentPath)
+	}
+
+	d.SetId(strconv.Itoa(*node.Id))
+	return nil
+}
+
+// ReadClassificationNodeResource reads a managed classification node by its integer ID. The returned
+// node is nil and the resource ID is cleared if the node does not exist anymore.
+func ReadClassificationNodeResource(clients *client.AggregatedClient, d *schema.ResourceData, structureType workitemtracking.TreeStructureGroup) (*workitemtracking.WorkItemClassificationNode, error) {
+	nodeID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return nil, fmt.Errorf(" parsing %s node ID %q: %+v", structureType, d.Id(), err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	nodes, err := clients.WorkItemTrackingClient.GetClassificationNodes(clients.Ctx, workitemtracking.GetClassificationNodesArgs{
+		Project:     converter.String(projectID),
+		Ids:         &[]int{nodeID},
+		ErrorPolicy: &workitemtracking.ClassificationNodesErrorPolicyValues.Omit,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf(" reading %s node %d: %+v", structureType, nodeID, err)
+	}
+
+	if nodes == nil || len(*nodes) == 0 {
+		d.SetId("")
+		return nil, nil
+	}
+
+	node := (*nodes)[0]
+	nodePath := convertNodePath(node.Path)
+	d.Set("project_id", projectID)
+	d.Set("name", converter.ToString(node.Name, ""))
+	d.Set("path", nodePath)
+	d.Set("parent_path", getClassificationNodeParentPath(nodePath))
+	d.Set("has_children", converter.ToBool(node.HasChildren, false))
+	if node.Identifier != nil {
+		d.Set("identifier", node.Identifier.String())
+	}
+	return &node, nil
+}
+
+// UpdateClassificationNodeResource moves and/or renames a managed classification node. The node
+// attributes are only updated if attributes is not nil.
+func UpdateClassificationNodeResource(clients *client.AggregatedClient, d *schema.ResourceData, structureType workitemtracking.TreeStructureGroup, attributes *map[string]interface{}) error {
+	nodeID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing %s node ID %q: %+v", structureType, d.Id(), err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	currentPath := d.Get("path").(string)
+
+	if d.HasChange("parent_path") {
+		parentPath := d.Get("parent_path").(string)
+		// posting an existing node ID to a parent moves the node including its children
+		node, err := clients.WorkItemTrackingClient.CreateOrUpdateClassificationNode(clients.Ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			Project:        converter.String(projectID),
+			StructureGroup: &structureType,
+			Path:           toClassificationNodeArgPath(parentPath),
+			PostedNode: &workitemtracking.WorkItemClassificationNode{
+				Id: converter.Int(nodeID),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf(" moving %s node %q below %q: %+v", structureType, currentPath, parentPath, err)
+		}
+		if node != nil {
+			currentPath = convertNodePath(node.Path)
+		}
+	}
+
+	if d.HasChange("name") || attributes != nil {
+		_, err := clients.WorkItemTrackingClient.UpdateClassificationNode(clients.Ctx, workitemtracking.UpdateClassificationNodeArgs{
+			Project:        converter.String(projectID),
+			StructureGroup: &structureType,
+			Path:           toClassificationNodeArgPath(currentPath),
+			PostedNode: &workitemtracking.WorkItemClassificationNode{
+				Name:       converter.String(d.Get("name").(string)),
+				Attributes: attributes,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf(" updating %s node %q: %+v", structureType, currentPath, err)
+		}
+	}
+	return nil
+}
+
+// DeleteClassificationNodeResource deletes a managed classification node including its children
+func DeleteClassificationNodeResource(clients *client.AggregatedClient, d *schema.ResourceData, structureType workitemtracking.TreeStructureGroup) error {
+	nodePath := d.Get("path").(string)
+	err := clients.WorkItemTrackingClient.DeleteClassificationNode(clients.Ctx, workitemtracking.DeleteClassificationNodeArgs{
+		Project:        converter.String(d.Get("project_id").(string)),
+		StructureGroup: &structureType,
+		Path:           toClassificationNodeArgPath(nodePath),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting %s node %q: %+v", structureType, nodePath, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// toClassificationNodeArgPath converts a path like /parent/child into the format expected by the
+// classification node API. The root node is addressed without a path.
+func toClassificationNodeArgPath(nodePath string) *string {
+	nodePath = strings.Trim(strings.TrimSpace(nodePath), "/")
+	if nodePath == "" {
+		return nil
+	}
+	return converter.String(nodePath)
+}
+
+func getClassificationNodeParentPath(nodePath string) string {
+	idx := strings.LastIndex(nodePath, "/")
+	if idx <= 0 {
+		return "/"
+	}
+	return nodePath[:idx]
+}
@@ -0,0 +1,177 @@
+//go:build all || utils || workitemtracking
+// +build all utils workitemtracking
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassificationResource_Create_BelowParentPath(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	structureType := workitemtracking.TreeStructureGroupValues.Areas
+	witClient.EXPECT().
+		CreateOrUpdateClassificationNode(clients.Ctx, workitemtracking.CreateOrUpdateClassificationNodeArgs{
+			Project:        converter.String(classificationProjectID),
+			StructureGroup: &structureType,
+			Path:           converter.String("parent/child"),
+			PostedNode: &workitemtracking.WorkItemClassificationNode{
+				Name: converter.String("node"),
+			},
+		}).
+		Return(&workitemtracking.WorkItemClassificationNode{Id: converter.Int(42)}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, CreateClassificationNodeResourceSchema(map[string]*schema.Schema{}), nil)
+	resourceData.Set("project_id", classificationProjectID)
+	resourceData.Set("name", "node")
+	resourceData.Set("parent_path", "/parent/child/")
+
+	err := CreateClassificationNodeResource(clients, resourceData, structureType, nil)
+	require.Nil(t, err)
+	require.Equal(t, "42", resourceData.Id())
+}
+
+func TestClassificationResource_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	witClient.EXPECT().
+		CreateOrUpdateClassificationNode(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@CreateOrUpdateClassificationNode@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, CreateClassificationNodeResourceSchema(map[string]*schema.Schema{}), nil)
+	resourceData.Set("project_id", classificationProjectID)
+	resourceData.Set("name", "node")
+
+	err := CreateClassificationNodeResource(clients, resourceData, workitemtracking.TreeStructureGroupValues.Areas, nil)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@CreateOrUpdateClassificationNode@@failed@@")
+}
+
+func TestClassificationResource_Read(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	structureType := workitemtracking.TreeStructureGroupValues.Areas
+	parent := newClassificationTestNode(structureType, nil)
+	node := newClassificationTestNode(structureType, parent)
+
+	witClient.EXPECT().
+		GetClassificationNodes(clients.Ctx, workitemtracking.GetClassificationNodesArgs{
+			Project:     converter.String(classificationProjectID),
+			Ids:         &[]int{42},
+			ErrorPolicy: &workitemtracking.ClassificationNodesErrorPolicyValues.Omit,
+		}).
+		Return(&[]workitemtracking.WorkItemClassificationNode{*convertClassificationTestNode(node)}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, CreateClassificationNodeResourceSchema(map[string]*schema.Schema{}), nil)
+	resourceData.SetId("42")
+	resourceData.Set("project_id", classificationProjectID)
+
+	result, err := ReadClassificationNodeResource(clients, resourceData, structureType)
+	require.Nil(t, err)
+	require.NotNil(t, result)
+	require.Equal(t, "42", resourceData.Id())
+	require.Equal(t, node.name, resourceData.Get("name"))
+	require.Equal(t, node.path, resourceData.Get("path"))
+	require.Equal(t, parent.path, resourceData.Get("parent_path"))
+	require.Equal(t, node.id, resourceData.Get("identifier"))
+	require.Equal(t, false, resourceData.Get("has_children"))
+}
+
+func TestClassificationResource_Read_ClearsIDWhenNodeIsGone(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	witClient.EXPECT().
+		GetClassificationNodes(clients.Ctx, gomock.Any()).
+		Return(&[]workitemtracking.WorkItemClassificationNode{}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, CreateClassificationNodeResourceSchema(map[string]*schema.Schema{}), nil)
+	resourceData.SetId("42")
+	resourceData.Set("project_id", classificationProjectID)
+
+	result, err := ReadClassificationNodeResource(clients, resourceData, workitemtracking.TreeStructureGroupValues.Areas)
+	require.Nil(t, err)
+	require.Nil(t, result)
+	require.Empty(t, resourceData.Id())
+}
+
+func TestClassificationResource_Delete_IgnoresNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	witClient := azdosdkmocks.NewMockWorkitemtrackingClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingClient: witClient,
+		Ctx:                    context.Background(),
+	}
+
+	structureType := workitemtracking.TreeStructureGroupValues.Areas
+	witClient.EXPECT().
+		DeleteClassificationNode(clients.Ctx, workitemtracking.DeleteClassificationNodeArgs{
+			Project:        converter.String(classificationProjectID),
+			StructureGroup: &structureType,
+			Path:           converter.String("parent/node"),
+		}).
+		Return(azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, CreateClassificationNodeResourceSchema(map[string]*schema.Schema{}), nil)
+	resourceData.SetId("42")
+	resourceData.Set("project_id", classificationProjectID)
+	resourceData.Set("path", "/parent/node")
+
+	err := DeleteClassificationNodeResource(clients, resourceData, structureType)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
+
+func TestClassificationResource_ParentPath(t *testing.T) {
+	require.Equal(t, "/", getClassificationNodeParentPath("/node"))
+	require.Equal(t, "/parent", getClassificationNodeParentPath("/parent/node"))
+	require.Equal(t, "/a/b", getClassificationNodeParentPath("/a/b/node"))
+	require.Nil(t, toClassificationNodeArgPath("/"))
+	require.Equal(t, "a/b", *toClassificationNodeArgPath("/a/b/"))
+}
@@ -0,0 +1,154 @@
+package workitemtrackingprocess
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/tfhelper"
+)
+
+// ResourcePicklist schema and implementation for a picklist providing the allowed values of custom work item fields
+func ResourcePicklist() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePicklistCreate,
+		Read:   resourcePicklistRead,
+		Update: resourcePicklistUpdate,
+		Delete: resourcePicklistDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "String",
+				ValidateFunc: validation.StringInSlice([]string{"String", "Integer"}, false),
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringIsNotWhiteSpace,
+				},
+			},
+			"is_suggested": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourcePicklistCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	picklist := expandPicklist(d)
+	picklist.Type = converter.String(d.Get("type").(string))
+
+	createdPicklist, err := clients.WorkItemTrackingProcessClient.CreateList(clients.Ctx, workitemtrackingprocess.CreateListArgs{
+		Picklist: picklist,
+	})
+	if err != nil {
+		return fmt.Errorf(" creating picklist %s: %+v", *picklist.Name, err)
+	}
+	if createdPicklist == nil || createdPicklist.Id == nil {
+		return fmt.Errorf(" creating picklist %s: no picklist ID returned", *picklist.Name)
+	}
+
+	d.SetId(createdPicklist.Id.String())
+	return resourcePicklistRead(d, m)
+}
+
+func resourcePicklistRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	picklistID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing picklist ID %s: %+v", d.Id(), err)
+	}
+
+	picklist, err := clients.WorkItemTrackingProcessClient.GetList(clients.Ctx, workitemtrackingprocess.GetListArgs{
+		ListId: &picklistID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading picklist %s: %+v", picklistID, err)
+	}
+
+	d.Set("name", converter.ToString(picklist.Name, ""))
+	d.Set("type", converter.ToString(picklist.Type, ""))
+	d.Set("is_suggested", converter.ToBool(picklist.IsSuggested, false))
+	items := []string{}
+	if picklist.Items != nil {
+		items = *picklist.Items
+	}
+	d.Set("items", items)
+	return nil
+}
+
+func resourcePicklistUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	picklistID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing picklist ID %s: %+v", d.Id(), err)
+	}
+
+	picklist := expandPicklist(d)
+	picklist.Id = &picklistID
+	_, err = clients.WorkItemTrackingProcessClient.UpdateList(clients.Ctx, workitemtrackingprocess.UpdateListArgs{
+		ListId:   &picklistID,
+		Picklist: picklist,
+	})
+	if err != nil {
+		return fmt.Errorf(" updating picklist %s: %+v", picklistID, err)
+	}
+
+	return resourcePicklistRead(d, m)
+}
+
+func resourcePicklistDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	picklistID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing picklist ID %s: %+v", d.Id(), err)
+	}
+
+	err = clients.WorkItemTrackingProcessClient.DeleteList(clients.Ctx, workitemtrackingprocess.DeleteListArgs{
+		ListId: &picklistID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting picklist %s: %+v", picklistID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandPicklist(d *schema.ResourceData) *workitemtrackingprocess.PickList {
+	items := tfhelper.ExpandStringList(d.Get("items").([]interface{}))
+	return &workitemtrackingprocess.PickList{
+		Name:        converter.String(d.Get("name").(string)),
+		IsSuggested: converter.Bool(d.Get("is_suggested").(bool)),
+		Items:       &items,
+	}
+}
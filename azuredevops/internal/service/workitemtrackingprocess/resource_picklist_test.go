@@ -0,0 +1,126 @@
+//go:build (all || resource_process_picklist) && !exclude_resource_process_picklist
+// +build all resource_process_picklist
+// +build !exclude_resource_process_picklist
+
+package workitemtrackingprocess
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPicklist_Create_KeepsItemOrder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	picklistID := uuid.New()
+	items := []string{"Gold", "Silver", "Bronze"}
+	picklist := &workitemtrackingprocess.PickList{
+		Id:          &picklistID,
+		Name:        converter.String("Tiers"),
+		Type:        converter.String("String"),
+		IsSuggested: converter.Bool(true),
+		Items:       &items,
+	}
+
+	processClient.
+		EXPECT().
+		CreateList(clients.Ctx, workitemtrackingprocess.CreateListArgs{
+			Picklist: &workitemtrackingprocess.PickList{
+				Name:        converter.String("Tiers"),
+				Type:        converter.String("String"),
+				IsSuggested: converter.Bool(true),
+				Items:       &items,
+			},
+		}).
+		Return(picklist, nil).
+		Times(1)
+
+	processClient.
+		EXPECT().
+		GetList(clients.Ctx, workitemtrackingprocess.GetListArgs{
+			ListId: &picklistID,
+		}).
+		Return(picklist, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourcePicklist().Schema, map[string]interface{}{
+		"name":         "Tiers",
+		"items":        []interface{}{"Gold", "Silver", "Bronze"},
+		"is_suggested": true,
+	})
+
+	err := resourcePicklistCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, picklistID.String(), resourceData.Id())
+	require.Equal(t, []interface{}{"Gold", "Silver", "Bronze"}, resourceData.Get("items"))
+	require.Equal(t, "String", resourceData.Get("type"))
+}
+
+func TestPicklist_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		CreateList(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@CreateList@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourcePicklist().Schema, map[string]interface{}{
+		"name":  "Tiers",
+		"items": []interface{}{"Gold"},
+	})
+
+	err := resourcePicklistCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@CreateList@@failed@@")
+	require.Empty(t, resourceData.Id())
+}
+
+func TestPicklist_Read_HandlesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		GetList(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourcePicklist().Schema, nil)
+	resourceData.SetId(uuid.New().String())
+
+	err := resourcePicklistRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
@@ -0,0 +1,191 @@
+package workitemtrackingprocess
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceProcess schema and implementation for an inherited process resource
+func ResourceProcess() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProcessCreate,
+		Read:   resourceProcessRead,
+		Update: resourceProcessUpdate,
+		Delete: resourceProcessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"parent_process_type_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"reference_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"is_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"is_default": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"customization_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProcessCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	parentProcessTypeID, err := uuid.Parse(d.Get("parent_process_type_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing parent process type ID %s: %+v", d.Get("parent_process_type_id").(string), err)
+	}
+
+	createRequest := workitemtrackingprocess.CreateProcessModel{
+		Name:                converter.String(d.Get("name").(string)),
+		Description:         converter.String(d.Get("description").(string)),
+		ParentProcessTypeId: &parentProcessTypeID,
+	}
+	if v, ok := d.GetOk("reference_name"); ok {
+		createRequest.ReferenceName = converter.String(v.(string))
+	}
+
+	process, err := clients.WorkItemTrackingProcessClient.CreateNewProcess(clients.Ctx, workitemtrackingprocess.CreateNewProcessArgs{
+		CreateRequest: &createRequest,
+	})
+	if err != nil {
+		return fmt.Errorf(" creating process %s: %+v", d.Get("name").(string), err)
+	}
+	if process == nil || process.TypeId == nil {
+		return fmt.Errorf(" creating process %s: no process type ID returned", d.Get("name").(string))
+	}
+	d.SetId(process.TypeId.String())
+
+	// a new process is always enabled and never the default process of the organization
+	isEnabled := d.Get("is_enabled").(bool)
+	isDefault := d.Get("is_default").(bool)
+	if !isEnabled || isDefault {
+		if err := editProcess(clients, process.TypeId, &workitemtrackingprocess.UpdateProcessModel{
+			IsEnabled: converter.Bool(isEnabled),
+			IsDefault: converter.Bool(isDefault),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourceProcessRead(d, m)
+}
+
+func resourceProcessRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processTypeID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing process type ID %s: %+v", d.Id(), err)
+	}
+
+	process, err := clients.WorkItemTrackingProcessClient.GetProcessByItsId(clients.Ctx, workitemtrackingprocess.GetProcessByItsIdArgs{
+		ProcessTypeId: &processTypeID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading process %s: %+v", processTypeID, err)
+	}
+
+	d.Set("name", converter.ToString(process.Name, ""))
+	d.Set("description", converter.ToString(process.Description, ""))
+	d.Set("reference_name", converter.ToString(process.ReferenceName, ""))
+	d.Set("is_enabled", converter.ToBool(process.IsEnabled, false))
+	d.Set("is_default", converter.ToBool(process.IsDefault, false))
+	if process.ParentProcessTypeId != nil {
+		d.Set("parent_process_type_id", process.ParentProcessTypeId.String())
+	}
+	if process.CustomizationType != nil {
+		d.Set("customization_type", string(*process.CustomizationType))
+	}
+	return nil
+}
+
+func resourceProcessUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processTypeID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing process type ID %s: %+v", d.Id(), err)
+	}
+
+	if err := editProcess(clients, &processTypeID, &workitemtrackingprocess.UpdateProcessModel{
+		Name:        converter.String(d.Get("name").(string)),
+		Description: converter.String(d.Get("description").(string)),
+		IsEnabled:   converter.Bool(d.Get("is_enabled").(bool)),
+		IsDefault:   converter.Bool(d.Get("is_default").(bool)),
+	}); err != nil {
+		return err
+	}
+
+	return resourceProcessRead(d, m)
+}
+
+func resourceProcessDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processTypeID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing process type ID %s: %+v", d.Id(), err)
+	}
+
+	err = clients.WorkItemTrackingProcessClient.DeleteProcessById(clients.Ctx, workitemtrackingprocess.DeleteProcessByIdArgs{
+		ProcessTypeId: &processTypeID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting process %s: %+v", processTypeID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func editProcess(clients *client.AggregatedClient, processTypeID *uuid.UUID, updateRequest *workitemtrackingprocess.UpdateProcessModel) error {
+	_, err := clients.WorkItemTrackingProcessClient.EditProcess(clients.Ctx, workitemtrackingprocess.EditProcessArgs{
+		ProcessTypeId: processTypeID,
+		UpdateRequest: updateRequest,
+	})
+	if err != nil {
+		return fmt.Errorf(" updating process %s: %+v", processTypeID, err)
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+//go:build (all || resource_process) && !exclude_resource_process
+// +build all resource_process
+// +build !exclude_resource_process
+
+package workitemtrackingprocess
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var agileProcessTypeID = uuid.MustParse("adcc42ab-9882-485e-a3ed-7678f01f66bc")
+
+func TestProcess_Create_DisablesProcessAfterCreation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processTypeID := uuid.New()
+	process := &workitemtrackingprocess.ProcessInfo{
+		TypeId:              &processTypeID,
+		Name:                converter.String("Custom Agile"),
+		Description:         converter.String("Managed by Terraform"),
+		ParentProcessTypeId: &agileProcessTypeID,
+		ReferenceName:       converter.String("Inherited.Custom"),
+		IsEnabled:           converter.Bool(false),
+		IsDefault:           converter.Bool(false),
+		CustomizationType:   &workitemtrackingprocess.CustomizationTypeValues.Inherited,
+	}
+
+	processClient.
+		EXPECT().
+		CreateNewProcess(clients.Ctx, workitemtrackingprocess.CreateNewProcessArgs{
+			CreateRequest: &workitemtrackingprocess.CreateProcessModel{
+				Name:                converter.String("Custom Agile"),
+				Description:         converter.String("Managed by Terraform"),
+				ParentProcessTypeId: &agileProcessTypeID,
+			},
+		}).
+		Return(process, nil).
+		Times(1)
+
+	processClient.
+		EXPECT().
+		EditProcess(clients.Ctx, workitemtrackingprocess.EditProcessArgs{
+			ProcessTypeId: &processTypeID,
+			UpdateRequest: &workitemtrackingprocess.UpdateProcessModel{
+				IsEnabled: converter.Bool(false),
+				IsDefault: converter.Bool(false),
+			},
+		}).
+		Return(process, nil).
+		Times(1)
+
+	processClient.
+		EXPECT().
+		GetProcessByItsId(clients.Ctx, workitemtrackingprocess.GetProcessByItsIdArgs{
+			ProcessTypeId: &processTypeID,
+		}).
+		Return(process, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProcess().Schema, map[string]interface{}{
+		"name":                   "Custom Agile",
+		"description":            "Managed by Terraform",
+		"parent_process_type_id": agileProcessTypeID.String(),
+		"is_enabled":             false,
+	})
+
+	err := resourceProcessCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, processTypeID.String(), resourceData.Id())
+	require.Equal(t, "Inherited.Custom", resourceData.Get("reference_name"))
+	require.Equal(t, false, resourceData.Get("is_enabled"))
+	require.Equal(t, "inherited", resourceData.Get("customization_type"))
+}
+
+func TestProcess_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		CreateNewProcess(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@CreateNewProcess@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProcess().Schema, map[string]interface{}{
+		"name":                   "Custom Agile",
+		"parent_process_type_id": agileProcessTypeID.String(),
+	})
+
+	err := resourceProcessCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@CreateNewProcess@@failed@@")
+	require.Empty(t, resourceData.Id())
+}
+
+func TestProcess_Read_HandlesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		GetProcessByItsId(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceProcess().Schema, nil)
+	resourceData.SetId(uuid.New().String())
+
+	err := resourceProcessRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
@@ -0,0 +1,262 @@
+package workitemtrackingprocess
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+// ResourceWorkItemTypeField schema and implementation for adding a field to a work item type of an inherited process
+func ResourceWorkItemTypeField() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkItemTypeFieldCreate,
+		Read:   resourceWorkItemTypeFieldRead,
+		Update: resourceWorkItemTypeFieldUpdate,
+		Delete: resourceWorkItemTypeFieldDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceWorkItemTypeFieldImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"process_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"work_item_type_reference_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"field_reference_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"required": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"read_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"allow_groups": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"default_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "",
+			},
+			"layout_group_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"label": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"layout_group_id"},
+			},
+		},
+	}
+}
+
+func resourceWorkItemTypeFieldCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing process ID %s: %+v", d.Get("process_id").(string), err)
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+	fieldRefName := d.Get("field_reference_name").(string)
+
+	request := workitemtrackingprocess.AddProcessWorkItemTypeFieldRequest{
+		ReferenceName: converter.String(fieldRefName),
+		Required:      converter.Bool(d.Get("required").(bool)),
+		ReadOnly:      converter.Bool(d.Get("read_only").(bool)),
+		AllowGroups:   converter.Bool(d.Get("allow_groups").(bool)),
+	}
+	if v, ok := d.GetOk("default_value"); ok {
+		request.DefaultValue = v.(string)
+	}
+
+	_, err = clients.WorkItemTrackingProcessClient.AddFieldToWorkItemType(clients.Ctx, workitemtrackingprocess.AddFieldToWorkItemTypeArgs{
+		ProcessId:  &processID,
+		WitRefName: converter.String(witRefName),
+		Field:      &request,
+	})
+	if err != nil {
+		return fmt.Errorf(" adding field %s to work item type %s of process %s: %+v", fieldRefName, witRefName, processID, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", processID, witRefName, fieldRefName))
+
+	if v, ok := d.GetOk("layout_group_id"); ok {
+		control := workitemtrackingprocess.Control{
+			Id:      converter.String(fieldRefName),
+			Visible: converter.Bool(true),
+		}
+		if label, ok := d.GetOk("label"); ok {
+			control.Label = converter.String(label.(string))
+		}
+		_, err = clients.WorkItemTrackingProcessClient.CreateControlInGroup(clients.Ctx, workitemtrackingprocess.CreateControlInGroupArgs{
+			ProcessId:  &processID,
+			WitRefName: converter.String(witRefName),
+			GroupId:    converter.String(v.(string)),
+			Control:    &control,
+		})
+		if err != nil {
+			return fmt.Errorf(" adding field %s to layout group %s of work item type %s: %+v", fieldRefName, v.(string), witRefName, err)
+		}
+	}
+
+	return resourceWorkItemTypeFieldRead(d, m)
+}
+
+func resourceWorkItemTypeFieldRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing process ID %s: %+v", d.Get("process_id").(string), err)
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+	fieldRefName := d.Get("field_reference_name").(string)
+
+	field, err := clients.WorkItemTrackingProcessClient.GetWorkItemTypeField(clients.Ctx, workitemtrackingprocess.GetWorkItemTypeFieldArgs{
+		ProcessId:    &processID,
+		WitRefName:   converter.String(witRefName),
+		FieldRefName: converter.String(fieldRefName),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading field %s of work item type %s of process %s: %+v", fieldRefName, witRefName, processID, err)
+	}
+
+	d.Set("required", converter.ToBool(field.Required, false))
+	d.Set("read_only", converter.ToBool(field.ReadOnly, false))
+	d.Set("allow_groups", converter.ToBool(field.AllowGroups, false))
+	defaultValue := ""
+	if field.DefaultValue != nil {
+		defaultValue = fmt.Sprintf("%v", field.DefaultValue)
+	}
+	d.Set("default_value", defaultValue)
+	return nil
+}
+
+func resourceWorkItemTypeFieldUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing process ID %s: %+v", d.Get("process_id").(string), err)
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+	fieldRefName := d.Get("field_reference_name").(string)
+
+	if d.HasChanges("required", "read_only", "allow_groups", "default_value") {
+		request := workitemtrackingprocess.UpdateProcessWorkItemTypeFieldRequest{
+			Required:     converter.Bool(d.Get("required").(bool)),
+			ReadOnly:     converter.Bool(d.Get("read_only").(bool)),
+			AllowGroups:  converter.Bool(d.Get("allow_groups").(bool)),
+			DefaultValue: d.Get("default_value").(string),
+		}
+		_, err = clients.WorkItemTrackingProcessClient.UpdateWorkItemTypeField(clients.Ctx, workitemtrackingprocess.UpdateWorkItemTypeFieldArgs{
+			ProcessId:    &processID,
+			WitRefName:   converter.String(witRefName),
+			FieldRefName: converter.String(fieldRefName),
+			Field:        &request,
+		})
+		if err != nil {
+			return fmt.Errorf(" updating field %s of work item type %s of process %s: %+v", fieldRefName, witRefName, processID, err)
+		}
+	}
+
+	if v, ok := d.GetOk("layout_group_id"); ok && d.HasChange("label") {
+		_, err = clients.WorkItemTrackingProcessClient.UpdateControl(clients.Ctx, workitemtrackingprocess.UpdateControlArgs{
+			ProcessId:  &processID,
+			WitRefName: converter.String(witRefName),
+			GroupId:    converter.String(v.(string)),
+			ControlId:  converter.String(fieldRefName),
+			Control: &workitemtrackingprocess.Control{
+				Label: converter.String(d.Get("label").(string)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf(" updating layout control of field %s of work item type %s: %+v", fieldRefName, witRefName, err)
+		}
+	}
+
+	return resourceWorkItemTypeFieldRead(d, m)
+}
+
+func resourceWorkItemTypeFieldDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing process ID %s: %+v", d.Get("process_id").(string), err)
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+	fieldRefName := d.Get("field_reference_name").(string)
+
+	if v, ok := d.GetOk("layout_group_id"); ok {
+		err = clients.WorkItemTrackingProcessClient.RemoveControlFromGroup(clients.Ctx, workitemtrackingprocess.RemoveControlFromGroupArgs{
+			ProcessId:  &processID,
+			WitRefName: converter.String(witRefName),
+			GroupId:    converter.String(v.(string)),
+			ControlId:  converter.String(fieldRefName),
+		})
+		if err != nil && !utils.ResponseWasNotFound(err) {
+			return fmt.Errorf(" removing field %s from layout group %s of work item type %s: %+v", fieldRefName, v.(string), witRefName, err)
+		}
+	}
+
+	err = clients.WorkItemTrackingProcessClient.RemoveWorkItemTypeField(clients.Ctx, workitemtrackingprocess.RemoveWorkItemTypeFieldArgs{
+		ProcessId:    &processID,
+		WitRefName:   converter.String(witRefName),
+		FieldRefName: converter.String(fieldRefName),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" removing field %s from work item type %s of process %s: %+v", fieldRefName, witRefName, processID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceWorkItemTypeFieldImport imports a field of a work item type by an ID that looks like
+// <process ID>/<work item type reference name>/<field reference name>. The layout placement is not imported.
+func resourceWorkItemTypeFieldImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <process ID>/<work item type reference name>/<field reference name>", d.Id())
+	}
+	if _, err := uuid.Parse(parts[0]); err != nil {
+		return nil, fmt.Errorf("process ID was expected to be a UUID, but was not: %+v", err)
+	}
+
+	d.Set("process_id", parts[0])
+	d.Set("work_item_type_reference_name", parts[1])
+	d.Set("field_reference_name", parts[2])
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,169 @@
+//go:build (all || resource_process_workitemtype_field) && !exclude_resource_process_workitemtype_field
+// +build all resource_process_workitemtype_field
+// +build !exclude_resource_process_workitemtype_field
+
+package workitemtrackingprocess
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemTypeFieldProcessID = uuid.MustParse("2f1b3b1a-3c4e-4c5e-9f3a-0a1b2c3d4e5f")
+
+func TestWorkItemTypeField_Create_AddsControlToLayoutGroup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		AddFieldToWorkItemType(clients.Ctx, workitemtrackingprocess.AddFieldToWorkItemTypeArgs{
+			ProcessId:  &testWorkItemTypeFieldProcessID,
+			WitRefName: converter.String("Custom.Bug"),
+			Field: &workitemtrackingprocess.AddProcessWorkItemTypeFieldRequest{
+				ReferenceName: converter.String("Custom.Customer"),
+				Required:      converter.Bool(true),
+				ReadOnly:      converter.Bool(false),
+				AllowGroups:   converter.Bool(false),
+				DefaultValue:  "Contoso",
+			},
+		}).
+		Return(&workitemtrackingprocess.ProcessWorkItemTypeField{}, nil).
+		Times(1)
+
+	processClient.
+		EXPECT().
+		CreateControlInGroup(clients.Ctx, workitemtrackingprocess.CreateControlInGroupArgs{
+			ProcessId:  &testWorkItemTypeFieldProcessID,
+			WitRefName: converter.String("Custom.Bug"),
+			GroupId:    converter.String("Custom.Bug.Details"),
+			Control: &workitemtrackingprocess.Control{
+				Id:      converter.String("Custom.Customer"),
+				Label:   converter.String("Customer"),
+				Visible: converter.Bool(true),
+			},
+		}).
+		Return(&workitemtrackingprocess.Control{}, nil).
+		Times(1)
+
+	processClient.
+		EXPECT().
+		GetWorkItemTypeField(clients.Ctx, workitemtrackingprocess.GetWorkItemTypeFieldArgs{
+			ProcessId:    &testWorkItemTypeFieldProcessID,
+			WitRefName:   converter.String("Custom.Bug"),
+			FieldRefName: converter.String("Custom.Customer"),
+		}).
+		Return(&workitemtrackingprocess.ProcessWorkItemTypeField{
+			ReferenceName: converter.String("Custom.Customer"),
+			Required:      converter.Bool(true),
+			ReadOnly:      converter.Bool(false),
+			AllowGroups:   converter.Bool(false),
+			DefaultValue:  "Contoso",
+		}, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeField().Schema, map[string]interface{}{
+		"process_id":                    testWorkItemTypeFieldProcessID.String(),
+		"work_item_type_reference_name": "Custom.Bug",
+		"field_reference_name":          "Custom.Customer",
+		"required":                      true,
+		"default_value":                 "Contoso",
+		"layout_group_id":               "Custom.Bug.Details",
+		"label":                         "Customer",
+	})
+
+	err := resourceWorkItemTypeFieldCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, testWorkItemTypeFieldProcessID.String()+"/Custom.Bug/Custom.Customer", resourceData.Id())
+	require.Equal(t, true, resourceData.Get("required"))
+	require.Equal(t, "Contoso", resourceData.Get("default_value"))
+}
+
+func TestWorkItemTypeField_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		AddFieldToWorkItemType(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@AddFieldToWorkItemType@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeField().Schema, map[string]interface{}{
+		"process_id":                    testWorkItemTypeFieldProcessID.String(),
+		"work_item_type_reference_name": "Custom.Bug",
+		"field_reference_name":          "Custom.Customer",
+	})
+
+	err := resourceWorkItemTypeFieldCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@AddFieldToWorkItemType@@failed@@")
+	require.Empty(t, resourceData.Id())
+}
+
+func TestWorkItemTypeField_Read_HandlesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		GetWorkItemTypeField(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeField().Schema, map[string]interface{}{
+		"process_id":                    testWorkItemTypeFieldProcessID.String(),
+		"work_item_type_reference_name": "Custom.Bug",
+		"field_reference_name":          "Custom.Customer",
+	})
+	resourceData.SetId(testWorkItemTypeFieldProcessID.String() + "/Custom.Bug/Custom.Customer")
+
+	err := resourceWorkItemTypeFieldRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
+
+func TestWorkItemTypeField_Import_ParsesID(t *testing.T) {
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeField().Schema, nil)
+	resourceData.SetId(testWorkItemTypeFieldProcessID.String() + "/Custom.Bug/Custom.Customer")
+
+	result, err := resourceWorkItemTypeFieldImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, testWorkItemTypeFieldProcessID.String(), resourceData.Get("process_id"))
+	require.Equal(t, "Custom.Bug", resourceData.Get("work_item_type_reference_name"))
+	require.Equal(t, "Custom.Customer", resourceData.Get("field_reference_name"))
+
+	resourceData.SetId("Custom.Bug/Custom.Customer")
+	_, err = resourceWorkItemTypeFieldImport(resourceData, nil)
+	require.NotNil(t, err)
+}
@@ -0,0 +1,228 @@
+package workitemtrackingprocess
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+)
+
+var stateColorRegex = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// ResourceWorkItemTypeState schema and implementation for a custom state of a work item type of an inherited process
+func ResourceWorkItemTypeState() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkItemTypeStateCreate,
+		Read:   resourceWorkItemTypeStateRead,
+		Update: resourceWorkItemTypeStateUpdate,
+		Delete: resourceWorkItemTypeStateDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceWorkItemTypeStateImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"process_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+			"work_item_type_reference_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Proposed",
+					"InProgress",
+					"Resolved",
+					"Completed",
+					"Removed",
+				}, false),
+			},
+			"color": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(stateColorRegex, "color must be a hex color code without a leading #, e.g. b2b2b2"),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"order": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"customization_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkItemTypeStateCreate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing process ID %s: %+v", d.Get("process_id").(string), err)
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+
+	stateModel := expandWorkItemTypeState(d)
+	stateModel.Name = converter.String(d.Get("name").(string))
+
+	state, err := clients.WorkItemTrackingProcessClient.CreateStateDefinition(clients.Ctx, workitemtrackingprocess.CreateStateDefinitionArgs{
+		ProcessId:  &processID,
+		WitRefName: converter.String(witRefName),
+		StateModel: stateModel,
+	})
+	if err != nil {
+		return fmt.Errorf(" creating state %s of work item type %s of process %s: %+v", *stateModel.Name, witRefName, processID, err)
+	}
+	if state == nil || state.Id == nil {
+		return fmt.Errorf(" creating state %s of work item type %s of process %s: no state ID returned", *stateModel.Name, witRefName, processID)
+	}
+
+	d.SetId(state.Id.String())
+	return resourceWorkItemTypeStateRead(d, m)
+}
+
+func resourceWorkItemTypeStateRead(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, stateID, err := parseWorkItemTypeStateIDs(d)
+	if err != nil {
+		return err
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+
+	state, err := clients.WorkItemTrackingProcessClient.GetStateDefinition(clients.Ctx, workitemtrackingprocess.GetStateDefinitionArgs{
+		ProcessId:  processID,
+		WitRefName: converter.String(witRefName),
+		StateId:    stateID,
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading state %s of work item type %s of process %s: %+v", stateID, witRefName, processID, err)
+	}
+
+	d.Set("name", converter.ToString(state.Name, ""))
+	d.Set("category", converter.ToString(state.StateCategory, ""))
+	d.Set("color", converter.ToString(state.Color, ""))
+	if state.Order != nil {
+		d.Set("order", *state.Order)
+	}
+	if state.CustomizationType != nil {
+		d.Set("customization_type", string(*state.CustomizationType))
+	}
+	return nil
+}
+
+func resourceWorkItemTypeStateUpdate(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, stateID, err := parseWorkItemTypeStateIDs(d)
+	if err != nil {
+		return err
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+
+	_, err = clients.WorkItemTrackingProcessClient.UpdateStateDefinition(clients.Ctx, workitemtrackingprocess.UpdateStateDefinitionArgs{
+		ProcessId:  processID,
+		WitRefName: converter.String(witRefName),
+		StateId:    stateID,
+		StateModel: expandWorkItemTypeState(d),
+	})
+	if err != nil {
+		return fmt.Errorf(" updating state %s of work item type %s of process %s: %+v", stateID, witRefName, processID, err)
+	}
+
+	return resourceWorkItemTypeStateRead(d, m)
+}
+
+func resourceWorkItemTypeStateDelete(d *schema.ResourceData, m interface{}) error {
+	clients := m.(*client.AggregatedClient)
+
+	processID, stateID, err := parseWorkItemTypeStateIDs(d)
+	if err != nil {
+		return err
+	}
+	witRefName := d.Get("work_item_type_reference_name").(string)
+
+	err = clients.WorkItemTrackingProcessClient.DeleteStateDefinition(clients.Ctx, workitemtrackingprocess.DeleteStateDefinitionArgs{
+		ProcessId:  processID,
+		WitRefName: converter.String(witRefName),
+		StateId:    stateID,
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" deleting state %s of work item type %s of process %s: %+v", stateID, witRefName, processID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceWorkItemTypeStateImport imports a state of a work item type by an ID that looks like
+// <process ID>/<work item type reference name>/<state ID>
+func resourceWorkItemTypeStateImport(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return nil, fmt.Errorf("unexpected format of ID (%s), expected <process ID>/<work item type reference name>/<state ID>", d.Id())
+	}
+	if _, err := uuid.Parse(parts[0]); err != nil {
+		return nil, fmt.Errorf("process ID was expected to be a UUID, but was not: %+v", err)
+	}
+	if _, err := uuid.Parse(parts[2]); err != nil {
+		return nil, fmt.Errorf("state ID was expected to be a UUID, but was not: %+v", err)
+	}
+
+	d.Set("process_id", parts[0])
+	d.Set("work_item_type_reference_name", parts[1])
+	d.SetId(parts[2])
+	return []*schema.ResourceData{d}, nil
+}
+
+func parseWorkItemTypeStateIDs(d *schema.ResourceData) (*uuid.UUID, *uuid.UUID, error) {
+	processID, err := uuid.Parse(d.Get("process_id").(string))
+	if err != nil {
+		return nil, nil, fmt.Errorf(" parsing process ID %s: %+v", d.Get("process_id").(string), err)
+	}
+	stateID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return nil, nil, fmt.Errorf(" parsing state ID %s: %+v", d.Id(), err)
+	}
+	return &processID, &stateID, nil
+}
+
+func expandWorkItemTypeState(d *schema.ResourceData) *workitemtrackingprocess.WorkItemStateInputModel {
+	stateModel := &workitemtrackingprocess.WorkItemStateInputModel{
+		StateCategory: converter.String(d.Get("category").(string)),
+		Color:         converter.String(d.Get("color").(string)),
+	}
+	if v, ok := d.GetOk("order"); ok {
+		stateModel.Order = converter.Int(v.(int))
+	}
+	return stateModel
+}
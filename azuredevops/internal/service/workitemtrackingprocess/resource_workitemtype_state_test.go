@@ -0,0 +1,156 @@
+//go:build (all || resource_process_workitemtype_state) && !exclude_resource_process_workitemtype_state
+// +build all resource_process_workitemtype_state
+// +build !exclude_resource_process_workitemtype_state
+
+package workitemtrackingprocess
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/workitemtrackingprocess"
+	"github.com/microsoft/terraform-provider-azuredevops/azdosdkmocks"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/stretchr/testify/require"
+)
+
+var testWorkItemTypeStateProcessID = uuid.MustParse("5b7a4c2e-1d3f-4a6b-8c9d-0e1f2a3b4c5d")
+
+func TestWorkItemTypeState_Create(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	stateID := uuid.New()
+	state := &workitemtrackingprocess.WorkItemStateResultModel{
+		Id:                &stateID,
+		Name:              converter.String("Ready for test"),
+		StateCategory:     converter.String("InProgress"),
+		Color:             converter.String("5688e0"),
+		Order:             converter.Int(3),
+		CustomizationType: &workitemtrackingprocess.CustomizationTypeValues.Custom,
+	}
+
+	processClient.
+		EXPECT().
+		CreateStateDefinition(clients.Ctx, workitemtrackingprocess.CreateStateDefinitionArgs{
+			ProcessId:  &testWorkItemTypeStateProcessID,
+			WitRefName: converter.String("Custom.Bug"),
+			StateModel: &workitemtrackingprocess.WorkItemStateInputModel{
+				Name:          converter.String("Ready for test"),
+				StateCategory: converter.String("InProgress"),
+				Color:         converter.String("5688e0"),
+			},
+		}).
+		Return(state, nil).
+		Times(1)
+
+	processClient.
+		EXPECT().
+		GetStateDefinition(clients.Ctx, workitemtrackingprocess.GetStateDefinitionArgs{
+			ProcessId:  &testWorkItemTypeStateProcessID,
+			WitRefName: converter.String("Custom.Bug"),
+			StateId:    &stateID,
+		}).
+		Return(state, nil).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeState().Schema, map[string]interface{}{
+		"process_id":                    testWorkItemTypeStateProcessID.String(),
+		"work_item_type_reference_name": "Custom.Bug",
+		"name":                          "Ready for test",
+		"category":                      "InProgress",
+		"color":                         "5688e0",
+	})
+
+	err := resourceWorkItemTypeStateCreate(resourceData, clients)
+	require.Nil(t, err)
+	require.Equal(t, stateID.String(), resourceData.Id())
+	require.Equal(t, 3, resourceData.Get("order"))
+	require.Equal(t, "custom", resourceData.Get("customization_type"))
+}
+
+func TestWorkItemTypeState_Create_DoesNotSwallowError(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		CreateStateDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, fmt.Errorf("@@CreateStateDefinition@@failed@@")).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeState().Schema, map[string]interface{}{
+		"process_id":                    testWorkItemTypeStateProcessID.String(),
+		"work_item_type_reference_name": "Custom.Bug",
+		"name":                          "Ready for test",
+		"category":                      "InProgress",
+		"color":                         "5688e0",
+	})
+
+	err := resourceWorkItemTypeStateCreate(resourceData, clients)
+	require.NotNil(t, err)
+	require.Contains(t, err.Error(), "@@CreateStateDefinition@@failed@@")
+	require.Empty(t, resourceData.Id())
+}
+
+func TestWorkItemTypeState_Read_HandlesNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	processClient := azdosdkmocks.NewMockWorkitemtrackingprocessClient(ctrl)
+	clients := &client.AggregatedClient{
+		WorkItemTrackingProcessClient: processClient,
+		Ctx:                           context.Background(),
+	}
+
+	processClient.
+		EXPECT().
+		GetStateDefinition(clients.Ctx, gomock.Any()).
+		Return(nil, azuredevops.WrappedError{StatusCode: converter.Int(404)}).
+		Times(1)
+
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeState().Schema, map[string]interface{}{
+		"process_id":                    testWorkItemTypeStateProcessID.String(),
+		"work_item_type_reference_name": "Custom.Bug",
+	})
+	resourceData.SetId(uuid.New().String())
+
+	err := resourceWorkItemTypeStateRead(resourceData, clients)
+	require.Nil(t, err)
+	require.Empty(t, resourceData.Id())
+}
+
+func TestWorkItemTypeState_Import_ParsesID(t *testing.T) {
+	stateID := uuid.New()
+	resourceData := schema.TestResourceDataRaw(t, ResourceWorkItemTypeState().Schema, nil)
+	resourceData.SetId(testWorkItemTypeStateProcessID.String() + "/Custom.Bug/" + stateID.String())
+
+	result, err := resourceWorkItemTypeStateImport(resourceData, nil)
+	require.Nil(t, err)
+	require.Len(t, result, 1)
+	require.Equal(t, stateID.String(), resourceData.Id())
+	require.Equal(t, testWorkItemTypeStateProcessID.String(), resourceData.Get("process_id"))
+	require.Equal(t, "Custom.Bug", resourceData.Get("work_item_type_reference_name"))
+
+	resourceData.SetId(testWorkItemTypeStateProcessID.String() + "/Custom.Bug/Ready")
+	_, err = resourceWorkItemTypeStateImport(resourceData, nil)
+	require.NotNil(t, err)
+}
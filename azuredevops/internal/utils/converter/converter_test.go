@@ -26,6 +26,11 @@ func TestInt(t *testing.T) {
 	}
 }
 
+func TestToInt(t *testing.T) {
+	assert.Equal(t, 123456, ToInt(Int(123456), 0))
+	assert.Equal(t, 7, ToInt(nil, 7))
+}
+
 func TestBoolTrue(t *testing.T) {
 	value := true
 	valuePtr := Bool(value)
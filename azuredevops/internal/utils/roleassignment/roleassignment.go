@@ -0,0 +1,148 @@
+// Package roleassignment implements resources assigning a security role to an identity. Role assignments of
+// different resources only differ in the scope the role is assigned on.
+package roleassignment
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles"
+)
+
+// ScopeFunc returns the security role scope and the ID of the resource the role is assigned on
+type ScopeFunc func(d *schema.ResourceData) (string, string)
+
+// Resource returns the schema and implementation of a role assignment on the resource identified by scope.
+// The given schema identifies the resource and is extended by the identity_id and role_name attributes.
+// The ID of a role assignment is the ID of the identity.
+func Resource(resourceSchema map[string]*schema.Schema, roles []string, scope ScopeFunc, importer schema.StateFunc) *schema.Resource {
+	resourceSchema["identity_id"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: validation.IsUUID,
+	}
+	resourceSchema["role_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		ValidateFunc: validation.StringInSlice(roles, false),
+	}
+
+	read := func(d *schema.ResourceData, m interface{}) error {
+		return resourceRoleAssignmentRead(d, m, scope)
+	}
+	createOrUpdate := func(d *schema.ResourceData, m interface{}) error {
+		return resourceRoleAssignmentCreateOrUpdate(d, m, scope)
+	}
+	return &schema.Resource{
+		Create: createOrUpdate,
+		Read:   read,
+		Update: createOrUpdate,
+		Delete: func(d *schema.ResourceData, m interface{}) error {
+			return resourceRoleAssignmentDelete(d, m, scope)
+		},
+		Importer: &schema.ResourceImporter{
+			State: importer,
+		},
+		Schema: resourceSchema,
+	}
+}
+
+func resourceRoleAssignmentCreateOrUpdate(d *schema.ResourceData, m interface{}, scope ScopeFunc) error {
+	clients := m.(*client.AggregatedClient)
+
+	identityID, err := uuid.Parse(d.Get("identity_id").(string))
+	if err != nil {
+		return fmt.Errorf(" parsing identity ID %s: %+v", d.Get("identity_id").(string), err)
+	}
+
+	scopeID, resourceID := scope(d)
+	_, err = clients.SecurityRolesClient.SetRoleAssignments(clients.Ctx, securityroles.SetRoleAssignmentsArgs{
+		RoleAssignments: &[]securityroles.UserRoleAssignmentRef{{
+			RoleName: converter.String(d.Get("role_name").(string)),
+			UserId:   &identityID,
+		}},
+		ScopeId:    converter.String(scopeID),
+		ResourceId: converter.String(resourceID),
+	})
+	if err != nil {
+		return fmt.Errorf(" assigning role to identity %s on %s: %+v", identityID, resourceID, err)
+	}
+
+	d.SetId(identityID.String())
+	return resourceRoleAssignmentRead(d, m, scope)
+}
+
+func resourceRoleAssignmentRead(d *schema.ResourceData, m interface{}, scope ScopeFunc) error {
+	clients := m.(*client.AggregatedClient)
+
+	scopeID, resourceID := scope(d)
+	assignments, err := clients.SecurityRolesClient.GetRoleAssignments(clients.Ctx, securityroles.GetRoleAssignmentsArgs{
+		ScopeId:    converter.String(scopeID),
+		ResourceId: converter.String(resourceID),
+	})
+	if err != nil {
+		if utils.ResponseWasNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf(" reading role assignments of %s: %+v", resourceID, err)
+	}
+
+	assignment := findAssignedRole(assignments, d.Id())
+	if assignment == nil {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("identity_id", d.Id())
+	if assignment.Role != nil {
+		d.Set("role_name", converter.ToString(assignment.Role.Name, ""))
+	}
+	return nil
+}
+
+func resourceRoleAssignmentDelete(d *schema.ResourceData, m interface{}, scope ScopeFunc) error {
+	clients := m.(*client.AggregatedClient)
+
+	identityID, err := uuid.Parse(d.Id())
+	if err != nil {
+		return fmt.Errorf(" parsing identity ID %s: %+v", d.Id(), err)
+	}
+
+	scopeID, resourceID := scope(d)
+	err = clients.SecurityRolesClient.RemoveRoleAssignments(clients.Ctx, securityroles.RemoveRoleAssignmentsArgs{
+		IdentityIds: &[]uuid.UUID{identityID},
+		ScopeId:     converter.String(scopeID),
+		ResourceId:  converter.String(resourceID),
+	})
+	if err != nil && !utils.ResponseWasNotFound(err) {
+		return fmt.Errorf(" removing role assignment of identity %s on %s: %+v", identityID, resourceID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// findAssignedRole returns the role assigned to an identity, ignoring roles inherited from a parent scope
+func findAssignedRole(assignments *[]securityroles.RoleAssignment, identityID string) *securityroles.RoleAssignment {
+	if assignments == nil {
+		return nil
+	}
+	for _, assignment := range *assignments {
+		if assignment.Identity == nil || assignment.Identity.Id == nil || !strings.EqualFold(*assignment.Identity.Id, identityID) {
+			continue
+		}
+		if assignment.Access != nil && *assignment.Access != securityroles.RoleAccessValues.Assigned {
+			continue
+		}
+		return &assignment
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+//go:build all || helper || roleassignment
+// +build all helper roleassignment
+
+package roleassignment
+
+import (
+	"testing"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/utils/converter"
+	"github.com/microsoft/terraform-provider-azuredevops/sdk/securityroles"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindAssignedRole_IgnoresInheritedRoles(t *testing.T) {
+	inherited := securityroles.RoleAccessValues.Inherited
+	assigned := securityroles.RoleAccessValues.Assigned
+	assignments := &[]securityroles.RoleAssignment{
+		{
+			Access:   &inherited,
+			Identity: &webapi.IdentityRef{Id: converter.String("ID")},
+			Role:     &securityroles.SecurityRole{Name: converter.String("Reader")},
+		},
+		{
+			Access:   &assigned,
+			Identity: &webapi.IdentityRef{Id: converter.String("id")},
+			Role:     &securityroles.SecurityRole{Name: converter.String("Administrator")},
+		},
+	}
+
+	assignment := findAssignedRole(assignments, "id")
+	require.NotNil(t, assignment)
+	require.Equal(t, "Administrator", *assignment.Role.Name)
+
+	require.Nil(t, findAssignedRole(assignments, "other"))
+	require.Nil(t, findAssignedRole(nil, "id"))
+}
@@ -7,8 +7,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/client"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/approvalsandchecks"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/build"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/core"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/extensionmanagement"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/git"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/graph"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/memberentitlementmanagement"
@@ -18,6 +20,7 @@ import (
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/serviceendpoint"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/taskagent"
 	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtracking"
+	"github.com/microsoft/terraform-provider-azuredevops/azuredevops/internal/service/workitemtrackingprocess"
 )
 
 // Provider - The top level Azure DevOps Provider definition.
@@ -25,6 +28,7 @@ func Provider() *schema.Provider {
 	p := &schema.Provider{
 		ResourcesMap: map[string]*schema.Resource{
 			"azuredevops_resource_authorization":                 build.ResourceResourceAuthorization(),
+			"azuredevops_pipeline_authorization":                 build.ResourcePipelineAuthorization(),
 			"azuredevops_branch_policy_build_validation":         branch.ResourceBranchPolicyBuildValidation(),
 			"azuredevops_branch_policy_min_reviewers":            branch.ResourceBranchPolicyMinReviewers(),
 			"azuredevops_branch_policy_auto_reviewers":           branch.ResourceBranchPolicyAutoReviewers(),
@@ -34,10 +38,24 @@ func Provider() *schema.Provider {
 			"azuredevops_branch_policy_status_check":             branch.ResourceBranchPolicyStatusCheck(),
 			"azuredevops_build_definition":                       build.ResourceBuildDefinition(),
 			"azuredevops_build_folder":                           build.ResourceBuildFolder(),
+			"azuredevops_build_retention_lease":                  build.ResourceBuildRetentionLease(),
+			"azuredevops_check_approval":                         approvalsandchecks.ResourceCheckApproval(),
+			"azuredevops_check_azure_function":                   approvalsandchecks.ResourceCheckAzureFunction(),
+			"azuredevops_check_branch_control":                   approvalsandchecks.ResourceCheckBranchControl(),
+			"azuredevops_check_business_hours":                   approvalsandchecks.ResourceCheckBusinessHours(),
+			"azuredevops_check_exclusive_lock":                   approvalsandchecks.ResourceCheckExclusiveLock(),
+			"azuredevops_check_required_template":                approvalsandchecks.ResourceCheckRequiredTemplate(),
+			"azuredevops_check_rest_api":                         approvalsandchecks.ResourceCheckRestAPI(),
 			"azuredevops_project":                                core.ResourceProject(),
 			"azuredevops_project_features":                       core.ResourceProjectFeatures(),
 			"azuredevops_project_pipeline_settings":              core.ResourceProjectPipelineSettings(),
+			"azuredevops_project_properties":                     core.ResourceProjectProperties(),
+			"azuredevops_project_retention_settings":             core.ResourceProjectRetentionSettings(),
+			"azuredevops_organization_policies":                  core.ResourceOrganizationPolicies(),
 			"azuredevops_variable_group":                         taskagent.ResourceVariableGroup(),
+			"azuredevops_library_role_assignment":                taskagent.ResourceLibraryRoleAssignment(),
+			"azuredevops_secure_file":                            taskagent.ResourceSecureFile(),
+			"azuredevops_task_group":                             taskagent.ResourceTaskGroup(),
 			"azuredevops_repository_policy_author_email_pattern": repository.ResourceRepositoryPolicyAuthorEmailPatterns(),
 			"azuredevops_repository_policy_file_path_pattern":    repository.ResourceRepositoryFilePathPatterns(),
 			"azuredevops_repository_policy_case_enforcement":     repository.ResourceRepositoryEnforceConsistentCase(),
@@ -47,6 +65,8 @@ func Provider() *schema.Provider {
 			"azuredevops_repository_policy_check_credentials":    repository.ResourceRepositoryPolicyCheckCredentials(),
 			"azuredevops_serviceendpoint_argocd":                 serviceendpoint.ResourceServiceEndpointArgoCD(),
 			"azuredevops_serviceendpoint_artifactory":            serviceendpoint.ResourceServiceEndpointArtifactory(),
+			"azuredevops_serviceendpoint_jfrog_distribution":     serviceendpoint.ResourceServiceEndpointJFrogDistribution(),
+			"azuredevops_serviceendpoint_jfrog_xray":             serviceendpoint.ResourceServiceEndpointJFrogXray(),
 			"azuredevops_serviceendpoint_aws":                    serviceendpoint.ResourceServiceEndpointAws(),
 			"azuredevops_serviceendpoint_azurerm":                serviceendpoint.ResourceServiceEndpointAzureRM(),
 			"azuredevops_serviceendpoint_bitbucket":              serviceendpoint.ResourceServiceEndpointBitBucket(),
@@ -60,56 +80,83 @@ func Provider() *schema.Provider {
 			"azuredevops_serviceendpoint_octopusdeploy":          serviceendpoint.ResourceServiceEndpointOctopusDeploy(),
 			"azuredevops_serviceendpoint_runpipeline":            serviceendpoint.ResourceServiceEndpointRunPipeline(),
 			"azuredevops_serviceendpoint_servicefabric":          serviceendpoint.ResourceServiceEndpointServiceFabric(),
+			"azuredevops_serviceendpoint_role_assignment":        serviceendpoint.ResourceServiceEndpointRoleAssignment(),
+			"azuredevops_serviceendpoint_share":                  serviceendpoint.ResourceServiceEndpointShare(),
 			"azuredevops_serviceendpoint_sonarqube":              serviceendpoint.ResourceServiceEndpointSonarQube(),
 			"azuredevops_serviceendpoint_sonarcloud":             serviceendpoint.ResourceServiceEndpointSonarCloud(),
 			"azuredevops_serviceendpoint_ssh":                    serviceendpoint.ResourceServiceEndpointSSH(),
 			"azuredevops_serviceendpoint_npm":                    serviceendpoint.ResourceServiceEndpointNpm(),
 			"azuredevops_serviceendpoint_generic":                serviceendpoint.ResourceServiceEndpointGeneric(),
+			"azuredevops_serviceendpoint_gcp":                    serviceendpoint.ResourceServiceEndpointGcp(),
 			"azuredevops_serviceendpoint_generic_git":            serviceendpoint.ResourceServiceEndpointGenericGit(),
 			"azuredevops_serviceendpoint_externaltfs":            serviceendpoint.ResourceServiceEndpointExternalTFS(),
 			"azuredevops_git_repository":                         git.ResourceGitRepository(),
 			"azuredevops_git_repository_branch":                  git.ResourceGitRepositoryBranch(),
 			"azuredevops_git_repository_file":                    git.ResourceGitRepositoryFile(),
+			"azuredevops_git_repository_searchable_branches":     git.ResourceGitRepositorySearchableBranches(),
 			"azuredevops_user_entitlement":                       memberentitlementmanagement.ResourceUserEntitlement(),
 			"azuredevops_group_membership":                       graph.ResourceGroupMembership(),
 			"azuredevops_agent_pool":                             taskagent.ResourceAgentPool(),
 			"azuredevops_agent_queue":                            taskagent.ResourceAgentQueue(),
+			"azuredevops_agent_pool_role_assignment":             taskagent.ResourceAgentPoolRoleAssignment(),
 			"azuredevops_group":                                  graph.ResourceGroup(),
 			"azuredevops_project_permissions":                    permissions.ResourceProjectPermissions(),
 			"azuredevops_git_permissions":                        permissions.ResourceGitPermissions(),
 			"azuredevops_workitemquery_permissions":              permissions.ResourceWorkItemQueryPermissions(),
+			"azuredevops_workitem_field":                         workitemtracking.ResourceWorkItemField(),
+			"azuredevops_area":                                   workitemtracking.ResourceArea(),
 			"azuredevops_area_permissions":                       permissions.ResourceAreaPermissions(),
+			"azuredevops_iteration":                              workitemtracking.ResourceIteration(),
 			"azuredevops_iteration_permissions":                  permissions.ResourceIterationPermissions(),
+			"azuredevops_process":                                workitemtrackingprocess.ResourceProcess(),
+			"azuredevops_process_picklist":                       workitemtrackingprocess.ResourcePicklist(),
+			"azuredevops_process_workitemtype_field":             workitemtrackingprocess.ResourceWorkItemTypeField(),
+			"azuredevops_process_workitemtype_state":             workitemtrackingprocess.ResourceWorkItemTypeState(),
+			"azuredevops_test_plan_permissions":                  permissions.ResourceTestPlanPermissions(),
 			"azuredevops_build_definition_permissions":           permissions.ResourceBuildDefinitionPermissions(),
 			"azuredevops_build_folder_permissions":               permissions.ResourceBuildFolderPermissions(),
 			"azuredevops_team":                                   core.ResourceTeam(),
 			"azuredevops_team_members":                           core.ResourceTeamMembers(),
 			"azuredevops_team_administrators":                    core.ResourceTeamAdministrators(),
+			"azuredevops_team_iterations":                        core.ResourceTeamIterations(),
 			"azuredevops_serviceendpoint_permissions":            permissions.ResourceServiceEndpointPermissions(),
 			"azuredevops_servicehook_permissions":                permissions.ResourceServiceHookPermissions(),
 			"azuredevops_tagging_permissions":                    permissions.ResourceTaggingPermissions(),
+			"azuredevops_permissions_baseline":                   permissions.ResourcePermissionsBaseline(),
 			"azuredevops_environment":                            taskagent.ResourceEnvironment(),
+			"azuredevops_deployment_group":                       taskagent.ResourceDeploymentGroup(),
+			"azuredevops_environment_kubernetes":                 taskagent.ResourceEnvironmentKubernetes(),
+			"azuredevops_governance_policy_assignment":           extensionmanagement.ResourceGovernancePolicyAssignment(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"azuredevops_build_definition":        build.DataBuildDefinition(),
-			"azuredevops_agent_pool":              taskagent.DataAgentPool(),
-			"azuredevops_agent_pools":             taskagent.DataAgentPools(),
-			"azuredevops_agent_queue":             taskagent.DataAgentQueue(),
-			"azuredevops_client_config":           service.DataClientConfig(),
-			"azuredevops_group":                   graph.DataGroup(),
-			"azuredevops_project":                 core.DataProject(),
-			"azuredevops_projects":                core.DataProjects(),
-			"azuredevops_git_repositories":        git.DataGitRepositories(),
-			"azuredevops_git_repository":          git.DataGitRepository(),
-			"azuredevops_users":                   graph.DataUsers(),
-			"azuredevops_area":                    workitemtracking.DataArea(),
-			"azuredevops_iteration":               workitemtracking.DataIteration(),
-			"azuredevops_team":                    core.DataTeam(),
-			"azuredevops_teams":                   core.DataTeams(),
-			"azuredevops_groups":                  graph.DataGroups(),
-			"azuredevops_variable_group":          taskagent.DataVariableGroup(),
-			"azuredevops_serviceendpoint_azurerm": serviceendpoint.DataServiceEndpointAzureRM(),
-			"azuredevops_serviceendpoint_github":  serviceendpoint.DataServiceEndpointGithub(),
+			"azuredevops_build_definition":             build.DataBuildDefinition(),
+			"azuredevops_build_definitions":            build.DataBuildDefinitions(),
+			"azuredevops_build_status_badge":           build.DataBuildStatusBadge(),
+			"azuredevops_build_queue_position":         build.DataBuildQueuePosition(),
+			"azuredevops_agent_pool":                   taskagent.DataAgentPool(),
+			"azuredevops_agent_pools":                  taskagent.DataAgentPools(),
+			"azuredevops_agent_queue":                  taskagent.DataAgentQueue(),
+			"azuredevops_agents":                       taskagent.DataAgents(),
+			"azuredevops_deployment_group_targets":     taskagent.DataDeploymentGroupTargets(),
+			"azuredevops_client_config":                service.DataClientConfig(),
+			"azuredevops_org_policy_values":            core.DataOrgPolicyValues(),
+			"azuredevops_group":                        graph.DataGroup(),
+			"azuredevops_project":                      core.DataProject(),
+			"azuredevops_projects":                     core.DataProjects(),
+			"azuredevops_git_repositories":             git.DataGitRepositories(),
+			"azuredevops_git_repository":               git.DataGitRepository(),
+			"azuredevops_users":                        graph.DataUsers(),
+			"azuredevops_area":                         workitemtracking.DataArea(),
+			"azuredevops_iteration":                    workitemtracking.DataIteration(),
+			"azuredevops_team":                         core.DataTeam(),
+			"azuredevops_teams":                        core.DataTeams(),
+			"azuredevops_team_members":                 core.DataTeamMembers(),
+			"azuredevops_groups":                       graph.DataGroups(),
+			"azuredevops_well_known_group_descriptors": graph.DataWellKnownGroupDescriptors(),
+			"azuredevops_variable_group":               taskagent.DataVariableGroup(),
+			"azuredevops_serviceendpoint":              serviceendpoint.DataServiceEndpoint(),
+			"azuredevops_serviceendpoint_azurerm":      serviceendpoint.DataServiceEndpointAzureRM(),
+			"azuredevops_serviceendpoint_github":       serviceendpoint.DataServiceEndpointGithub(),
 		},
 		Schema: map[string]*schema.Schema{
 			"org_service_url": {
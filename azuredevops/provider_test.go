@@ -10,6 +10,7 @@ import (
 func TestProvider_HasChildResources(t *testing.T) {
 	expectedResources := []string{
 		"azuredevops_resource_authorization",
+		"azuredevops_pipeline_authorization",
 		"azuredevops_build_definition",
 		"azuredevops_build_definition_permissions",
 		"azuredevops_branch_policy_build_validation",
@@ -22,6 +23,9 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_project",
 		"azuredevops_project_features",
 		"azuredevops_project_pipeline_settings",
+		"azuredevops_project_properties",
+		"azuredevops_project_retention_settings",
+		"azuredevops_organization_policies",
 		"azuredevops_serviceendpoint_github",
 		"azuredevops_serviceendpoint_github_enterprise",
 		"azuredevops_serviceendpoint_dockerregistry",
@@ -32,19 +36,27 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_serviceendpoint_bitbucket",
 		"azuredevops_serviceendpoint_kubernetes",
 		"azuredevops_serviceendpoint_servicefabric",
+		"azuredevops_serviceendpoint_role_assignment",
+		"azuredevops_serviceendpoint_share",
 		"azuredevops_serviceendpoint_argocd",
 		"azuredevops_serviceendpoint_aws",
 		"azuredevops_serviceendpoint_artifactory",
+		"azuredevops_serviceendpoint_jfrog_distribution",
+		"azuredevops_serviceendpoint_jfrog_xray",
 		"azuredevops_serviceendpoint_sonarqube",
 		"azuredevops_serviceendpoint_sonarcloud",
 		"azuredevops_serviceendpoint_ssh",
 		"azuredevops_serviceendpoint_npm",
 		"azuredevops_serviceendpoint_generic",
+		"azuredevops_serviceendpoint_gcp",
 		"azuredevops_serviceendpoint_generic_git",
 		"azuredevops_serviceendpoint_octopusdeploy",
 		"azuredevops_serviceendpoint_incomingwebhook",
 		"azuredevops_serviceendpoint_externaltfs",
 		"azuredevops_variable_group",
+		"azuredevops_library_role_assignment",
+		"azuredevops_secure_file",
+		"azuredevops_task_group",
 		"azuredevops_repository_policy_author_email_pattern",
 		"azuredevops_repository_policy_case_enforcement",
 		"azuredevops_repository_policy_file_path_pattern",
@@ -55,24 +67,47 @@ func TestProvider_HasChildResources(t *testing.T) {
 		"azuredevops_git_repository",
 		"azuredevops_git_repository_branch",
 		"azuredevops_git_repository_file",
+		"azuredevops_git_repository_searchable_branches",
 		"azuredevops_user_entitlement",
 		"azuredevops_group_membership",
 		"azuredevops_group",
 		"azuredevops_agent_pool",
 		"azuredevops_agent_queue",
+		"azuredevops_agent_pool_role_assignment",
 		"azuredevops_project_permissions",
 		"azuredevops_git_permissions",
 		"azuredevops_workitemquery_permissions",
+		"azuredevops_workitem_field",
+		"azuredevops_area",
 		"azuredevops_area_permissions",
+		"azuredevops_iteration",
 		"azuredevops_iteration_permissions",
+		"azuredevops_process",
+		"azuredevops_process_picklist",
+		"azuredevops_process_workitemtype_field",
+		"azuredevops_process_workitemtype_state",
+		"azuredevops_test_plan_permissions",
 		"azuredevops_team",
 		"azuredevops_team_members",
 		"azuredevops_team_administrators",
+		"azuredevops_team_iterations",
 		"azuredevops_serviceendpoint_permissions",
 		"azuredevops_servicehook_permissions",
 		"azuredevops_tagging_permissions",
+		"azuredevops_permissions_baseline",
 		"azuredevops_environment",
+		"azuredevops_deployment_group",
+		"azuredevops_environment_kubernetes",
+		"azuredevops_governance_policy_assignment",
 		"azuredevops_build_folder",
+		"azuredevops_build_retention_lease",
+		"azuredevops_check_approval",
+		"azuredevops_check_azure_function",
+		"azuredevops_check_branch_control",
+		"azuredevops_check_business_hours",
+		"azuredevops_check_exclusive_lock",
+		"azuredevops_check_required_template",
+		"azuredevops_check_rest_api",
 		"azuredevops_build_folder_permissions",
 	}
 
@@ -88,7 +123,11 @@ func TestProvider_HasChildResources(t *testing.T) {
 func TestProvider_HasChildDataSources(t *testing.T) {
 	expectedDataSources := []string{
 		"azuredevops_build_definition",
+		"azuredevops_build_definitions",
+		"azuredevops_build_status_badge",
+		"azuredevops_build_queue_position",
 		"azuredevops_client_config",
+		"azuredevops_org_policy_values",
 		"azuredevops_group",
 		"azuredevops_project",
 		"azuredevops_projects",
@@ -98,12 +137,17 @@ func TestProvider_HasChildDataSources(t *testing.T) {
 		"azuredevops_agent_pool",
 		"azuredevops_agent_pools",
 		"azuredevops_agent_queue",
+		"azuredevops_agents",
+		"azuredevops_deployment_group_targets",
 		"azuredevops_area",
 		"azuredevops_iteration",
 		"azuredevops_team",
 		"azuredevops_teams",
+		"azuredevops_team_members",
 		"azuredevops_groups",
+		"azuredevops_well_known_group_descriptors",
 		"azuredevops_variable_group",
+		"azuredevops_serviceendpoint",
 		"azuredevops_serviceendpoint_azurerm",
 		"azuredevops_serviceendpoint_github",
 	}
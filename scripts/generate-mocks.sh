@@ -72,6 +72,11 @@ function generate_mock_clients() {
         #       leaving the parameter hard-coded here.
         generate_single_mock_client "$PACKAGE" "Client" || true
     done
+
+    # clients in ./sdk fill gaps of the Azure DevOps Go SDK and are mocked the same way
+    for PACKAGE in $(go list ./sdk/...); do
+        generate_single_mock_client "$PACKAGE" "Client" || true
+    done
 }
 
 function generate_mocks() {
@@ -0,0 +1,80 @@
+// Package environmentkubernetes provides a client for the Kubernetes resources of Azure Pipelines environments.
+//
+// The AddKubernetesResource operation of the taskagent package of the Azure DevOps Go SDK only accepts create
+// parameters without a service endpoint, so this client adds resources backed by an existing service endpoint.
+package environmentkubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+)
+
+var kubernetesResourcesLocationID, _ = uuid.Parse("73fba52f-15ab-42b3-a538-ce67a9223a04")
+
+const kubernetesResourcesAPIVersion = "6.0-preview.1"
+
+type Client interface {
+	// [Preview API] Add a Kubernetes resource backed by an existing service endpoint to an environment
+	AddKubernetesResource(context.Context, AddKubernetesResourceArgs) (*taskagent.KubernetesResource, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, taskagent.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Add a Kubernetes resource backed by an existing service endpoint to an environment
+func (client *ClientImpl) AddKubernetesResource(ctx context.Context, args AddKubernetesResourceArgs) (*taskagent.KubernetesResource, error) {
+	if args.CreateParameters == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.CreateParameters"}
+	}
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	if args.EnvironmentId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.EnvironmentId"}
+	}
+	routeValues := map[string]string{
+		"project":       *args.Project,
+		"environmentId": strconv.Itoa(*args.EnvironmentId),
+	}
+
+	body, marshalErr := json.Marshal(args.CreateParameters)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPost, kubernetesResourcesLocationID, kubernetesResourcesAPIVersion, routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.KubernetesResource
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the AddKubernetesResource function
+type AddKubernetesResourceArgs struct {
+	// (required)
+	CreateParameters *taskagent.KubernetesResourceCreateParametersExistingEndpoint
+	// (required) Project ID or project name
+	Project *string
+	// (required)
+	EnvironmentId *int
+}
@@ -0,0 +1,189 @@
+// Package extensiondata provides a client for the data storage API of Azure DevOps extensions.
+//
+// Extensions store settings as JSON documents in collections. The Azure DevOps Go SDK has no client for the data
+// storage API, so this client follows the shape of the SDK clients.
+// https://docs.microsoft.com/en-us/azure/devops/extend/develop/data-storage
+package extensiondata
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/extensionmanagement"
+)
+
+var extensionDataLocationID, _ = uuid.Parse("bbe06c18-1c8b-4fcd-b9c6-1535aaab8749")
+
+const extensionDataAPIVersion = "6.0-preview.1"
+
+type Client interface {
+	// [Preview API] Create a document in a collection of an extension
+	CreateDocument(context.Context, CreateDocumentArgs) (map[string]interface{}, error)
+	// [Preview API] Delete a document from a collection of an extension
+	DeleteDocument(context.Context, DeleteDocumentArgs) error
+	// [Preview API] Get a document from a collection of an extension
+	GetDocument(context.Context, GetDocumentArgs) (map[string]interface{}, error)
+	// [Preview API] Create or replace a document in a collection of an extension
+	SetDocument(context.Context, SetDocumentArgs) (map[string]interface{}, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, extensionmanagement.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// Collection identifies a collection of documents of an extension
+type Collection struct {
+	// (required) Name of the publisher of the extension
+	PublisherName *string
+	// (required) Name of the extension
+	ExtensionName *string
+	// (required) Type of the scope of the collection, like Default or User
+	ScopeType *string
+	// (required) Value of the scope of the collection, like Current or Me
+	ScopeValue *string
+	// (required) Name of the collection
+	CollectionName *string
+}
+
+func (collection *Collection) routeValues() (map[string]string, error) {
+	routeValues := make(map[string]string)
+	for name, value := range map[string]*string{
+		"publisherName":  collection.PublisherName,
+		"extensionName":  collection.ExtensionName,
+		"scopeType":      collection.ScopeType,
+		"scopeValue":     collection.ScopeValue,
+		"collectionName": collection.CollectionName,
+	} {
+		if value == nil || *value == "" {
+			return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Collection." + name}
+		}
+		routeValues[name] = *value
+	}
+	return routeValues, nil
+}
+
+func (client *ClientImpl) sendDocument(ctx context.Context, httpMethod string, collection *Collection, document map[string]interface{}) (map[string]interface{}, error) {
+	if collection == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Collection"}
+	}
+	routeValues, err := collection.routeValues()
+	if err != nil {
+		return nil, err
+	}
+	if document == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Document"}
+	}
+	body, marshalErr := json.Marshal(document)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+
+	resp, err := client.Client.Send(ctx, httpMethod, extensionDataLocationID, extensionDataAPIVersion, routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue map[string]interface{}
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return responseValue, err
+}
+
+func (client *ClientImpl) documentRouteValues(collection *Collection, documentId *string) (map[string]string, error) {
+	if collection == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Collection"}
+	}
+	routeValues, err := collection.routeValues()
+	if err != nil {
+		return nil, err
+	}
+	if documentId == nil || *documentId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.DocumentId"}
+	}
+	routeValues["documentId"] = *documentId
+	return routeValues, nil
+}
+
+// [Preview API] Create a document in a collection of an extension
+func (client *ClientImpl) CreateDocument(ctx context.Context, args CreateDocumentArgs) (map[string]interface{}, error) {
+	return client.sendDocument(ctx, http.MethodPost, args.Collection, args.Document)
+}
+
+// Arguments for the CreateDocument function
+type CreateDocumentArgs struct {
+	// (required) Collection of the document
+	Collection *Collection
+	// (required) Document to create, the property id holds the ID of the document
+	Document map[string]interface{}
+}
+
+// [Preview API] Delete a document from a collection of an extension
+func (client *ClientImpl) DeleteDocument(ctx context.Context, args DeleteDocumentArgs) error {
+	routeValues, err := client.documentRouteValues(args.Collection, args.DocumentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Client.Send(ctx, http.MethodDelete, extensionDataLocationID, extensionDataAPIVersion, routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// Arguments for the DeleteDocument function
+type DeleteDocumentArgs struct {
+	// (required) Collection of the document
+	Collection *Collection
+	// (required) ID of the document
+	DocumentId *string
+}
+
+// [Preview API] Get a document from a collection of an extension
+func (client *ClientImpl) GetDocument(ctx context.Context, args GetDocumentArgs) (map[string]interface{}, error) {
+	routeValues, err := client.documentRouteValues(args.Collection, args.DocumentId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, extensionDataLocationID, extensionDataAPIVersion, routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue map[string]interface{}
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return responseValue, err
+}
+
+// Arguments for the GetDocument function
+type GetDocumentArgs struct {
+	// (required) Collection of the document
+	Collection *Collection
+	// (required) ID of the document
+	DocumentId *string
+}
+
+// [Preview API] Create or replace a document in a collection of an extension. Without an etag, the document is
+// replaced regardless of changes made to it in the meantime.
+func (client *ClientImpl) SetDocument(ctx context.Context, args SetDocumentArgs) (map[string]interface{}, error) {
+	return client.sendDocument(ctx, http.MethodPut, args.Collection, args.Document)
+}
+
+// Arguments for the SetDocument function
+type SetDocumentArgs struct {
+	// (required) Collection of the document
+	Collection *Collection
+	// (required) Document to create or replace, the property id holds the ID of the document
+	Document map[string]interface{}
+}
@@ -0,0 +1,150 @@
+// Package organizationpolicy provides a client for the organization policy API of Azure DevOps.
+//
+// Organization policies control security settings like third-party application access, SSH authentication and
+// public projects. The Azure DevOps Go SDK has no client for them, so this client follows the shape of the SDK clients.
+// The API is undocumented and serves the organization settings UI, so it may change without notice.
+package organizationpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+)
+
+const policiesAPIVersion = "5.0-preview.1"
+
+type Client interface {
+	// [Preview API] Get an organization policy
+	GetPolicy(context.Context, GetPolicyArgs) (*Policy, error)
+	// [Preview API] Update the value of an organization policy
+	UpdatePolicy(context.Context, UpdatePolicyArgs) error
+}
+
+type ClientImpl struct {
+	Client  azuredevops.Client
+	BaseUrl string
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client:  *client,
+		BaseUrl: connection.BaseUrl,
+	}, nil
+}
+
+// The organization policy API is not published in the resource locations of an organization, so its route is built directly
+func (client *ClientImpl) policyUrl(policyName string) string {
+	return strings.TrimSuffix(client.BaseUrl, "/") + "/_apis/OrganizationPolicy/Policies/" + url.PathEscape(policyName)
+}
+
+func (client *ClientImpl) send(ctx context.Context, httpMethod string, requestUrl string, body interface{}, mediaType string) (*http.Response, error) {
+	var reader io.Reader
+	if body != nil {
+		content, marshalErr := json.Marshal(body)
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		reader = bytes.NewReader(content)
+	}
+
+	req, err := client.Client.CreateRequestMessage(ctx, httpMethod, requestUrl, policiesAPIVersion, reader, mediaType, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Client.SendRequest(req)
+}
+
+// [Preview API] Get an organization policy
+func (client *ClientImpl) GetPolicy(ctx context.Context, args GetPolicyArgs) (*Policy, error) {
+	if args.PolicyName == nil || *args.PolicyName == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.PolicyName"}
+	}
+
+	resp, err := client.send(ctx, http.MethodGet, client.policyUrl(*args.PolicyName), nil, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue Policy
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetPolicy function
+type GetPolicyArgs struct {
+	// (required) Name of the policy, like Policy.DisallowSecureShell
+	PolicyName *string
+}
+
+// [Preview API] Update the value of an organization policy
+func (client *ClientImpl) UpdatePolicy(ctx context.Context, args UpdatePolicyArgs) error {
+	if args.PolicyName == nil || *args.PolicyName == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.PolicyName"}
+	}
+	if args.Value == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Value"}
+	}
+
+	path := "/Value"
+	patchDocument := []webapi.JsonPatchOperation{
+		{
+			Op:    &webapi.OperationValues.Replace,
+			Path:  &path,
+			Value: *args.Value,
+		},
+	}
+	_, err := client.send(ctx, http.MethodPatch, client.policyUrl(*args.PolicyName), patchDocument, "application/json-patch+json")
+	return err
+}
+
+// Arguments for the UpdatePolicy function
+type UpdatePolicyArgs struct {
+	// (required) Name of the policy, like Policy.DisallowSecureShell
+	PolicyName *string
+	// (required) New value of the policy
+	Value *bool
+}
+
+// Organization policy
+type Policy struct {
+	// Value of the policy in effect, taking policies enforced by a parent into account
+	EffectiveValue *bool `json:"effectiveValue,omitempty"`
+	// Whether the policy is enforced for all organizations of the parent
+	Enforce *bool `json:"enforce,omitempty"`
+	// Whether the value of the policy has not been set and the default applies
+	IsValueUndefined *bool `json:"isValueUndefined,omitempty"`
+	// Name of the policy, like Policy.DisallowSecureShell
+	Name *string `json:"name,omitempty"`
+	// Value of the policy
+	Value *bool `json:"value,omitempty"`
+}
+
+// Names of the organization policies
+var PolicyNameValues = policyNameValuesType{
+	AllowAnonymousAccess:        "Policy.AllowAnonymousAccess",
+	DisallowAadGuestUserAccess:  "Policy.DisallowAadGuestUserAccess",
+	DisallowOAuthAuthentication: "Policy.DisallowOAuthAuthentication",
+	DisallowSecureShell:         "Policy.DisallowSecureShell",
+	EnforceAADConditionalAccess: "Policy.EnforceAADConditionalAccess",
+}
+
+type policyNameValuesType struct {
+	// Allow public projects
+	AllowAnonymousAccess string
+	// Disallow access of Azure Active Directory guest users
+	DisallowAadGuestUserAccess string
+	// Disallow third-party application access via OAuth
+	DisallowOAuthAuthentication string
+	// Disallow SSH authentication
+	DisallowSecureShell string
+	// Enable Azure Active Directory conditional access policy validation
+	EnforceAADConditionalAccess string
+}
@@ -0,0 +1,176 @@
+// Package pipelineschecksextras provides a client for the check configurations API of Azure Pipelines.
+//
+// The check configuration models of the pipelineschecks SDK package do not carry the settings of a check, which
+// makes them unusable for creating anything but the exclusive lock check. This client mirrors the SDK client but
+// exchanges check configurations including their settings.
+package pipelineschecksextras
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/pipelineschecks"
+)
+
+var checkConfigurationsLocationID, _ = uuid.Parse("86c8381e-5aee-4cde-8ae4-25c0c7f5eaea")
+
+const checkConfigurationsAPIVersion = "6.0-preview.1"
+
+type Client interface {
+	// [Preview API] Add a check configuration
+	AddCheckConfiguration(context.Context, AddCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error)
+	// [Preview API] Delete a check configuration
+	DeleteCheckConfiguration(context.Context, DeleteCheckConfigurationArgs) error
+	// [Preview API] Get a check configuration including its settings
+	GetCheckConfiguration(context.Context, GetCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error)
+	// [Preview API] Update a check configuration
+	UpdateCheckConfiguration(context.Context, UpdateCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, pipelineschecks.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Add a check configuration
+func (client *ClientImpl) AddCheckConfiguration(ctx context.Context, args AddCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error) {
+	if args.Configuration == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Configuration"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	body, marshalErr := json.Marshal(*args.Configuration)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPost, checkConfigurationsLocationID, checkConfigurationsAPIVersion, routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue pipelineschecks.GenericCheckConfiguration
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the AddCheckConfiguration function
+type AddCheckConfigurationArgs struct {
+	// (required)
+	Configuration *pipelineschecks.GenericCheckConfiguration
+	// (required) Project ID or project name
+	Project *string
+}
+
+// [Preview API] Delete a check configuration
+func (client *ClientImpl) DeleteCheckConfiguration(ctx context.Context, args DeleteCheckConfigurationArgs) error {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.Id == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues["id"] = strconv.Itoa(*args.Id)
+
+	_, err := client.Client.Send(ctx, http.MethodDelete, checkConfigurationsLocationID, checkConfigurationsAPIVersion, routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// Arguments for the DeleteCheckConfiguration function
+type DeleteCheckConfigurationArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required)
+	Id *int
+}
+
+// [Preview API] Get a check configuration including its settings
+func (client *ClientImpl) GetCheckConfiguration(ctx context.Context, args GetCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.Id == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues["id"] = strconv.Itoa(*args.Id)
+
+	queryParams := url.Values{}
+	queryParams.Add("$expand", string(pipelineschecks.CheckConfigurationExpandParameterValues.Settings))
+	resp, err := client.Client.Send(ctx, http.MethodGet, checkConfigurationsLocationID, checkConfigurationsAPIVersion, routeValues, queryParams, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue pipelineschecks.GenericCheckConfiguration
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetCheckConfiguration function
+type GetCheckConfigurationArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required)
+	Id *int
+}
+
+// [Preview API] Update a check configuration
+func (client *ClientImpl) UpdateCheckConfiguration(ctx context.Context, args UpdateCheckConfigurationArgs) (*pipelineschecks.GenericCheckConfiguration, error) {
+	if args.Configuration == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Configuration"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.Id == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.Id"}
+	}
+	routeValues["id"] = strconv.Itoa(*args.Id)
+
+	body, marshalErr := json.Marshal(*args.Configuration)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPatch, checkConfigurationsLocationID, checkConfigurationsAPIVersion, routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue pipelineschecks.GenericCheckConfiguration
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateCheckConfiguration function
+type UpdateCheckConfigurationArgs struct {
+	// (required)
+	Configuration *pipelineschecks.GenericCheckConfiguration
+	// (required) Project ID or project name
+	Project *string
+	// (required) check configuration id
+	Id *int
+}
@@ -0,0 +1,181 @@
+// Package securefiles provides a client for the secure files API of the Azure Pipelines Library.
+//
+// The taskagent package of the Azure DevOps Go SDK carries the secure file models, but no client operations for them.
+package securefiles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/taskagent"
+)
+
+var secureFilesLocationID, _ = uuid.Parse("adcfd8bc-b184-43ba-bd84-7c8c6a2ff421")
+
+const secureFilesAPIVersion = "6.0-preview.1"
+
+type Client interface {
+	// [Preview API] Delete a secure file
+	DeleteSecureFile(context.Context, DeleteSecureFileArgs) error
+	// [Preview API] Get a secure file
+	GetSecureFile(context.Context, GetSecureFileArgs) (*taskagent.SecureFile, error)
+	// [Preview API] Update the name or properties of an existing secure file
+	UpdateSecureFile(context.Context, UpdateSecureFileArgs) (*taskagent.SecureFile, error)
+	// [Preview API] Upload a secure file, include the file stream in the request body
+	UploadSecureFile(context.Context, UploadSecureFileArgs) (*taskagent.SecureFile, error)
+}
+
+type ClientImpl struct {
+	Client azuredevops.Client
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client, err := connection.GetClientByResourceAreaId(ctx, taskagent.ResourceAreaId)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientImpl{
+		Client: *client,
+	}, nil
+}
+
+// [Preview API] Delete a secure file
+func (client *ClientImpl) DeleteSecureFile(ctx context.Context, args DeleteSecureFileArgs) error {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	_, err := client.Client.Send(ctx, http.MethodDelete, secureFilesLocationID, secureFilesAPIVersion, routeValues, nil, nil, "", "application/json", nil)
+	return err
+}
+
+// Arguments for the DeleteSecureFile function
+type DeleteSecureFileArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Get a secure file
+func (client *ClientImpl) GetSecureFile(ctx context.Context, args GetSecureFileArgs) (*taskagent.SecureFile, error) {
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	resp, err := client.Client.Send(ctx, http.MethodGet, secureFilesLocationID, secureFilesAPIVersion, routeValues, nil, nil, "", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetSecureFile function
+type GetSecureFileArgs struct {
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Update the name or properties of an existing secure file
+func (client *ClientImpl) UpdateSecureFile(ctx context.Context, args UpdateSecureFileArgs) (*taskagent.SecureFile, error) {
+	if args.SecureFile == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFile"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+	if args.SecureFileId == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.SecureFileId"}
+	}
+	routeValues["secureFileId"] = (*args.SecureFileId).String()
+
+	body, marshalErr := json.Marshal(*args.SecureFile)
+	if marshalErr != nil {
+		return nil, marshalErr
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPatch, secureFilesLocationID, secureFilesAPIVersion, routeValues, nil, bytes.NewReader(body), "application/json", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UpdateSecureFile function
+type UpdateSecureFileArgs struct {
+	// (required) The secure file with updated name and/or properties
+	SecureFile *taskagent.SecureFile
+	// (required) Project ID or project name
+	Project *string
+	// (required) The unique secure file Id
+	SecureFileId *uuid.UUID
+}
+
+// [Preview API] Upload a secure file, include the file stream in the request body
+func (client *ClientImpl) UploadSecureFile(ctx context.Context, args UploadSecureFileArgs) (*taskagent.SecureFile, error) {
+	if args.UploadStream == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.UploadStream"}
+	}
+	routeValues := make(map[string]string)
+	if args.Project == nil || *args.Project == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.Project"}
+	}
+	routeValues["project"] = *args.Project
+
+	queryParams := url.Values{}
+	if args.Name == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "name"}
+	}
+	queryParams.Add("name", *args.Name)
+	if args.AuthorizePipelines != nil {
+		queryParams.Add("authorizePipelines", strconv.FormatBool(*args.AuthorizePipelines))
+	}
+	resp, err := client.Client.Send(ctx, http.MethodPost, secureFilesLocationID, secureFilesAPIVersion, routeValues, queryParams, args.UploadStream, "application/octet-stream", "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue taskagent.SecureFile
+	err = client.Client.UnmarshalBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the UploadSecureFile function
+type UploadSecureFileArgs struct {
+	// (required) Stream to upload
+	UploadStream io.Reader
+	// (required) Project ID or project name
+	Project *string
+	// (required) Name of the file to upload
+	Name *string
+	// (optional) If authorizePipelines is true, then the secure file is authorized for use by all pipelines in the project.
+	AuthorizePipelines *bool
+}
@@ -0,0 +1,197 @@
+// Package securityroles provides a client for the security roles API of Azure DevOps.
+//
+// Role based security secures resources like the Library, variable groups, deployment pools and environments. The
+// Azure DevOps Go SDK has no client for it, so this client follows the shape of the SDK clients.
+package securityroles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6"
+	"github.com/microsoft/azure-devops-go-api/azuredevops/v6/webapi"
+)
+
+const roleAssignmentsAPIVersion = "6.0-preview.1"
+
+type Client interface {
+	// [Preview API] Get the role assignments of a resource
+	GetRoleAssignments(context.Context, GetRoleAssignmentsArgs) (*[]RoleAssignment, error)
+	// [Preview API] Remove role assignments of identities from a resource
+	RemoveRoleAssignments(context.Context, RemoveRoleAssignmentsArgs) error
+	// [Preview API] Set role assignments of identities on a resource
+	SetRoleAssignments(context.Context, SetRoleAssignmentsArgs) (*[]RoleAssignment, error)
+}
+
+type ClientImpl struct {
+	Client  azuredevops.Client
+	BaseUrl string
+}
+
+func NewClient(ctx context.Context, connection *azuredevops.Connection) (Client, error) {
+	client := connection.GetClientByUrl(connection.BaseUrl)
+	return &ClientImpl{
+		Client:  *client,
+		BaseUrl: connection.BaseUrl,
+	}, nil
+}
+
+// The security roles API is not published in the resource locations of an organization, so its route is built directly
+func (client *ClientImpl) roleAssignmentsUrl(scopeId string, resourceId string) string {
+	return strings.TrimSuffix(client.BaseUrl, "/") + "/_apis/securityroles/scopes/" + url.PathEscape(scopeId) + "/roleassignments/resources/" + url.PathEscape(resourceId)
+}
+
+func (client *ClientImpl) send(ctx context.Context, httpMethod string, requestUrl string, body interface{}) (*http.Response, error) {
+	var reader io.Reader
+	mediaType := ""
+	if body != nil {
+		content, marshalErr := json.Marshal(body)
+		if marshalErr != nil {
+			return nil, marshalErr
+		}
+		reader = bytes.NewReader(content)
+		mediaType = "application/json"
+	}
+
+	req, err := client.Client.CreateRequestMessage(ctx, httpMethod, requestUrl, roleAssignmentsAPIVersion, reader, mediaType, "application/json", nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Client.SendRequest(req)
+}
+
+// [Preview API] Get the role assignments of a resource
+func (client *ClientImpl) GetRoleAssignments(ctx context.Context, args GetRoleAssignmentsArgs) (*[]RoleAssignment, error) {
+	if args.ScopeId == nil || *args.ScopeId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ScopeId"}
+	}
+	if args.ResourceId == nil || *args.ResourceId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ResourceId"}
+	}
+
+	resp, err := client.send(ctx, http.MethodGet, client.roleAssignmentsUrl(*args.ScopeId, *args.ResourceId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []RoleAssignment
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the GetRoleAssignments function
+type GetRoleAssignmentsArgs struct {
+	// (required) ID of the scope, like distributedtask.library
+	ScopeId *string
+	// (required) ID of the resource within the scope
+	ResourceId *string
+}
+
+// [Preview API] Remove role assignments of identities from a resource
+func (client *ClientImpl) RemoveRoleAssignments(ctx context.Context, args RemoveRoleAssignmentsArgs) error {
+	if args.IdentityIds == nil {
+		return &azuredevops.ArgumentNilError{ArgumentName: "args.IdentityIds"}
+	}
+	if args.ScopeId == nil || *args.ScopeId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ScopeId"}
+	}
+	if args.ResourceId == nil || *args.ResourceId == "" {
+		return &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ResourceId"}
+	}
+
+	_, err := client.send(ctx, http.MethodPatch, client.roleAssignmentsUrl(*args.ScopeId, *args.ResourceId), *args.IdentityIds)
+	return err
+}
+
+// Arguments for the RemoveRoleAssignments function
+type RemoveRoleAssignmentsArgs struct {
+	// (required) IDs of the identities to remove the role assignments of
+	IdentityIds *[]uuid.UUID
+	// (required) ID of the scope, like distributedtask.library
+	ScopeId *string
+	// (required) ID of the resource within the scope
+	ResourceId *string
+}
+
+// [Preview API] Set role assignments of identities on a resource
+func (client *ClientImpl) SetRoleAssignments(ctx context.Context, args SetRoleAssignmentsArgs) (*[]RoleAssignment, error) {
+	if args.RoleAssignments == nil {
+		return nil, &azuredevops.ArgumentNilError{ArgumentName: "args.RoleAssignments"}
+	}
+	if args.ScopeId == nil || *args.ScopeId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ScopeId"}
+	}
+	if args.ResourceId == nil || *args.ResourceId == "" {
+		return nil, &azuredevops.ArgumentNilOrEmptyError{ArgumentName: "args.ResourceId"}
+	}
+
+	resp, err := client.send(ctx, http.MethodPut, client.roleAssignmentsUrl(*args.ScopeId, *args.ResourceId), *args.RoleAssignments)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseValue []RoleAssignment
+	err = client.Client.UnmarshalCollectionBody(resp, &responseValue)
+	return &responseValue, err
+}
+
+// Arguments for the SetRoleAssignments function
+type SetRoleAssignmentsArgs struct {
+	// (required) Role assignments to set
+	RoleAssignments *[]UserRoleAssignmentRef
+	// (required) ID of the scope, like distributedtask.library
+	ScopeId *string
+	// (required) ID of the resource within the scope
+	ResourceId *string
+}
+
+// Role assignment of an identity on a resource
+type RoleAssignment struct {
+	// Whether the role is assigned to the identity on the resource or inherited from a parent resource
+	Access *RoleAccess `json:"access,omitempty"`
+	// Display name of the access
+	AccessDisplayName *string `json:"accessDisplayName,omitempty"`
+	// Identity the role is assigned to
+	Identity *webapi.IdentityRef `json:"identity,omitempty"`
+	// Role assigned to the identity
+	Role *SecurityRole `json:"role,omitempty"`
+}
+
+type RoleAccess string
+
+type roleAccessValuesType struct {
+	Assigned  RoleAccess
+	Inherited RoleAccess
+}
+
+var RoleAccessValues = roleAccessValuesType{
+	// Access has been explicitly assigned on the resource
+	Assigned: "assigned",
+	// Access has been inherited from a parent resource
+	Inherited: "inherited",
+}
+
+// Role which can be assigned on resources of a scope
+type SecurityRole struct {
+	AllowPermissions *int    `json:"allowPermissions,omitempty"`
+	DenyPermissions  *int    `json:"denyPermissions,omitempty"`
+	Description      *string `json:"description,omitempty"`
+	DisplayName      *string `json:"displayName,omitempty"`
+	Identifier       *string `json:"identifier,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	Scope            *string `json:"scope,omitempty"`
+}
+
+// Reference to a role assignment of an identity, used to set role assignments
+type UserRoleAssignmentRef struct {
+	// Name of the role, like Reader, User or Administrator
+	RoleName *string `json:"roleName,omitempty"`
+	// ID of the identity
+	UserId *uuid.UUID `json:"userId,omitempty"`
+}